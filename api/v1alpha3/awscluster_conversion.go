@@ -55,10 +55,28 @@ func (r *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.S3Bucket = restored.Spec.S3Bucket
+	dst.Spec.DedicatedHosts = restored.Spec.DedicatedHosts
+	dst.Spec.ControlPlaneDisableAPITermination = restored.Spec.ControlPlaneDisableAPITermination
+	dst.Spec.ControlPlaneDisableAPIStop = restored.Spec.ControlPlaneDisableAPIStop
+	dst.Spec.BootstrapSecretsKMSKeyARN = restored.Spec.BootstrapSecretsKMSKeyARN
+	dst.Spec.Proxy = restored.Spec.Proxy
+	dst.Spec.AdditionalTrustBundle = restored.Spec.AdditionalTrustBundle
+	dst.Spec.Bastion.AMILookup = restored.Spec.Bastion.AMILookup
+	restoreSubnets(restored.Spec.NetworkSpec.Subnets, dst.Spec.NetworkSpec.Subnets)
 
 	return nil
 }
 
+// restoreSubnets manually restores the subnet data, matching the subnets by position.
+func restoreSubnets(restored, dst infrav1.Subnets) {
+	for i := range dst {
+		if i >= len(restored) {
+			return
+		}
+		dst[i].PrivateDNSNameOptionsOnLaunch = restored[i].PrivateDNSNameOptionsOnLaunch
+	}
+}
+
 // restoreControlPlaneLoadBalancer manually restores the control plane loadbalancer data.
 // Assumes restored and dst are non-nil.
 func restoreControlPlaneLoadBalancer(restored, dst *infrav1.AWSLoadBalancerSpec) {
@@ -124,6 +142,10 @@ func Convert_v1beta1_NetworkStatus_To_v1alpha3_Network(in *infrav1.NetworkStatus
 	return nil
 }
 
+func Convert_v1beta1_Bastion_To_v1alpha3_Bastion(in *infrav1.Bastion, out *Bastion, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_Bastion_To_v1alpha3_Bastion(in, out, s)
+}
+
 func Convert_v1beta1_AWSLoadBalancerSpec_To_v1alpha3_AWSLoadBalancerSpec(in *infrav1.AWSLoadBalancerSpec, out *AWSLoadBalancerSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSLoadBalancerSpec_To_v1alpha3_AWSLoadBalancerSpec(in, out, s)
 }
@@ -131,3 +153,7 @@ func Convert_v1beta1_AWSLoadBalancerSpec_To_v1alpha3_AWSLoadBalancerSpec(in *inf
 func Convert_v1beta1_AWSClusterSpec_To_v1alpha3_AWSClusterSpec(in *infrav1.AWSClusterSpec, out *AWSClusterSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSClusterSpec_To_v1alpha3_AWSClusterSpec(in, out, s)
 }
+
+func Convert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(in *infrav1.SubnetSpec, out *SubnetSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(in, out, s)
+}
@@ -41,6 +41,9 @@ func (r *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	restoreSpec(&restored.Spec, &dst.Spec)
 
 	dst.Spec.Ignition = restored.Spec.Ignition
+	dst.Status.LaunchTemplateID = restored.Status.LaunchTemplateID
+	dst.Status.LaunchTemplateVersion = restored.Status.LaunchTemplateVersion
+	dst.Status.RetainedVolumeIDs = restored.Status.RetainedVolumeIDs
 
 	return nil
 }
@@ -59,6 +62,21 @@ func restoreSpec(restored, dst *infrav1.AWSMachineSpec) {
 		}
 		restoreNonRootVolumes(restored.NonRootVolumes, dst.NonRootVolumes)
 	}
+	dst.RetainedVolumes = restored.RetainedVolumes
+	dst.CPUOptions = restored.CPUOptions
+	dst.HibernationOptions = restored.HibernationOptions
+	dst.EnclaveOptions = restored.EnclaveOptions
+	dst.BootMode = restored.BootMode
+	dst.NetworkInterfaceSpecs = restored.NetworkInterfaceSpecs
+	dst.HostID = restored.HostID
+	dst.HostResourceGroupArn = restored.HostResourceGroupArn
+	dst.HostAffinity = restored.HostAffinity
+	dst.DisableAPITermination = restored.DisableAPITermination
+	dst.DisableAPIStop = restored.DisableAPIStop
+	dst.UseLaunchTemplate = restored.UseLaunchTemplate
+	dst.PrivateDNSName = restored.PrivateDNSName
+	dst.CloudInit.KMSKeyARN = restored.CloudInit.KMSKeyARN
+	dst.CloudInit.AdditionalParts = restored.CloudInit.AdditionalParts
 }
 
 // ConvertFrom converts the v1beta1 AWSMachine receiver to a v1alpha3 AWSMachine.
@@ -105,6 +123,8 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.Ignition = restored.Spec.Template.Spec.Ignition
 
 	restoreSpec(&restored.Spec.Template.Spec, &dst.Spec.Template.Spec)
+	dst.Spec.AMIUpdatePolicy = restored.Spec.AMIUpdatePolicy
+	dst.Status = restored.Status
 
 	return nil
 }
@@ -147,6 +167,16 @@ func Convert_v1beta1_AWSMachineSpec_To_v1alpha3_AWSMachineSpec(in *infrav1.AWSMa
 	return autoConvert_v1beta1_AWSMachineSpec_To_v1alpha3_AWSMachineSpec(in, out, s)
 }
 
+// Convert_v1beta1_CloudInit_To_v1alpha3_CloudInit .
+func Convert_v1beta1_CloudInit_To_v1alpha3_CloudInit(in *infrav1.CloudInit, out *CloudInit, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_CloudInit_To_v1alpha3_CloudInit(in, out, s)
+}
+
+// Convert_v1beta1_AWSMachineStatus_To_v1alpha3_AWSMachineStatus .
+func Convert_v1beta1_AWSMachineStatus_To_v1alpha3_AWSMachineStatus(in *infrav1.AWSMachineStatus, out *AWSMachineStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachineStatus_To_v1alpha3_AWSMachineStatus(in, out, s)
+}
+
 // Convert_v1beta1_Instance_To_v1alpha3_Instance .
 func Convert_v1beta1_Instance_To_v1alpha3_Instance(in *infrav1.Instance, out *Instance, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_Instance_To_v1alpha3_Instance(in, out, s)
@@ -170,6 +200,18 @@ func restoreInstance(restored, dst *infrav1.Instance) {
 		}
 		restoreNonRootVolumes(restored.NonRootVolumes, dst.NonRootVolumes)
 	}
+
+	dst.CPUOptions = restored.CPUOptions
+	dst.HibernationOptions = restored.HibernationOptions
+	dst.EnclaveOptions = restored.EnclaveOptions
+	dst.BootMode = restored.BootMode
+	dst.PrivateDNSName = restored.PrivateDNSName
+	dst.NetworkInterfaceSpecs = restored.NetworkInterfaceSpecs
+	dst.HostID = restored.HostID
+	dst.HostResourceGroupArn = restored.HostResourceGroupArn
+	dst.HostAffinity = restored.HostAffinity
+	dst.DisableAPITermination = restored.DisableAPITermination
+	dst.DisableAPIStop = restored.DisableAPIStop
 }
 
 // Convert_v1alpha3_AWSResourceReference_To_v1beta1_AMIReference is a conversion function.
@@ -184,9 +226,13 @@ func Convert_v1beta1_AMIReference_To_v1alpha3_AWSResourceReference(in *infrav1.A
 	return nil
 }
 
-// RestoreAMIReference manually restore the EKSOptimizedLookupType for AWSMachine and AWSMachineTemplate
+// RestoreAMIReference manually restore the EKSOptimizedLookupType and the SSM parameter and filter lookups
+// for AWSMachine and AWSMachineTemplate
 // Assumes both restored and dst are non-nil.
 func RestoreAMIReference(restored, dst *infrav1.AMIReference) {
+	dst.SSMParameter = restored.SSMParameter
+	dst.Owners = restored.Owners
+	dst.Filters = restored.Filters
 	if restored.EKSOptimizedLookupType == nil {
 		return
 	}
@@ -207,6 +253,8 @@ func restoreNonRootVolumes(restoredVolumes, dstVolumes []infrav1.Volume) {
 			}
 		}
 		dstVolumes[i].Throughput = restoredVolumes[i].Throughput
+		dstVolumes[i].SnapshotID = restoredVolumes[i].SnapshotID
+		dstVolumes[i].DeleteOnTermination = restoredVolumes[i].DeleteOnTermination
 	}
 }
 
@@ -222,6 +270,16 @@ func RestoreRootVolume(restored, dst *infrav1.Volume) {
 		dst.Encrypted = nil
 	}
 	dst.Throughput = restored.Throughput
+	dst.SnapshotID = restored.SnapshotID
+	dst.DeleteOnTermination = restored.DeleteOnTermination
+}
+
+func Convert_v1beta1_AWSMachineTemplate_To_v1alpha3_AWSMachineTemplate(in *infrav1.AWSMachineTemplate, out *AWSMachineTemplate, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachineTemplate_To_v1alpha3_AWSMachineTemplate(in, out, s)
+}
+
+func Convert_v1beta1_AWSMachineTemplateSpec_To_v1alpha3_AWSMachineTemplateSpec(in *infrav1.AWSMachineTemplateSpec, out *AWSMachineTemplateSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachineTemplateSpec_To_v1alpha3_AWSMachineTemplateSpec(in, out, s)
 }
 
 func Convert_v1beta1_AWSMachineTemplateResource_To_v1alpha3_AWSMachineTemplateResource(in *infrav1.AWSMachineTemplateResource, out *AWSMachineTemplateResource, s apiconversion.Scope) error {
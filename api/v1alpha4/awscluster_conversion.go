@@ -46,10 +46,32 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.S3Bucket = restored.Spec.S3Bucket
+	dst.Spec.DedicatedHosts = restored.Spec.DedicatedHosts
+	dst.Spec.ControlPlaneDisableAPITermination = restored.Spec.ControlPlaneDisableAPITermination
+	dst.Spec.ControlPlaneDisableAPIStop = restored.Spec.ControlPlaneDisableAPIStop
+	dst.Spec.BootstrapSecretsKMSKeyARN = restored.Spec.BootstrapSecretsKMSKeyARN
+	dst.Spec.Proxy = restored.Spec.Proxy
+	dst.Spec.AdditionalTrustBundle = restored.Spec.AdditionalTrustBundle
+	dst.Spec.Bastion.AMILookup = restored.Spec.Bastion.AMILookup
+	restoreSubnets(restored.Spec.NetworkSpec.Subnets, dst.Spec.NetworkSpec.Subnets)
+
+	if restored.Status.Bastion != nil && dst.Status.Bastion != nil {
+		restoreInstance(restored.Status.Bastion, dst.Status.Bastion)
+	}
 
 	return nil
 }
 
+// restoreSubnets manually restores the subnet data, matching the subnets by position.
+func restoreSubnets(restored, dst infrav1.Subnets) {
+	for i := range dst {
+		if i >= len(restored) {
+			return
+		}
+		dst[i].PrivateDNSNameOptionsOnLaunch = restored[i].PrivateDNSNameOptionsOnLaunch
+	}
+}
+
 // restoreControlPlaneLoadBalancer manually restores the control plane loadbalancer data.
 // Assumes restored and dst are non-nil.
 func restoreControlPlaneLoadBalancer(restored, dst *infrav1.AWSLoadBalancerSpec) {
@@ -97,6 +119,14 @@ func (r *AWSClusterList) ConvertFrom(srcRaw conversion.Hub) error {
 	return Convert_v1beta1_AWSClusterList_To_v1alpha4_AWSClusterList(src, r, nil)
 }
 
+func Convert_v1beta1_Bastion_To_v1alpha4_Bastion(in *infrav1.Bastion, out *Bastion, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_Bastion_To_v1alpha4_Bastion(in, out, s)
+}
+
 func Convert_v1beta1_AWSLoadBalancerSpec_To_v1alpha4_AWSLoadBalancerSpec(in *infrav1.AWSLoadBalancerSpec, out *AWSLoadBalancerSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSLoadBalancerSpec_To_v1alpha4_AWSLoadBalancerSpec(in, out, s)
 }
+
+func Convert_v1beta1_SubnetSpec_To_v1alpha4_SubnetSpec(in *infrav1.SubnetSpec, out *SubnetSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_SubnetSpec_To_v1alpha4_SubnetSpec(in, out, s)
+}
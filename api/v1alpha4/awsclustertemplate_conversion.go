@@ -38,6 +38,14 @@ func (r *AWSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
+	dst.Spec.Template.Spec.DedicatedHosts = restored.Spec.Template.Spec.DedicatedHosts
+	dst.Spec.Template.Spec.ControlPlaneDisableAPITermination = restored.Spec.Template.Spec.ControlPlaneDisableAPITermination
+	dst.Spec.Template.Spec.ControlPlaneDisableAPIStop = restored.Spec.Template.Spec.ControlPlaneDisableAPIStop
+	dst.Spec.Template.Spec.BootstrapSecretsKMSKeyARN = restored.Spec.Template.Spec.BootstrapSecretsKMSKeyARN
+	dst.Spec.Template.Spec.Proxy = restored.Spec.Template.Spec.Proxy
+	dst.Spec.Template.Spec.AdditionalTrustBundle = restored.Spec.Template.Spec.AdditionalTrustBundle
+	dst.Spec.Template.Spec.Bastion.AMILookup = restored.Spec.Template.Spec.Bastion.AMILookup
+	restoreSubnets(restored.Spec.Template.Spec.NetworkSpec.Subnets, dst.Spec.Template.Spec.NetworkSpec.Subnets)
 
 	return nil
 }
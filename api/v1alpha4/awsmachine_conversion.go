@@ -38,10 +38,73 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Ignition = restored.Spec.Ignition
+	restoreSpec(&restored.Spec, &dst.Spec)
+	dst.Status.LaunchTemplateID = restored.Status.LaunchTemplateID
+	dst.Status.LaunchTemplateVersion = restored.Status.LaunchTemplateVersion
+	dst.Status.RetainedVolumeIDs = restored.Status.RetainedVolumeIDs
 
 	return nil
 }
 
+// restoreSpec manually restores the AWSMachineSpec fields which do not exist in v1alpha4.
+func restoreSpec(restored, dst *infrav1.AWSMachineSpec) {
+	RestoreAMIReference(&restored.AMI, &dst.AMI)
+	if restored.RootVolume != nil && dst.RootVolume != nil {
+		restoreVolume(restored.RootVolume, dst.RootVolume)
+	}
+	restoreVolumes(restored.NonRootVolumes, dst.NonRootVolumes)
+	dst.RetainedVolumes = restored.RetainedVolumes
+	dst.CPUOptions = restored.CPUOptions
+	dst.HibernationOptions = restored.HibernationOptions
+	dst.EnclaveOptions = restored.EnclaveOptions
+	dst.BootMode = restored.BootMode
+	dst.NetworkInterfaceSpecs = restored.NetworkInterfaceSpecs
+	dst.HostID = restored.HostID
+	dst.HostResourceGroupArn = restored.HostResourceGroupArn
+	dst.HostAffinity = restored.HostAffinity
+	dst.DisableAPITermination = restored.DisableAPITermination
+	dst.DisableAPIStop = restored.DisableAPIStop
+	dst.UseLaunchTemplate = restored.UseLaunchTemplate
+	dst.PrivateDNSName = restored.PrivateDNSName
+	dst.CloudInit.KMSKeyARN = restored.CloudInit.KMSKeyARN
+	dst.CloudInit.AdditionalParts = restored.CloudInit.AdditionalParts
+}
+
+// restoreInstance manually restores the Instance fields which do not exist in v1alpha4.
+// Assumes both restored and dst are non-nil.
+func restoreInstance(restored, dst *infrav1.Instance) {
+	if restored.RootVolume != nil && dst.RootVolume != nil {
+		restoreVolume(restored.RootVolume, dst.RootVolume)
+	}
+	restoreVolumes(restored.NonRootVolumes, dst.NonRootVolumes)
+	dst.CPUOptions = restored.CPUOptions
+	dst.HibernationOptions = restored.HibernationOptions
+	dst.EnclaveOptions = restored.EnclaveOptions
+	dst.BootMode = restored.BootMode
+	dst.PrivateDNSName = restored.PrivateDNSName
+	dst.NetworkInterfaceSpecs = restored.NetworkInterfaceSpecs
+	dst.HostID = restored.HostID
+	dst.HostResourceGroupArn = restored.HostResourceGroupArn
+	dst.HostAffinity = restored.HostAffinity
+	dst.DisableAPITermination = restored.DisableAPITermination
+	dst.DisableAPIStop = restored.DisableAPIStop
+}
+
+// restoreVolume manually restores the Volume fields which do not exist in v1alpha4.
+// Assumes both restored and dst are non-nil.
+func restoreVolume(restored, dst *infrav1.Volume) {
+	dst.SnapshotID = restored.SnapshotID
+	dst.DeleteOnTermination = restored.DeleteOnTermination
+}
+
+func restoreVolumes(restored, dst []infrav1.Volume) {
+	for i := range dst {
+		if i < len(restored) {
+			restoreVolume(&restored[i], &dst[i])
+		}
+	}
+}
+
 // ConvertFrom converts the v1beta1 AWSMachine to a v1alpha4 AWSMachine.
 func (dst *AWSMachine) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1.AWSMachine)
@@ -83,6 +146,9 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
 	dst.Spec.Template.Spec.Ignition = restored.Spec.Template.Spec.Ignition
+	restoreSpec(&restored.Spec.Template.Spec, &dst.Spec.Template.Spec)
+	dst.Spec.AMIUpdatePolicy = restored.Spec.AMIUpdatePolicy
+	dst.Status = restored.Status
 
 	return nil
 }
@@ -116,6 +182,14 @@ func (dst *AWSMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error {
 	return Convert_v1beta1_AWSMachineTemplateList_To_v1alpha4_AWSMachineTemplateList(src, dst, nil)
 }
 
+func Convert_v1beta1_AWSMachineTemplate_To_v1alpha4_AWSMachineTemplate(in *infrav1.AWSMachineTemplate, out *AWSMachineTemplate, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachineTemplate_To_v1alpha4_AWSMachineTemplate(in, out, s)
+}
+
+func Convert_v1beta1_AWSMachineTemplateSpec_To_v1alpha4_AWSMachineTemplateSpec(in *infrav1.AWSMachineTemplateSpec, out *AWSMachineTemplateSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachineTemplateSpec_To_v1alpha4_AWSMachineTemplateSpec(in, out, s)
+}
+
 func Convert_v1beta1_AWSMachineTemplateResource_To_v1alpha4_AWSMachineTemplateResource(in *infrav1.AWSMachineTemplateResource, out *AWSMachineTemplateResource, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSMachineTemplateResource_To_v1alpha4_AWSMachineTemplateResource(in, out, s)
 }
@@ -123,3 +197,31 @@ func Convert_v1beta1_AWSMachineTemplateResource_To_v1alpha4_AWSMachineTemplateRe
 func Convert_v1beta1_AWSMachineSpec_To_v1alpha4_AWSMachineSpec(in *v1beta1.AWSMachineSpec, out *AWSMachineSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSMachineSpec_To_v1alpha4_AWSMachineSpec(in, out, s)
 }
+
+func Convert_v1beta1_CloudInit_To_v1alpha4_CloudInit(in *v1beta1.CloudInit, out *CloudInit, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_CloudInit_To_v1alpha4_CloudInit(in, out, s)
+}
+
+func Convert_v1beta1_Volume_To_v1alpha4_Volume(in *v1beta1.Volume, out *Volume, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_Volume_To_v1alpha4_Volume(in, out, s)
+}
+
+func Convert_v1beta1_AWSMachineStatus_To_v1alpha4_AWSMachineStatus(in *v1beta1.AWSMachineStatus, out *AWSMachineStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachineStatus_To_v1alpha4_AWSMachineStatus(in, out, s)
+}
+
+func Convert_v1beta1_Instance_To_v1alpha4_Instance(in *v1beta1.Instance, out *Instance, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_Instance_To_v1alpha4_Instance(in, out, s)
+}
+
+// RestoreAMIReference manually restores the AMIReference lookups which do not exist in v1alpha4.
+// Assumes both restored and dst are non-nil.
+func RestoreAMIReference(restored, dst *infrav1.AMIReference) {
+	dst.SSMParameter = restored.SSMParameter
+	dst.Owners = restored.Owners
+	dst.Filters = restored.Filters
+}
+
+func Convert_v1beta1_AMIReference_To_v1alpha4_AMIReference(in *infrav1.AMIReference, out *AMIReference, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AMIReference_To_v1alpha4_AMIReference(in, out, s)
+}
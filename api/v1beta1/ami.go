@@ -0,0 +1,268 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Architecture is the processor architecture of an instance type or AMI.
+type Architecture string
+
+const (
+	// ArchitectureX86_64 is the 64-bit x86 architecture.
+	ArchitectureX86_64 = Architecture("x86_64")
+
+	// ArchitectureArm64 is the 64-bit ARM architecture of the AWS Graviton processors.
+	ArchitectureArm64 = Architecture("arm64")
+)
+
+// Validate checks that at most one way of resolving the AMI is set and that the lookup is well-formed.
+func (r *AMIReference) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var set []string
+	if r.ID != nil {
+		set = append(set, "id")
+	}
+	if r.EKSOptimizedLookupType != nil {
+		set = append(set, "eksLookupType")
+	}
+	if r.SSMParameter != nil {
+		set = append(set, "ssmParameter")
+	}
+	if len(r.Owners) > 0 || len(r.Filters) > 0 {
+		set = append(set, "owners/filters")
+	}
+	if len(set) > 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of id, eksLookupType, ssmParameter or owners/filters may be specified"))
+	}
+
+	if r.SSMParameter != nil {
+		if *r.SSMParameter == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("ssmParameter"), "must not be empty"))
+		} else if _, err := template.New("ssmParameter").Parse(*r.SSMParameter); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ssmParameter"), *r.SSMParameter, err.Error()))
+		}
+	}
+
+	for i, f := range r.Filters {
+		if f.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("filters").Index(i).Child("name"), "must not be empty"))
+		}
+		if len(f.Values) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("filters").Index(i).Child("values"), "at least one value is required"))
+		}
+	}
+
+	return allErrs
+}
+
+// Architectures returns the architectures the AMI reference explicitly asks for, either through an
+// architecture filter or an SSM parameter path such as /aws/service/bottlerocket/aws-k8s-1.22/arm64/latest/image_id.
+func (r *AMIReference) Architectures() []Architecture {
+	var archs []Architecture
+
+	for _, f := range r.Filters {
+		if f.Name != "architecture" {
+			continue
+		}
+		for _, v := range f.Values {
+			archs = append(archs, Architecture(v))
+		}
+	}
+
+	if r.SSMParameter != nil {
+		for _, segment := range strings.Split(*r.SSMParameter, "/") {
+			if arch := Architecture(segment); arch == ArchitectureX86_64 || arch == ArchitectureArm64 {
+				archs = append(archs, arch)
+			}
+		}
+	}
+
+	return archs
+}
+
+// ValidateArchitecture checks that the AMI reference does not explicitly ask for an architecture
+// other than the one of the instance type.
+func (r *AMIReference) ValidateArchitecture(fldPath *field.Path, instanceType string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	arch := InstanceTypeArchitecture(instanceType)
+	if arch == "" {
+		return allErrs
+	}
+
+	if archs := r.Architectures(); len(archs) > 0 && !containsArchitecture(archs, arch) {
+		allErrs = append(allErrs, field.Invalid(fldPath, archs, "the AMI architecture does not match the "+string(arch)+" architecture of instance type "+instanceType))
+	}
+
+	if r.EKSOptimizedLookupType != nil && *r.EKSOptimizedLookupType == AmazonLinuxGPU && arch == ArchitectureArm64 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eksLookupType"), *r.EKSOptimizedLookupType, "no EKS optimized GPU AMI is published for the arm64 architecture of instance type "+instanceType))
+	}
+
+	if r.EKSOptimizedLookupType != nil && r.EKSOptimizedLookupType.IsWindows() && arch == ArchitectureArm64 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eksLookupType"), *r.EKSOptimizedLookupType, "no EKS optimized Windows AMI is published for the arm64 architecture of instance type "+instanceType))
+	}
+
+	return allErrs
+}
+
+func containsArchitecture(archs []Architecture, arch Architecture) bool {
+	for _, a := range archs {
+		if a == arch {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultAMIUpdateCheckInterval is how often an AMI update policy re-runs the AMI lookup if no check interval is set.
+const DefaultAMIUpdateCheckInterval = time.Hour
+
+// minAMIUpdateCheckInterval limits how often the AMI lookup can be re-run, as every run calls the EC2 and SSM APIs.
+const minAMIUpdateCheckInterval = 5 * time.Minute
+
+// GetCheckInterval returns how often the AMI lookup is re-run.
+func (p *AMIUpdatePolicy) GetCheckInterval() time.Duration {
+	if p.CheckInterval == nil {
+		return DefaultAMIUpdateCheckInterval
+	}
+	return p.CheckInterval.Duration
+}
+
+// Validate checks the mode, check interval and maintenance window of the policy.
+func (p *AMIUpdatePolicy) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch p.Mode {
+	case "", AMIUpdateModeReport, AMIUpdateModeAuto:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), p.Mode, []string{string(AMIUpdateModeReport), string(AMIUpdateModeAuto)}))
+	}
+
+	if p.CheckInterval != nil && p.CheckInterval.Duration < minAMIUpdateCheckInterval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("checkInterval"), p.CheckInterval.Duration.String(), fmt.Sprintf("must be at least %s", minAMIUpdateCheckInterval)))
+	}
+
+	if p.MaintenanceWindow != nil {
+		if p.Mode != AMIUpdateModeAuto {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maintenanceWindow"), "can be set only in auto mode"))
+		}
+		allErrs = append(allErrs, p.MaintenanceWindow.Validate(fldPath.Child("maintenanceWindow"))...)
+	}
+
+	return allErrs
+}
+
+// Validate checks that the days, start time and duration of the window are well-formed.
+func (w *MaintenanceWindow) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, day := range w.Days {
+		if _, ok := parseWeekday(day); !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("days").Index(i), day, "must be a day of the week such as Saturday"))
+		}
+	}
+
+	if _, err := time.Parse("15:04", w.StartTime); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("startTime"), w.StartTime, "must be a time of day formatted as HH:MM"))
+	}
+
+	if w.Duration.Duration <= 0 || w.Duration.Duration > 7*24*time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), w.Duration.Duration.String(), "must be positive and at most one week"))
+	}
+
+	return allErrs
+}
+
+// Contains returns whether t falls within the window. A nil window is always open.
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+
+	start, err := time.Parse("15:04", w.StartTime)
+	if err != nil {
+		return false
+	}
+
+	t = t.UTC()
+	// A window lasts at most one week, so only the windows opening in the last seven days can still be open.
+	for d := 0; d <= 7; d++ {
+		day := t.AddDate(0, 0, -d)
+		opens := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC)
+		if opens.After(t) || !w.opensOn(opens.Weekday()) {
+			continue
+		}
+		if t.Before(opens.Add(w.Duration.Duration)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextOpening returns when the window opens next after t. It returns the zero time for a nil
+// window or an invalid start time.
+func (w *MaintenanceWindow) NextOpening(t time.Time) time.Time {
+	if w == nil {
+		return time.Time{}
+	}
+
+	start, err := time.Parse("15:04", w.StartTime)
+	if err != nil {
+		return time.Time{}
+	}
+
+	t = t.UTC()
+	for d := 0; d <= 7; d++ {
+		day := t.AddDate(0, 0, d)
+		opens := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC)
+		if opens.After(t) && w.opensOn(opens.Weekday()) {
+			return opens
+		}
+	}
+
+	return time.Time{}
+}
+
+func (w *MaintenanceWindow) opensOn(weekday time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, day := range w.Days {
+		if d, ok := parseWeekday(day); ok && d == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), day) {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestMaintenanceWindow_Contains(t *testing.T) {
+	// Saturday.
+	saturdayNight := time.Date(2022, time.April, 2, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		window   *MaintenanceWindow
+		t        time.Time
+		expected bool
+	}{
+		{
+			name:     "nil window is always open",
+			window:   nil,
+			t:        saturdayNight,
+			expected: true,
+		},
+		{
+			name:     "within a daily window",
+			window:   &MaintenanceWindow{StartTime: "22:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			t:        saturdayNight,
+			expected: true,
+		},
+		{
+			name:     "before a daily window",
+			window:   &MaintenanceWindow{StartTime: "23:30", Duration: metav1.Duration{Duration: time.Hour}},
+			t:        saturdayNight,
+			expected: false,
+		},
+		{
+			name:     "window opened on another day",
+			window:   &MaintenanceWindow{Days: []string{"Sunday"}, StartTime: "22:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			t:        saturdayNight,
+			expected: false,
+		},
+		{
+			name:     "window opened the day before and still open",
+			window:   &MaintenanceWindow{Days: []string{"friday"}, StartTime: "22:00", Duration: metav1.Duration{Duration: 26 * time.Hour}},
+			t:        saturdayNight,
+			expected: true,
+		},
+		{
+			name:     "time in another zone is compared in UTC",
+			window:   &MaintenanceWindow{Days: []string{"Saturday"}, StartTime: "22:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			t:        saturdayNight.In(time.FixedZone("UTC+2", 2*60*60)),
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Contains(tt.t); got != tt.expected {
+				t.Errorf("Contains() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindow_NextOpening(t *testing.T) {
+	// Saturday.
+	saturdayNight := time.Date(2022, time.April, 2, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		window   *MaintenanceWindow
+		expected time.Time
+	}{
+		{
+			name:     "nil window",
+			window:   nil,
+			expected: time.Time{},
+		},
+		{
+			name:     "daily window opening later the same day",
+			window:   &MaintenanceWindow{StartTime: "23:30", Duration: metav1.Duration{Duration: time.Hour}},
+			expected: time.Date(2022, time.April, 2, 23, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "daily window which already opened today",
+			window:   &MaintenanceWindow{StartTime: "22:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			expected: time.Date(2022, time.April, 3, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekly window which already opened today",
+			window:   &MaintenanceWindow{Days: []string{"Saturday"}, StartTime: "22:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			expected: time.Date(2022, time.April, 9, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "window opening on another day",
+			window:   &MaintenanceWindow{Days: []string{"Tuesday", "Monday"}, StartTime: "02:00", Duration: metav1.Duration{Duration: time.Hour}},
+			expected: time.Date(2022, time.April, 4, 2, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.NextOpening(saturdayNight); !got.Equal(tt.expected) {
+				t.Errorf("NextOpening() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAMIUpdatePolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    *AMIUpdatePolicy
+		wantError bool
+	}{
+		{
+			name:      "report mode with defaults",
+			policy:    &AMIUpdatePolicy{Mode: AMIUpdateModeReport},
+			wantError: false,
+		},
+		{
+			name: "auto mode with a maintenance window",
+			policy: &AMIUpdatePolicy{
+				Mode:              AMIUpdateModeAuto,
+				CheckInterval:     &metav1.Duration{Duration: 30 * time.Minute},
+				MaintenanceWindow: &MaintenanceWindow{Days: []string{"Saturday", "Sunday"}, StartTime: "02:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+			},
+			wantError: false,
+		},
+		{
+			name:      "unknown mode",
+			policy:    &AMIUpdatePolicy{Mode: "rolling"},
+			wantError: true,
+		},
+		{
+			name:      "check interval too short",
+			policy:    &AMIUpdatePolicy{CheckInterval: &metav1.Duration{Duration: time.Minute}},
+			wantError: true,
+		},
+		{
+			name: "maintenance window in report mode",
+			policy: &AMIUpdatePolicy{
+				Mode:              AMIUpdateModeReport,
+				MaintenanceWindow: &MaintenanceWindow{StartTime: "02:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid maintenance window",
+			policy: &AMIUpdatePolicy{
+				Mode:              AMIUpdateModeAuto,
+				MaintenanceWindow: &MaintenanceWindow{Days: []string{"Caturday"}, StartTime: "2am"},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.policy.Validate(field.NewPath("amiUpdatePolicy"))
+			if (len(errs) > 0) != tt.wantError {
+				t.Errorf("Validate() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
@@ -94,9 +94,58 @@ type AWSClusterSpec struct {
 	// S3Bucket contains options to configure a supporting S3 bucket for this
 	// cluster - currently used for nodes requiring Ignition
 	// (https://coreos.github.io/ignition/) for bootstrapping (requires
-	// BootstrapFormatIgnition feature flag to be enabled).
+	// BootstrapFormatIgnition feature flag to be enabled), and for machines
+	// using the s3 secure secrets backend for cloud-init.
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// DedicatedHosts are groups of dedicated hosts which are allocated for the cluster and
+	// released when the cluster is deleted. The hosts accept instances with tenancy host
+	// which do not target a specific host or host resource group.
+	// +optional
+	DedicatedHosts []DedicatedHostSpec `json:"dedicatedHosts,omitempty"`
+
+	// ControlPlaneDisableAPITermination enables termination protection on the instances of
+	// control plane machines which do not set disableApiTermination themselves.
+	// +optional
+	ControlPlaneDisableAPITermination bool `json:"controlPlaneDisableApiTermination,omitempty"`
+
+	// ControlPlaneDisableAPIStop enables stop protection on the instances of control plane
+	// machines which do not set disableApiStop themselves and are not spot instances.
+	// +optional
+	ControlPlaneDisableAPIStop bool `json:"controlPlaneDisableApiStop,omitempty"`
+
+	// BootstrapSecretsKMSKeyARN is the ARN of the customer managed AWS KMS key used to encrypt
+	// the bootstrap data secrets of machines which do not set cloudInit.kmsKeyArn themselves.
+	// +optional
+	BootstrapSecretsKMSKeyARN string `json:"bootstrapSecretsKmsKeyArn,omitempty"`
+
+	// Proxy is the HTTP proxy used by the instances of the cluster to reach the AWS APIs and the
+	// internet while they are bootstrapped.
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+
+	// AdditionalTrustBundle is a PEM encoded bundle of CA certificates which the instances of the
+	// cluster trust in addition to the system trust store, e.g. to reach a TLS intercepting proxy.
+	// +optional
+	AdditionalTrustBundle string `json:"additionalTrustBundle,omitempty"`
+}
+
+// ProxyConfig defines the HTTP proxy of the instances of a cluster.
+type ProxyConfig struct {
+	// HTTPProxy is the URL of the proxy for HTTP requests.
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy for HTTPS requests.
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy are the host names, domain names with a leading dot, IP addresses and CIDR blocks
+	// which are reached without the proxy. The VPC CIDR block, the API server endpoint, the custom
+	// AWS service endpoints, localhost and the instance metadata service are always added.
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -150,6 +199,41 @@ type Bastion struct {
 	// the AMI will default to one picked out in public space.
 	// +optional
 	AMI string `json:"ami,omitempty"`
+
+	// AMILookup looks up the AMI to boot the bastion through an SSM parameter or owners and filters.
+	// Kubernetes version templating is not available for the bastion. Mutually exclusive with AMI.
+	// +optional
+	AMILookup *AMIReference `json:"amiLookup,omitempty"`
+}
+
+// DedicatedHostSpec defines a group of dedicated hosts allocated for a cluster.
+// See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/dedicated-hosts-overview.html
+type DedicatedHostSpec struct {
+	// Name identifies the group of hosts within the cluster. It is set as the Name tag of the hosts.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// InstanceType is the only instance type the hosts support, e.g. m5.large.
+	// Exactly one of InstanceType and InstanceFamily must be set.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// InstanceFamily is the instance family the hosts support, e.g. m5, so that instances of
+	// different sizes of the family can share a host.
+	// Exactly one of InstanceType and InstanceFamily must be set.
+	// +optional
+	InstanceFamily string `json:"instanceFamily,omitempty"`
+
+	// AvailabilityZone is the availability zone to allocate the hosts in.
+	// +kubebuilder:validation:MinLength:=1
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// Quantity is the number of hosts to allocate. Hosts which are no longer wanted
+	// are released once no instances run on them.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Quantity int64 `json:"quantity,omitempty"`
 }
 
 // AWSLoadBalancerSpec defines the desired state of an AWS load balancer.
@@ -219,6 +303,78 @@ type S3Bucket struct {
 	// +kubebuilder:validation:MaxLength:=63
 	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`
 	Name string `json:"name"`
+
+	// Encryption configures the default server-side encryption of the objects in the bucket.
+	// Removing it keeps the last default encryption of the bucket.
+	// +optional
+	Encryption *S3BucketEncryption `json:"encryption,omitempty"`
+
+	// BlockPublicAccess blocks all public access to the bucket and its objects through
+	// access control lists and bucket policies. Unsetting it does not unblock public access.
+	// +optional
+	BlockPublicAccess bool `json:"blockPublicAccess,omitempty"`
+
+	// ObjectOwnership sets the object ownership controls of the bucket. BucketOwnerEnforced
+	// disables access control lists, so the bucket owner owns and controls all objects.
+	// +kubebuilder:validation:Enum=BucketOwnerEnforced;BucketOwnerPreferred;ObjectWriter
+	// +optional
+	ObjectOwnership S3ObjectOwnership `json:"objectOwnership,omitempty"`
+
+	// DenyInsecureTransport adds a statement to the bucket policy which denies all requests
+	// not sent over TLS.
+	// +optional
+	DenyInsecureTransport bool `json:"denyInsecureTransport,omitempty"`
+
+	// BootstrapDataExpirationDays is the number of days after which the bootstrap data objects
+	// in the bucket expire, removing the objects of machines which did not delete them.
+	// Unsetting it removes the expiration.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BootstrapDataExpirationDays *int32 `json:"bootstrapDataExpirationDays,omitempty"`
+
+	// PresignedIgnitionURLs denies the IAM instance profiles of the bucket from reading the Ignition
+	// bootstrap data, for clusters whose Ignition machines reference it with presigned URLs. The
+	// profiles can still read the userdata of the s3 secure secrets backend. Ignition machines of
+	// the cluster have to set presignedURL, the instances of the others are not created.
+	// +optional
+	PresignedIgnitionURLs bool `json:"presignedIgnitionURLs,omitempty"`
+
+	// AccessLogging delivers the server access logs of the bucket to another bucket.
+	// Removing it does not disable the access logging of the bucket.
+	// +optional
+	AccessLogging *S3BucketAccessLogging `json:"accessLogging,omitempty"`
+}
+
+// S3BucketEncryption configures the default server-side encryption of an S3 bucket.
+type S3BucketEncryption struct {
+	// KMSKeyARN is the ARN of the customer managed AWS KMS key used to encrypt the objects
+	// in the bucket. Defaults to the AWS managed aws/s3 key.
+	// +optional
+	KMSKeyARN string `json:"kmsKeyArn,omitempty"`
+}
+
+// S3ObjectOwnership is the object ownership setting of an S3 bucket.
+type S3ObjectOwnership string
+
+const (
+	// S3ObjectOwnershipBucketOwnerEnforced disables access control lists, the bucket owner owns all objects.
+	S3ObjectOwnershipBucketOwnerEnforced = S3ObjectOwnership("BucketOwnerEnforced")
+	// S3ObjectOwnershipBucketOwnerPreferred makes the bucket owner own objects uploaded with the
+	// bucket-owner-full-control canned access control list.
+	S3ObjectOwnershipBucketOwnerPreferred = S3ObjectOwnership("BucketOwnerPreferred")
+	// S3ObjectOwnershipObjectWriter makes the uploading account own its objects.
+	S3ObjectOwnershipObjectWriter = S3ObjectOwnership("ObjectWriter")
+)
+
+// S3BucketAccessLogging configures the server access logging of an S3 bucket.
+type S3BucketAccessLogging struct {
+	// TargetBucket is the name of the bucket the access logs are delivered to, which must
+	// allow the S3 logging service to write to it.
+	TargetBucket string `json:"targetBucket"`
+
+	// TargetPrefix is the key prefix of the access log objects.
+	// +optional
+	TargetPrefix string `json:"targetPrefix,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -56,6 +56,10 @@ func (r *AWSCluster) ValidateCreate() error {
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, validateDedicatedHosts(r.Spec.DedicatedHosts, field.NewPath("spec", "dedicatedHosts"))...)
+	allErrs = append(allErrs, validateKMSKeyARN(r.Spec.BootstrapSecretsKMSKeyARN, field.NewPath("spec", "bootstrapSecretsKmsKeyArn"))...)
+	allErrs = append(allErrs, r.Spec.Proxy.Validate(field.NewPath("spec", "proxy"))...)
+	allErrs = append(allErrs, ValidateTrustBundle(r.Spec.AdditionalTrustBundle, field.NewPath("spec", "additionalTrustBundle"))...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -166,6 +170,10 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, validateDedicatedHosts(r.Spec.DedicatedHosts, field.NewPath("spec", "dedicatedHosts"))...)
+	allErrs = append(allErrs, validateKMSKeyARN(r.Spec.BootstrapSecretsKMSKeyARN, field.NewPath("spec", "bootstrapSecretsKmsKeyArn"))...)
+	allErrs = append(allErrs, r.Spec.Proxy.Validate(field.NewPath("spec", "proxy"))...)
+	allErrs = append(allErrs, ValidateTrustBundle(r.Spec.AdditionalTrustBundle, field.NewPath("spec", "additionalTrustBundle"))...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
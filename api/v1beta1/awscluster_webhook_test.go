@@ -161,6 +161,50 @@ func TestAWSCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "accepts hardened bucket settings",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "control-plane.cluster-api-provider-aws.sigs.k8s.io",
+						NodesIAMInstanceProfiles:       []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"},
+						Encryption:                     &S3BucketEncryption{KMSKeyARN: "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"},
+						BlockPublicAccess:              true,
+						DenyInsecureTransport:          true,
+						AccessLogging:                  &S3BucketAccessLogging{TargetBucket: "foo-logs"},
+					},
+				},
+			},
+		},
+		{
+			name: "rejects bucket encryption key which is not a KMS key ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "control-plane.cluster-api-provider-aws.sigs.k8s.io",
+						NodesIAMInstanceProfiles:       []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"},
+						Encryption:                     &S3BucketEncryption{KMSKeyARN: "alias/bootstrap"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects bucket delivering access logs to itself",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "control-plane.cluster-api-provider-aws.sigs.k8s.io",
+						NodesIAMInstanceProfiles:       []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"},
+						AccessLogging:                  &S3BucketAccessLogging{TargetBucket: "foo"},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "rejects bucket name formatted as IP address",
 			cluster: &AWSCluster{
@@ -222,6 +266,40 @@ func TestAWSCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "dedicated hosts require exactly one of instance type and family",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: []DedicatedHostSpec{
+						{Name: "hosts", InstanceType: "m5.large", InstanceFamily: "m5", AvailabilityZone: "us-east-1a"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dedicated host names must be unique",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: []DedicatedHostSpec{
+						{Name: "hosts", InstanceType: "m5.large", AvailabilityZone: "us-east-1a"},
+						{Name: "hosts", InstanceFamily: "c5", AvailabilityZone: "us-east-1b"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts valid dedicated hosts",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: []DedicatedHostSpec{
+						{Name: "hosts", InstanceFamily: "m5", AvailabilityZone: "us-east-1a", Quantity: 2},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -765,6 +843,64 @@ func TestAWSCluster_ValidateAllowedCIDRBlocks(t *testing.T) {
 	}
 }
 
+func TestAWSCluster_ValidateBastionAMILookup(t *testing.T) {
+	amazonLinux := AmazonLinux
+
+	tests := []struct {
+		name    string
+		awsc    *AWSCluster
+		wantErr bool
+	}{
+		{
+			name: "allow an SSM parameter lookup",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						AMILookup: &AMIReference{SSMParameter: aws.String("/aws/service/canonical/ubuntu/server/20.04/stable/current/amd64/hvm/ebs-gp2/ami-id")},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ami lookup not allowed with ami",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						AMI:       "ami-1",
+						AMILookup: &AMIReference{Owners: []string{"self"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "EKS optimized lookup not allowed",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						AMILookup: &AMIReference{EKSOptimizedLookupType: &amazonLinux},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			cluster := tt.awsc.DeepCopy()
+			cluster.ObjectMeta = metav1.ObjectMeta{
+				GenerateName: "cluster-",
+				Namespace:    "default",
+			}
+			if err := testEnv.Create(ctx, cluster); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBastionAMILookup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestAWSCluster_DefaultAllowedCIDRBlocks(t *testing.T) {
 	g := NewWithT(t)
 	tests := []struct {
@@ -48,6 +48,10 @@ func (r *AWSClusterTemplate) ValidateCreate() error {
 
 	allErrs = append(allErrs, r.Spec.Template.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, validateSSHKeyName(r.Spec.Template.Spec.SSHKeyName)...)
+	allErrs = append(allErrs, validateDedicatedHosts(r.Spec.Template.Spec.DedicatedHosts, field.NewPath("spec", "template", "spec", "dedicatedHosts"))...)
+	allErrs = append(allErrs, validateKMSKeyARN(r.Spec.Template.Spec.BootstrapSecretsKMSKeyARN, field.NewPath("spec", "template", "spec", "bootstrapSecretsKmsKeyArn"))...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.Proxy.Validate(field.NewPath("spec", "template", "spec", "proxy"))...)
+	allErrs = append(allErrs, ValidateTrustBundle(r.Spec.Template.Spec.AdditionalTrustBundle, field.NewPath("spec", "template", "spec", "additionalTrustBundle"))...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
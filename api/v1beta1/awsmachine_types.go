@@ -17,6 +17,9 @@ limitations under the License.
 package v1beta1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -30,6 +33,12 @@ const (
 
 	// DefaultIgnitionVersion represents default Ignition version generated for machine userdata.
 	DefaultIgnitionVersion = "2.3"
+
+	// DefaultIgnitionPresignedURLExpiration is the default validity of presigned Ignition bootstrap data URLs.
+	DefaultIgnitionPresignedURLExpiration = time.Hour
+
+	// MaxIgnitionPresignedURLExpiration is the maximum validity of presigned URLs supported by S3.
+	MaxIgnitionPresignedURLExpiration = 7 * 24 * time.Hour
 )
 
 // SecretBackend defines variants for backend secret storage.
@@ -41,6 +50,9 @@ var (
 
 	// SecretBackendSecretsManager defines AWS Secrets Manager as the secret backend.
 	SecretBackendSecretsManager = SecretBackend("secrets-manager")
+
+	// SecretBackendS3 defines an SSE-KMS encrypted object in the cluster's S3 bucket as the secret backend.
+	SecretBackendS3 = SecretBackend("s3")
 )
 
 // AWSMachineSpec defines the desired state of an Amazon EC2 instance.
@@ -49,6 +61,7 @@ type AWSMachineSpec struct {
 	ProviderID *string `json:"providerID,omitempty"`
 
 	// InstanceID is the EC2 instance ID for this machine.
+	// Setting it without a ProviderID adopts the existing instance, which must match the spec.
 	InstanceID *string `json:"instanceID,omitempty"`
 
 	// AMI is the reference to the AMI from which to create the machine instance.
@@ -127,12 +140,27 @@ type AWSMachineSpec struct {
 	// +optional
 	NonRootVolumes []Volume `json:"nonRootVolumes,omitempty"`
 
+	// RetainedVolumes are named EBS volumes which are kept when the instance is terminated.
+	// On creation of an instance, each volume is looked up by name in the instance's
+	// availability zone and attached to it, so a replacement machine in the same
+	// availability zone re-attaches the data of the machine it replaces.
+	// +optional
+	RetainedVolumes []RetainedVolume `json:"retainedVolumes,omitempty"`
+
 	// NetworkInterfaces is a list of ENIs to associate with the instance.
 	// A maximum of 2 may be specified.
 	// +optional
 	// +kubebuilder:validation:MaxItems=2
 	NetworkInterfaces []string `json:"networkInterfaces,omitempty"`
 
+	// NetworkInterfaceSpecs is a list of network interfaces to create and attach to the instance
+	// at launch, such as Elastic Fabric Adapters or interfaces in additional subnets.
+	// If set, it must contain the primary interface with device index 0. When more than one
+	// interface is attached, no public IPv4 address is assigned to the instance automatically.
+	// Cannot be combined with NetworkInterfaces.
+	// +optional
+	NetworkInterfaceSpecs []NetworkInterfaceSpec `json:"networkInterfaceSpecs,omitempty"`
+
 	// UncompressedUserData specify whether the user data is gzip-compressed before it is sent to ec2 instance.
 	// cloud-init has built-in support for gzip-compressed user data
 	// user data stored in aws secret manager is always gzip-compressed.
@@ -157,6 +185,71 @@ type AWSMachineSpec struct {
 	// +optional
 	// +kubebuilder:validation:Enum:=default;dedicated;host
 	Tenancy string `json:"tenancy,omitempty"`
+
+	// HostID is the ID of the dedicated host to launch the instance on.
+	// Requires tenancy host and cannot be combined with HostResourceGroupArn.
+	// +optional
+	HostID *string `json:"hostID,omitempty"`
+
+	// HostResourceGroupArn is the ARN of the host resource group to launch the instance in.
+	// Requires tenancy host and cannot be combined with HostID.
+	// +optional
+	HostResourceGroupArn *string `json:"hostResourceGroupArn,omitempty"`
+
+	// HostAffinity indicates whether an instance which is stopped and started again always
+	// restarts on the same dedicated host (host), or on any available host (default).
+	// Requires tenancy host.
+	// +optional
+	// +kubebuilder:validation:Enum:=default;host
+	HostAffinity string `json:"hostAffinity,omitempty"`
+
+	// DisableAPITermination enables termination protection of the instance, so that it cannot be
+	// terminated through the EC2 API or console. It is set at launch and kept in sync on the instance,
+	// and cleared when the AWSMachine is deleted. If not set, the controlPlaneDisableApiTermination
+	// of the AWSCluster applies to control plane machines.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
+	// DisableAPIStop enables stop protection of the instance, so that it cannot be stopped through
+	// the EC2 API or console. It is set at launch and kept in sync on the instance, and cleared when
+	// the AWSMachine is deleted. Spot instances do not support stop protection. If not set, the
+	// controlPlaneDisableApiStop of the AWSCluster applies to control plane machines.
+	// +optional
+	DisableAPIStop *bool `json:"disableApiStop,omitempty"`
+
+	// CPUOptions sets the number of CPU cores and threads per core of the instance.
+	// If not set, the defaults of the instance type are used.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// HibernationOptions enables the instance for hibernation.
+	// Hibernation cannot be combined with spot instances or Nitro Enclaves.
+	// +optional
+	HibernationOptions *HibernationOptions `json:"hibernationOptions,omitempty"`
+
+	// EnclaveOptions enables the instance for AWS Nitro Enclaves.
+	// +optional
+	EnclaveOptions *EnclaveOptions `json:"enclaveOptions,omitempty"`
+
+	// BootMode is the boot mode required for the instance. The boot mode is a property of the AMI,
+	// so when set the AMI and the instance type are checked to support it before the instance is created.
+	// Use uefi for UEFI Secure Boot and NitroTPM.
+	// +optional
+	// +kubebuilder:validation:Enum:=legacy-bios;uefi
+	BootMode BootMode `json:"bootMode,omitempty"`
+
+	// UseLaunchTemplate creates the instance from an EC2 launch template owned by the AWSMachine.
+	// The launch template describes the instance derived from this spec, gets a new version when
+	// the spec changes, and is deleted together with the AWSMachine.
+	// Cannot be combined with InstanceID or NetworkInterfaces.
+	// +optional
+	UseLaunchTemplate bool `json:"useLaunchTemplate,omitempty"`
+
+	// PrivateDNSName is the hostname type of the instance and the DNS records of its resource-based
+	// hostname. Defaults to the options of the subnet the instance is launched in.
+	// Resource-based hostnames are not supported for EKS nodes, which are always given IP-based hostnames.
+	// +optional
+	PrivateDNSName *PrivateDNSName `json:"privateDnsName,omitempty"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -179,11 +272,61 @@ type CloudInit struct {
 	SecretPrefix string `json:"secretPrefix,omitempty"`
 
 	// SecureSecretsBackend, when set to parameter-store will utilize the AWS Systems Manager
-	// Parameter Storage to distribute secrets. By default or with the value of secrets-manager,
-	// will use AWS Secrets Manager instead.
+	// Parameter Storage to distribute secrets. When set to s3, the userdata is stored as an
+	// SSE-KMS encrypted object in the S3 bucket of the AWSCluster, which requires the
+	// IAMInstanceProfile of the machine to be one of the profiles of the bucket.
+	// By default or with the value of secrets-manager, will use AWS Secrets Manager instead.
 	// +optional
-	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store
+	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store;s3
 	SecureSecretsBackend SecretBackend `json:"secureSecretsBackend,omitempty"`
+
+	// KMSKeyARN is the ARN of the customer managed AWS KMS key used to encrypt the secrets
+	// of the secrets-manager, ssm-parameter-store and s3 backends. Defaults to the
+	// bootstrapSecretsKmsKeyArn of the AWSCluster, or the AWS managed key of the backend.
+	// +optional
+	KMSKeyARN string `json:"kmsKeyArn,omitempty"`
+
+	// AdditionalParts is a list of parts appended in order to the multipart cloud-init userdata,
+	// after the parts fetching the bootstrap data from the secure secrets backend. The userdata
+	// is gzip compressed when it exceeds the 16KB limit of EC2, and must not exceed it compressed.
+	// +optional
+	AdditionalParts []CloudInitPart `json:"additionalParts,omitempty"`
+}
+
+// CloudInitPartContentType is the MIME content type of a cloud-init userdata part.
+type CloudInitPartContentType string
+
+var (
+	// CloudInitPartContentTypeShellScript is a shell script run by cloud-init once per instance.
+	CloudInitPartContentTypeShellScript = CloudInitPartContentType("text/x-shellscript")
+
+	// CloudInitPartContentTypeBoothook is a shell script run by cloud-init early on every boot.
+	CloudInitPartContentTypeBoothook = CloudInitPartContentType("text/cloud-boothook")
+
+	// CloudInitPartContentTypeIncludeURL is a list of URLs of userdata included by cloud-init.
+	CloudInitPartContentTypeIncludeURL = CloudInitPartContentType("text/x-include-url")
+
+	// CloudInitPartContentTypeCloudConfig is a cloud-config document merged by cloud-init.
+	CloudInitPartContentTypeCloudConfig = CloudInitPartContentType("text/cloud-config")
+)
+
+// CloudInitPart is an additional part of the multipart cloud-init userdata.
+// Exactly one of Data or Secret must be set.
+type CloudInitPart struct {
+	// Name is the file name of the part, which must be unique among the parts of the machine.
+	Name string `json:"name"`
+
+	// ContentType is the MIME content type of the part.
+	// +kubebuilder:validation:Enum=text/x-shellscript;text/cloud-boothook;text/x-include-url;text/cloud-config
+	ContentType CloudInitPartContentType `json:"contentType"`
+
+	// Data is the inline content of the part.
+	// +optional
+	Data string `json:"data,omitempty"`
+
+	// Secret selects a key of a Secret in the namespace of the machine holding the content of the part.
+	// +optional
+	Secret *corev1.SecretKeySelector `json:"secret,omitempty"`
 }
 
 // Ignition defines options related to the bootstrapping systems where Ignition is used.
@@ -192,8 +335,43 @@ type Ignition struct {
 	//
 	// +optional
 	// +kubebuilder:default="2.3"
-	// +kubebuilder:validation:Enum="2.3"
+	// +kubebuilder:validation:Enum="2.3";"3.0";"3.1";"3.2";"3.3"
 	Version string `json:"version,omitempty"`
+
+	// PresignedURL, when set, references the bootstrap data object in the generated Ignition config
+	// with a presigned HTTPS URL instead of its s3:// URL, so the IAM instance profile of the machine
+	// does not need permissions to read from the S3 bucket of the cluster.
+	// +optional
+	PresignedURL *IgnitionPresignedURL `json:"presignedURL,omitempty"`
+
+	// Snippets is a list of Ignition configs stored in Secrets or ConfigMaps in the namespace of the
+	// machine, which are merged in order into the generated Ignition config. The bootstrap data
+	// takes precedence over the snippets. Snippets require an Ignition version of 3.0 or later, and
+	// must not use a later config spec version than the generated config.
+	// +optional
+	Snippets []IgnitionSnippet `json:"snippets,omitempty"`
+}
+
+// IgnitionSnippet references an Ignition config stored in a key of a Secret or a ConfigMap.
+// Exactly one of Secret or ConfigMap must be set.
+type IgnitionSnippet struct {
+	// Secret selects a key of a Secret holding the Ignition config.
+	// +optional
+	Secret *corev1.SecretKeySelector `json:"secret,omitempty"`
+
+	// ConfigMap selects a key of a ConfigMap holding the Ignition config.
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty"`
+}
+
+// IgnitionPresignedURL configures the presigned URL of the Ignition bootstrap data object.
+type IgnitionPresignedURL struct {
+	// Expiration is how long the presigned URL stays valid after the instance is created. It has to
+	// cover the expected boot time of the instance until Ignition fetches its config, and must not
+	// exceed 7 days. Defaults to 1 hour. The URL is only valid as long as the credentials of the
+	// controller which signed it, so it is limited to the remaining lifetime of temporary credentials.
+	// +optional
+	Expiration *metav1.Duration `json:"expiration,omitempty"`
 }
 
 // AWSMachineStatus defines the observed state of AWSMachine.
@@ -214,6 +392,20 @@ type AWSMachineStatus struct {
 	// +optional
 	InstanceState *InstanceState `json:"instanceState,omitempty"`
 
+	// LaunchTemplateID is the ID of the launch template the instance is created from
+	// when UseLaunchTemplate is set.
+	// +optional
+	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
+
+	// LaunchTemplateVersion is the version of the launch template the instance is created from.
+	// +optional
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+
+	// RetainedVolumeIDs are the IDs of the retained volumes of the machine by name. They are recorded
+	// when the volumes are created, and the volumes are attached once they become available.
+	// +optional
+	RetainedVolumeIDs map[string]string `json:"retainedVolumeIDs,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -54,8 +54,15 @@ func (r *AWSMachine) ValidateCreate() error {
 	allErrs = append(allErrs, r.validateIgnitionAndCloudInit()...)
 	allErrs = append(allErrs, r.validateRootVolume()...)
 	allErrs = append(allErrs, r.validateNonRootVolumes()...)
+	allErrs = append(allErrs, r.validateRetainedVolumes()...)
+	allErrs = append(allErrs, r.validateInstanceOptions()...)
+	allErrs = append(allErrs, r.validateNetworkInterfaces()...)
+	allErrs = append(allErrs, r.validateLaunchTemplate()...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.Spec.AMI.Validate(field.NewPath("spec", "ami"))...)
+	allErrs = append(allErrs, r.Spec.AMI.ValidateArchitecture(field.NewPath("spec", "ami"), r.Spec.InstanceType)...)
+	allErrs = append(allErrs, validateDisableAPIStop(r.Spec.DisableAPIStop, r.Spec.SpotMarketOptions != nil, field.NewPath("spec", "disableApiStop"))...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
@@ -79,6 +86,7 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, r.validateCloudInitSecret()...)
+	allErrs = append(allErrs, validateDisableAPIStop(r.Spec.DisableAPIStop, r.Spec.SpotMarketOptions != nil, field.NewPath("spec", "disableApiStop"))...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
 	newAWSMachineSpec := newAWSMachine["spec"].(map[string]interface{})
@@ -100,6 +108,14 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
 
+	// allow changes to disableApiTermination
+	delete(oldAWSMachineSpec, "disableApiTermination")
+	delete(newAWSMachineSpec, "disableApiTermination")
+
+	// allow changes to disableApiStop
+	delete(oldAWSMachineSpec, "disableApiStop")
+	delete(newAWSMachineSpec, "disableApiStop")
+
 	// allow changes to secretPrefix, secretCount, and secureSecretsBackend
 	if cloudInit, ok := oldAWSMachineSpec["cloudInit"].(map[string]interface{}); ok {
 		delete(cloudInit, "secretPrefix")
@@ -133,6 +149,16 @@ func (r *AWSMachine) validateCloudInitSecret() field.ErrorList {
 		if r.Spec.CloudInit.SecureSecretsBackend != "" {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "cloudInit", "secureSecretsBackend"), "cannot be set if spec.cloudInit.insecureSkipSecretsManager is true"))
 		}
+		if r.Spec.CloudInit.KMSKeyARN != "" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "cloudInit", "kmsKeyArn"), "cannot be set if spec.cloudInit.insecureSkipSecretsManager is true"))
+		}
+	}
+
+	allErrs = append(allErrs, validateKMSKeyARN(r.Spec.CloudInit.KMSKeyARN, field.NewPath("spec", "cloudInit", "kmsKeyArn"))...)
+	allErrs = append(allErrs, validateCloudInitAdditionalParts(r.Spec.CloudInit, field.NewPath("spec", "cloudInit"))...)
+
+	if r.Spec.CloudInit.SecureSecretsBackend == SecretBackendS3 && r.Spec.IAMInstanceProfile == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "iamInstanceProfile"), "must be set when spec.cloudInit.secureSecretsBackend is s3"))
 	}
 
 	if (r.Spec.CloudInit.SecretPrefix != "") != (r.Spec.CloudInit.SecretCount != 0) {
@@ -148,7 +174,9 @@ func (r *AWSMachine) cloudInitConfigured() bool {
 	configured = configured || r.Spec.CloudInit.SecretPrefix != ""
 	configured = configured || r.Spec.CloudInit.SecretCount != 0
 	configured = configured || r.Spec.CloudInit.SecureSecretsBackend != ""
+	configured = configured || r.Spec.CloudInit.KMSKeyARN != ""
 	configured = configured || r.Spec.CloudInit.InsecureSkipSecretsManager
+	configured = configured || len(r.Spec.CloudInit.AdditionalParts) > 0
 
 	return configured
 }
@@ -170,6 +198,8 @@ func (r *AWSMachine) validateIgnitionAndCloudInit() field.ErrorList {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "cloudInit"), "cannot be set if spec.ignition is set"))
 	}
 
+	allErrs = append(allErrs, validateIgnition(r.Spec.Ignition, field.NewPath("spec", "ignition"))...)
+
 	return allErrs
 }
 
@@ -225,6 +255,89 @@ func (r *AWSMachine) validateNonRootVolumes() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachine) validateRetainedVolumes() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	deviceNames := map[string]bool{}
+	for _, volume := range r.Spec.NonRootVolumes {
+		deviceNames[volume.DeviceName] = true
+	}
+
+	for i, retained := range r.Spec.RetainedVolumes {
+		fldPath := field.NewPath("spec", "retainedVolumes").Index(i)
+
+		if names[retained.Name] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), retained.Name))
+		}
+		names[retained.Name] = true
+
+		if retained.Volume.DeviceName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("volume", "deviceName"), "retained volume should have device name"))
+		} else if deviceNames[retained.Volume.DeviceName] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("volume", "deviceName"), retained.Volume.DeviceName))
+		}
+		deviceNames[retained.Volume.DeviceName] = true
+
+		if VolumeTypesProvisioned.Has(string(retained.Volume.Type)) && retained.Volume.IOPS == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("volume", "iops"), "iops required if type is 'io1' or 'io2'"))
+		}
+
+		if retained.Volume.Throughput != nil && retained.Volume.Type != VolumeTypeGP3 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("volume", "throughput"), "throughput is valid only for type 'gp3'"))
+		}
+
+		if retained.Volume.DeleteOnTermination != nil && *retained.Volume.DeleteOnTermination {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("volume", "deleteOnTermination"), "retained volumes are never deleted on termination"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachine) validateInstanceOptions() field.ErrorList {
+	return InstanceOptions{
+		InstanceType:          r.Spec.InstanceType,
+		Spot:                  r.Spec.SpotMarketOptions != nil,
+		CPUOptions:            r.Spec.CPUOptions,
+		HibernationOptions:    r.Spec.HibernationOptions,
+		EnclaveOptions:        r.Spec.EnclaveOptions,
+		BootMode:              r.Spec.BootMode,
+		NetworkInterfaceSpecs: r.Spec.NetworkInterfaceSpecs,
+		Tenancy:               r.Spec.Tenancy,
+		HostID:                r.Spec.HostID,
+		HostResourceGroupArn:  r.Spec.HostResourceGroupArn,
+		HostAffinity:          r.Spec.HostAffinity,
+	}.Validate(field.NewPath("spec"))
+}
+
+// validateDisableAPIStop validates that stop protection is not enabled for spot instances, which do not support it.
+func validateDisableAPIStop(disableAPIStop *bool, spot bool, fldPath *field.Path) field.ErrorList {
+	if spot && disableAPIStop != nil && *disableAPIStop {
+		return field.ErrorList{field.Forbidden(fldPath, "cannot be set for spot instances")}
+	}
+	return nil
+}
+
+func (r *AWSMachine) validateNetworkInterfaces() field.ErrorList {
+	var allErrs field.ErrorList
+	if len(r.Spec.NetworkInterfaces) > 0 && len(r.Spec.NetworkInterfaceSpecs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "networkInterfaceSpecs"), "cannot be set together with spec.networkInterfaces"))
+	}
+	return allErrs
+}
+
+func (r *AWSMachine) validateLaunchTemplate() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.UseLaunchTemplate && r.Spec.InstanceID != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "useLaunchTemplate"), "cannot be set together with spec.instanceID"))
+	}
+	if r.Spec.UseLaunchTemplate && len(r.Spec.NetworkInterfaces) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "useLaunchTemplate"), "cannot be set together with spec.networkInterfaces"))
+	}
+	return allErrs
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (r *AWSMachine) ValidateDelete() error {
 	return nil
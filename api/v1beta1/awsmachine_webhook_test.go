@@ -43,6 +43,27 @@ func TestAWSMachine_Create(t *testing.T) {
 		machine *AWSMachine
 		wantErr bool
 	}{
+		{
+			name: "allow stop protection",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:   "test",
+					DisableAPIStop: aws.Bool(true),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "forbid stop protection of spot instances",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					SpotMarketOptions: &SpotMarketOptions{},
+					DisableAPIStop:    aws.Bool(true),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "ensure IOPS exists if type equal to io1",
 			machine: &AWSMachine{
@@ -147,6 +168,185 @@ func TestAWSMachine_Create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "ensure retained volumes have device names",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RetainedVolumes: []RetainedVolume{
+						{
+							Name:   "data",
+							Volume: Volume{Size: 100},
+						},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure retained volume names are unique",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RetainedVolumes: []RetainedVolume{
+						{
+							Name:   "data",
+							Volume: Volume{DeviceName: "/dev/sdb", Size: 100},
+						},
+						{
+							Name:   "data",
+							Volume: Volume{DeviceName: "/dev/sdc", Size: 100},
+						},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure retained volumes are not deleted on termination",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RetainedVolumes: []RetainedVolume{
+						{
+							Name:   "data",
+							Volume: Volume{DeviceName: "/dev/sdb", Size: 100, DeleteOnTermination: aws.Bool(true)},
+						},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "retained volumes with distinct names and device names are accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RetainedVolumes: []RetainedVolume{
+						{
+							Name:   "data",
+							Volume: Volume{DeviceName: "/dev/sdb", Size: 100},
+						},
+						{
+							Name:   "cache",
+							Volume: Volume{DeviceName: "/dev/sdc", Size: 100, SnapshotID: "snap-1"},
+						},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ami may be looked up through an SSM parameter",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI:          AMIReference{SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sVersion}}/x86_64/latest/image_id")},
+					InstanceType: "test",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ami may be looked up through owners and filters",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI: AMIReference{
+						Owners:  []string{"aws-marketplace"},
+						Filters: []Filter{{Name: "name", Values: []string{"CIS Ubuntu Linux 20.04*"}}},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ami cannot be looked up in more than one way",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI: AMIReference{
+						ID:           aws.String("ami-1"),
+						SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-1.22/x86_64/latest/image_id"),
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ami SSM parameter must be a valid template",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI:          AMIReference{SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sVersion/image_id")},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ami architecture filter must match the architecture of the instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI: AMIReference{
+						Owners:  []string{"aws-marketplace"},
+						Filters: []Filter{{Name: "architecture", Values: []string{"x86_64"}}},
+					},
+					InstanceType: "m6g.large",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ami SSM parameter architecture must match the architecture of the instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI:          AMIReference{SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sVersion}}/arm64/latest/image_id")},
+					InstanceType: "m6g.large",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "eks GPU ami lookup is rejected for graviton instance types",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI:          AMIReference{EKSOptimizedLookupType: (*EKSAMILookupType)(aws.String(string(AmazonLinuxGPU)))},
+					InstanceType: "g5g.xlarge",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template can be used",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					UseLaunchTemplate: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "launch template cannot be used when adopting an instance",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					InstanceID:        aws.String("i-1234"),
+					UseLaunchTemplate: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template cannot be used with existing network interfaces",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					NetworkInterfaces: []string{"eni-1234"},
+					UseLaunchTemplate: true,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "additional security groups may have id",
 			machine: &AWSMachine{
@@ -231,6 +431,192 @@ func TestAWSMachine_Create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid cpu options are accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.xlarge",
+					CPUOptions: &CPUOptions{
+						CoreCount:      2,
+						ThreadsPerCore: 1,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "graviton instance types support one thread per core only",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m6g.xlarge",
+					CPUOptions: &CPUOptions{
+						CoreCount:      2,
+						ThreadsPerCore: 2,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "hibernation is not allowed for spot instances",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "m5.large",
+					SpotMarketOptions:  &SpotMarketOptions{},
+					HibernationOptions: &HibernationOptions{Configured: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "hibernation is not allowed together with enclaves",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "m5.xlarge",
+					HibernationOptions: &HibernationOptions{Configured: true},
+					EnclaveOptions:     &EnclaveOptions{Enabled: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "enclaves are not allowed on burstable instance types",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:   "t3.large",
+					EnclaveOptions: &EnclaveOptions{Enabled: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "legacy bios boot mode is not allowed on graviton instance types",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "c6g.large",
+					BootMode:     BootModeLegacyBIOS,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "uefi boot mode is accepted on nitro instance types",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					BootMode:     BootModeUEFI,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "network interface specs with an elastic fabric adapter are accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "c5n.18xlarge",
+					NetworkInterfaceSpecs: []NetworkInterfaceSpec{
+						{DeviceIndex: 0, InterfaceType: NetworkInterfaceTypeEFA},
+						{DeviceIndex: 1, IPv4PrefixCount: aws.Int64(4)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "network interface specs must contain the primary interface",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					NetworkInterfaceSpecs: []NetworkInterfaceSpec{
+						{DeviceIndex: 1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "network interface specs must have unique device indexes",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					NetworkInterfaceSpecs: []NetworkInterfaceSpec{
+						{DeviceIndex: 0},
+						{DeviceIndex: 0},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "network interface specs cannot combine secondary addresses and prefixes",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					NetworkInterfaceSpecs: []NetworkInterfaceSpec{
+						{DeviceIndex: 0, SecondaryPrivateIPAddressCount: aws.Int64(2), IPv4PrefixCount: aws.Int64(1)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "network interface specs cannot be combined with network interfaces",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "m5.large",
+					NetworkInterfaces: []string{"eni-1"},
+					NetworkInterfaceSpecs: []NetworkInterfaceSpec{
+						{DeviceIndex: 0},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "host ID requires tenancy host",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					Tenancy:      "dedicated",
+					HostID:       aws.String("h-1"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "host ID cannot be combined with a host resource group",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:         "m5.large",
+					Tenancy:              "host",
+					HostID:               aws.String("h-1"),
+					HostResourceGroupArn: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/hosts"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "host affinity requires tenancy host",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					HostAffinity: "host",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an instance on a dedicated host",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					Tenancy:      "host",
+					HostID:       aws.String("h-1"),
+					HostAffinity: "host",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid tags return error",
 			machine: &AWSMachine{
@@ -246,6 +632,68 @@ func TestAWSMachine_Create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid KMS key ARN is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CloudInit: CloudInit{
+						KMSKeyARN: "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "KMS key alias returns error",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CloudInit: CloudInit{
+						KMSKeyARN: "arn:aws:kms:us-east-1:123456789012:alias/bootstrap",
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "KMS key ARN with insecure skip secrets manager returns error",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CloudInit: CloudInit{
+						InsecureSkipSecretsManager: true,
+						KMSKeyARN:                  "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3 secure secrets backend without IAM instance profile returns error",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CloudInit: CloudInit{
+						SecureSecretsBackend: SecretBackendS3,
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3 secure secrets backend with IAM instance profile is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CloudInit: CloudInit{
+						SecureSecretsBackend: SecretBackendS3,
+					},
+					IAMInstanceProfile: "nodes.cluster-api-provider-aws.sigs.k8s.io",
+					InstanceType:       "test",
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -327,6 +775,53 @@ func TestAWSMachine_Update(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in termination protection",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:          "test",
+					DisableAPITermination: pointer.BoolPtr(true),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in stop protection",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:   "test",
+					DisableAPIStop: pointer.BoolPtr(true),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "stop protection of spot instances",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					SpotMarketOptions: &SpotMarketOptions{},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					SpotMarketOptions: &SpotMarketOptions{},
+					DisableAPIStop:    pointer.BoolPtr(true),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "change in tags adding invalid ones",
 			oldMachine: &AWSMachine{
@@ -25,11 +25,24 @@ import (
 // AWSMachineTemplateSpec defines the desired state of AWSMachineTemplate.
 type AWSMachineTemplateSpec struct {
 	Template AWSMachineTemplateResource `json:"template"`
+
+	// AMIUpdatePolicy periodically re-runs the AMI lookup of the template and reports the most recent AMI
+	// in the status. Only the report mode is supported.
+	// +optional
+	AMIUpdatePolicy *AMIUpdatePolicy `json:"amiUpdatePolicy,omitempty"`
+}
+
+// AWSMachineTemplateStatus defines the observed state of AWSMachineTemplate.
+type AWSMachineTemplateStatus struct {
+	// LatestAMIAvailable is the most recent AMI found by the lookup of the AMI update policy.
+	// +optional
+	LatestAMIAvailable *LatestAMIStatus `json:"latestAMIAvailable,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=awsmachinetemplates,scope=Namespaced,categories=cluster-api,shortName=awsmt
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
 // +k8s:defaulter-gen=true
 
 // AWSMachineTemplate is the schema for the Amazon EC2 Machine Templates API.
@@ -37,7 +50,8 @@ type AWSMachineTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec AWSMachineTemplateSpec `json:"spec,omitempty"`
+	Spec   AWSMachineTemplateSpec   `json:"spec,omitempty"`
+	Status AWSMachineTemplateStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
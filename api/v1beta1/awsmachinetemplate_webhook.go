@@ -94,6 +94,26 @@ func (r *AWSMachineTemplate) validateNonRootVolumes() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachineTemplate) validateAMIUpdatePolicy() field.ErrorList {
+	var allErrs field.ErrorList
+
+	policy := r.Spec.AMIUpdatePolicy
+	if policy == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "amiUpdatePolicy")
+	if r.Spec.Template.Spec.AMI.ID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set if spec.template.spec.ami.id is set"))
+	}
+	if policy.Mode == AMIUpdateModeAuto {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("mode"), "auto mode is not supported by AWSMachineTemplates, as templates are immutable"))
+	}
+	allErrs = append(allErrs, policy.Validate(fldPath)...)
+
+	return allErrs
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *AWSMachineTemplate) ValidateCreate() error {
 	var allErrs field.ErrorList
@@ -113,6 +133,34 @@ func (r *AWSMachineTemplate) ValidateCreate() error {
 
 	allErrs = append(allErrs, r.validateRootVolume()...)
 	allErrs = append(allErrs, r.validateNonRootVolumes()...)
+	allErrs = append(allErrs, InstanceOptions{
+		InstanceType:          spec.InstanceType,
+		Spot:                  spec.SpotMarketOptions != nil,
+		CPUOptions:            spec.CPUOptions,
+		HibernationOptions:    spec.HibernationOptions,
+		EnclaveOptions:        spec.EnclaveOptions,
+		BootMode:              spec.BootMode,
+		NetworkInterfaceSpecs: spec.NetworkInterfaceSpecs,
+		Tenancy:               spec.Tenancy,
+		HostID:                spec.HostID,
+		HostResourceGroupArn:  spec.HostResourceGroupArn,
+		HostAffinity:          spec.HostAffinity,
+	}.Validate(field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, spec.AMI.Validate(field.NewPath("spec", "template", "spec", "ami"))...)
+	allErrs = append(allErrs, spec.AMI.ValidateArchitecture(field.NewPath("spec", "template", "spec", "ami"), spec.InstanceType)...)
+	allErrs = append(allErrs, r.validateAMIUpdatePolicy()...)
+	allErrs = append(allErrs, validateDisableAPIStop(spec.DisableAPIStop, spec.SpotMarketOptions != nil, field.NewPath("spec", "template", "spec", "disableApiStop"))...)
+	allErrs = append(allErrs, validateKMSKeyARN(spec.CloudInit.KMSKeyARN, field.NewPath("spec", "template", "spec", "cloudInit", "kmsKeyArn"))...)
+	allErrs = append(allErrs, validateCloudInitAdditionalParts(spec.CloudInit, field.NewPath("spec", "template", "spec", "cloudInit"))...)
+
+	if spec.CloudInit.SecureSecretsBackend == SecretBackendS3 && spec.IAMInstanceProfile == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "template", "spec", "iamInstanceProfile"), "must be set when spec.template.spec.cloudInit.secureSecretsBackend is s3"))
+	}
+
+	if len(spec.NetworkInterfaces) > 0 && len(spec.NetworkInterfaceSpecs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "networkInterfaceSpecs"),
+			"cannot be set together with spec.template.spec.networkInterfaces"))
+	}
 
 	// Feature gate is not enabled but ignition is enabled then send a forbidden error.
 	if !feature.Gates.Enabled(feature.BootstrapFormatIgnition) && spec.Ignition != nil {
@@ -120,12 +168,15 @@ func (r *AWSMachineTemplate) ValidateCreate() error {
 			"can be set only if the BootstrapFormatIgnition feature gate is enabled"))
 	}
 
-	cloudInitConfigured := spec.CloudInit.SecureSecretsBackend != "" || spec.CloudInit.InsecureSkipSecretsManager
+	cloudInitConfigured := spec.CloudInit.SecureSecretsBackend != "" || spec.CloudInit.InsecureSkipSecretsManager || spec.CloudInit.KMSKeyARN != "" ||
+		len(spec.CloudInit.AdditionalParts) > 0
 	if cloudInitConfigured && spec.Ignition != nil {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "cloudInit"),
 			"cannot be set if spec.template.spec.ignition is set"))
 	}
 
+	allErrs = append(allErrs, validateIgnition(spec.Ignition, field.NewPath("spec", "template", "spec", "ignition"))...)
+
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
@@ -138,11 +189,11 @@ func (r *AWSMachineTemplate) ValidateUpdate(old runtime.Object) error {
 		r.Spec.Template.Spec.CloudInit.SecureSecretsBackend = ""
 	}
 
-	if !cmp.Equal(r.Spec, oldAWSMachineTemplate.Spec) {
-		return apierrors.NewBadRequest("AWSMachineTemplate.Spec is immutable")
+	if !cmp.Equal(r.Spec.Template, oldAWSMachineTemplate.Spec.Template) {
+		return apierrors.NewBadRequest("AWSMachineTemplate.Spec.Template is immutable")
 	}
 
-	return nil
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, r.validateAMIUpdatePolicy())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -60,6 +60,52 @@ func TestAWSMachineTemplateValidateCreate(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "allow AMI update policy in report mode",
+			inputTemplate: &AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: AWSMachineTemplateSpec{
+					Template: AWSMachineTemplateResource{
+						Spec: AWSMachineSpec{
+							InstanceType: "test",
+						},
+					},
+					AMIUpdatePolicy: &AMIUpdatePolicy{Mode: AMIUpdateModeReport},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "don't allow AMI update policy in auto mode",
+			inputTemplate: &AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: AWSMachineTemplateSpec{
+					Template: AWSMachineTemplateResource{
+						Spec: AWSMachineSpec{
+							InstanceType: "test",
+						},
+					},
+					AMIUpdatePolicy: &AMIUpdatePolicy{Mode: AMIUpdateModeAuto},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "don't allow AMI update policy with an AMI ID",
+			inputTemplate: &AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: AWSMachineTemplateSpec{
+					Template: AWSMachineTemplateResource{
+						Spec: AWSMachineSpec{
+							AMI:          AMIReference{ID: pointer.StringPtr("ami-1234")},
+							InstanceType: "test",
+						},
+					},
+					AMIUpdatePolicy: &AMIUpdatePolicy{Mode: AMIUpdateModeReport},
+				},
+			},
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -116,6 +162,22 @@ func TestAWSMachineTemplateValidateUpdate(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "allow adding an AMI update policy",
+			modifiedTemplate: &AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: AWSMachineTemplateSpec{
+					Template: AWSMachineTemplateResource{
+						Spec: AWSMachineSpec{
+							CloudInit:    CloudInit{},
+							InstanceType: "test",
+						},
+					},
+					AMIUpdatePolicy: &AMIUpdatePolicy{Mode: AMIUpdateModeReport},
+				},
+			},
+			wantError: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -46,6 +46,20 @@ func (b *Bastion) Validate() []*field.Error {
 			)
 		}
 	}
+
+	if b.AMILookup != nil {
+		amiLookupPath := field.NewPath("spec", "bastion", "amiLookup")
+		if b.AMI != "" {
+			errs = append(errs, field.Forbidden(amiLookupPath, "cannot be set together with spec.bastion.ami"))
+		}
+		if b.AMILookup.ID != nil {
+			errs = append(errs, field.Forbidden(amiLookupPath.Child("id"), "use spec.bastion.ami instead"))
+		}
+		if b.AMILookup.EKSOptimizedLookupType != nil {
+			errs = append(errs, field.Forbidden(amiLookupPath.Child("eksLookupType"), "is not supported for the bastion"))
+		}
+		errs = append(errs, b.AMILookup.Validate(amiLookupPath)...)
+	}
 	return errs
 }
 
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateCloudInitAdditionalParts validates the additional cloud-init userdata parts of a machine.
+func validateCloudInitAdditionalParts(cloudInit CloudInit, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(cloudInit.AdditionalParts) > 0 && cloudInit.InsecureSkipSecretsManager {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalParts"), "cannot be set if insecureSkipSecretsManager is true"))
+	}
+
+	names := sets.NewString()
+	for i, part := range cloudInit.AdditionalParts {
+		partPath := fldPath.Child("additionalParts").Index(i)
+
+		switch {
+		case part.Name == "":
+			allErrs = append(allErrs, field.Required(partPath.Child("name"), "can't be empty"))
+		case strings.ContainsAny(part.Name, "/\"\r\n"):
+			allErrs = append(allErrs, field.Invalid(partPath.Child("name"), part.Name, "must be a file name"))
+		case names.Has(part.Name):
+			allErrs = append(allErrs, field.Duplicate(partPath.Child("name"), part.Name))
+		}
+		names.Insert(part.Name)
+
+		switch {
+		case part.Data != "" && part.Secret != nil:
+			allErrs = append(allErrs, field.Invalid(partPath, part.Name, "only one of data or secret may be set"))
+		case part.Secret != nil:
+			allErrs = append(allErrs, validateKeySelector(part.Secret.Name, part.Secret.Key, partPath.Child("secret"))...)
+		case part.Data == "":
+			allErrs = append(allErrs, field.Required(partPath, "one of data or secret must be set"))
+		}
+	}
+
+	return allErrs
+}
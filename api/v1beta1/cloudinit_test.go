@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateCloudInitAdditionalParts(t *testing.T) {
+	inlinePart := CloudInitPart{
+		Name:        "hello.sh",
+		ContentType: CloudInitPartContentTypeShellScript,
+		Data:        "#!/bin/sh\necho hello\n",
+	}
+	secretPart := CloudInitPart{
+		Name:        "credentials.cfg",
+		ContentType: CloudInitPartContentTypeCloudConfig,
+		Secret:      &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "cloud-config"},
+	}
+
+	tests := []struct {
+		name      string
+		cloudInit CloudInit
+		wantError bool
+	}{
+		{
+			name:      "no additional parts",
+			cloudInit: CloudInit{},
+			wantError: false,
+		},
+		{
+			name:      "inline and secret parts",
+			cloudInit: CloudInit{AdditionalParts: []CloudInitPart{inlinePart, secretPart}},
+			wantError: false,
+		},
+		{
+			name:      "parts without the secure secrets backend",
+			cloudInit: CloudInit{InsecureSkipSecretsManager: true, AdditionalParts: []CloudInitPart{inlinePart}},
+			wantError: true,
+		},
+		{
+			name:      "part without a name",
+			cloudInit: CloudInit{AdditionalParts: []CloudInitPart{{ContentType: CloudInitPartContentTypeShellScript, Data: "echo"}}},
+			wantError: true,
+		},
+		{
+			name:      "part with a path as name",
+			cloudInit: CloudInit{AdditionalParts: []CloudInitPart{{Name: "scripts/hello.sh", ContentType: CloudInitPartContentTypeShellScript, Data: "echo"}}},
+			wantError: true,
+		},
+		{
+			name:      "parts with duplicate names",
+			cloudInit: CloudInit{AdditionalParts: []CloudInitPart{inlinePart, inlinePart}},
+			wantError: true,
+		},
+		{
+			name:      "part without content",
+			cloudInit: CloudInit{AdditionalParts: []CloudInitPart{{Name: "empty.sh", ContentType: CloudInitPartContentTypeShellScript}}},
+			wantError: true,
+		},
+		{
+			name: "part with both data and a secret",
+			cloudInit: CloudInit{AdditionalParts: []CloudInitPart{
+				{Name: "both.cfg", ContentType: CloudInitPartContentTypeCloudConfig, Data: "#cloud-config", Secret: secretPart.Secret},
+			}},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateCloudInitAdditionalParts(tt.cloudInit, field.NewPath("spec", "cloudInit"))
+			if (len(errs) > 0) != tt.wantError {
+				t.Errorf("validateCloudInitAdditionalParts() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
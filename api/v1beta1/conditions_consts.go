@@ -104,6 +104,15 @@ const (
 	BastionHostFailedReason = "BastionHostFailed"
 )
 
+const (
+	// DedicatedHostsReadyCondition reports whether the dedicated hosts of the cluster are allocated. Depending on the
+	// configuration, a cluster may not have dedicated hosts and this condition will be skipped. The condition is
+	// removed once all hosts are released after the dedicated hosts were removed from the spec.
+	DedicatedHostsReadyCondition clusterv1.ConditionType = "DedicatedHostsReady"
+	// DedicatedHostsAllocationFailedReason used when an error occurs while allocating or releasing dedicated hosts.
+	DedicatedHostsAllocationFailedReason = "DedicatedHostsAllocationFailed"
+)
+
 const (
 	// LoadBalancerReadyCondition reports on whether a control plane load balancer was successfully reconciled.
 	LoadBalancerReadyCondition clusterv1.ConditionType = "LoadBalancerReady"
@@ -137,6 +146,54 @@ const (
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
 )
 
+const (
+	// InstanceReachableCondition reports on the EC2 system and instance status checks of a running instance.
+	// It is informational only: it is not part of the Ready summary, and MachineHealthChecks only evaluate the
+	// conditions of Nodes, so failing status checks do not trigger a remediation. A warning event is recorded
+	// when the status checks start failing.
+	InstanceReachableCondition clusterv1.ConditionType = "InstanceReachable"
+
+	// InstanceStatusChecksInitializingReason used while the status checks of a new instance are initializing.
+	InstanceStatusChecksInitializingReason = "InstanceStatusChecksInitializing"
+	// SystemStatusImpairedReason used when the system status check, i.e. the AWS infrastructure hosting the instance, fails.
+	SystemStatusImpairedReason = "SystemStatusImpaired"
+	// InstanceStatusImpairedReason used when the instance status check, i.e. the operating system of the instance, fails.
+	InstanceStatusImpairedReason = "InstanceStatusImpaired"
+	// InstanceStatusUnavailableReason used when EC2 does not report a conclusive result of the status checks,
+	// e.g. due to insufficient data.
+	InstanceStatusUnavailableReason = "InstanceStatusUnavailable"
+
+	// NoScheduledEventsCondition reports whether AWS has scheduled events, e.g. a reboot or retirement, for the instance.
+	NoScheduledEventsCondition clusterv1.ConditionType = "NoScheduledEvents"
+
+	// InstanceEventScheduledReason used when events are scheduled for the instance. The message lists the events and their dates.
+	InstanceEventScheduledReason = "InstanceEventScheduled"
+)
+
+const (
+	// NoSpotInterruptionCondition reports whether AWS warned that the spot instance of an AWSMachine is about to be
+	// interrupted, or recommended to rebalance it. It is only set on AWSMachines with SpotMarketOptions when the
+	// EventBridgeInstanceState feature is enabled, and is not part of the Ready summary.
+	NoSpotInterruptionCondition clusterv1.ConditionType = "NoSpotInterruption"
+
+	// SpotInterruptionWarningReason used when the spot instance is interrupted within two minutes.
+	SpotInterruptionWarningReason = "SpotInterruptionWarning"
+	// RebalanceRecommendationReason used when the spot instance is at an elevated risk of interruption.
+	RebalanceRecommendationReason = "RebalanceRecommendation"
+)
+
+const (
+	// InstanceAdoptedCondition reports on the adoption of an existing EC2 instance referenced by Spec.InstanceID.
+	// It is only set on AWSMachines adopting an instance which was not launched by CAPA.
+	InstanceAdoptedCondition clusterv1.ConditionType = "InstanceAdopted"
+
+	// InstanceAdoptionMismatchReason used when the instance to adopt does not match the AWSMachine spec.
+	// The message lists the mismatches.
+	InstanceAdoptionMismatchReason = "InstanceAdoptionMismatch"
+	// InstanceAdoptionFailedReason used when the instance to adopt could not be described or tagged.
+	InstanceAdoptionFailedReason = "InstanceAdoptionFailed"
+)
+
 const (
 	// SecurityGroupsReadyCondition indicates the security groups are up to date on the AWSMachine.
 	SecurityGroupsReadyCondition clusterv1.ConditionType = "SecurityGroupsReady"
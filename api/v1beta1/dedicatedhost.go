@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func validateDedicatedHosts(hosts []DedicatedHostSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make(map[string]struct{}, len(hosts))
+	for i, host := range hosts {
+		if _, ok := names[host.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), host.Name))
+		}
+		names[host.Name] = struct{}{}
+
+		if (host.InstanceType == "") == (host.InstanceFamily == "") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), host.Name, "exactly one of instanceType and instanceFamily must be set"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateIgnition validates the Ignition options of a machine.
+func validateIgnition(ignition *Ignition, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if ignition == nil {
+		return allErrs
+	}
+
+	if ignition.PresignedURL != nil && ignition.PresignedURL.Expiration != nil {
+		expiration := ignition.PresignedURL.Expiration.Duration
+		if expiration <= 0 || expiration > MaxIgnitionPresignedURLExpiration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("presignedURL", "expiration"), expiration.String(),
+				fmt.Sprintf("must be greater than 0 and at most %s", MaxIgnitionPresignedURLExpiration)))
+		}
+	}
+
+	if len(ignition.Snippets) > 0 && !IsIgnitionV3(ignition.Version) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("snippets"),
+			fmt.Sprintf("snippets are not supported with Ignition version %q, use version 3.0 or later", ignitionVersion(ignition))))
+	}
+
+	for i, snippet := range ignition.Snippets {
+		snippetPath := fldPath.Child("snippets").Index(i)
+
+		switch {
+		case snippet.Secret != nil && snippet.ConfigMap != nil:
+			allErrs = append(allErrs, field.Invalid(snippetPath, snippet, "only one of secret or configMap may be set"))
+		case snippet.Secret != nil:
+			allErrs = append(allErrs, validateKeySelector(snippet.Secret.Name, snippet.Secret.Key, snippetPath.Child("secret"))...)
+		case snippet.ConfigMap != nil:
+			allErrs = append(allErrs, validateKeySelector(snippet.ConfigMap.Name, snippet.ConfigMap.Key, snippetPath.Child("configMap"))...)
+		default:
+			allErrs = append(allErrs, field.Required(snippetPath, "one of secret or configMap must be set"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateKeySelector(name, key string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "can't be empty"))
+	}
+
+	if key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "can't be empty"))
+	}
+
+	return allErrs
+}
+
+// IsIgnitionV3 returns true if the Ignition version is a config spec 3.x version.
+func IsIgnitionV3(version string) bool {
+	return strings.HasPrefix(version, "3.")
+}
+
+func ignitionVersion(ignition *Ignition) string {
+	if ignition.Version == "" {
+		return DefaultIgnitionVersion
+	}
+
+	return ignition.Version
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateIgnition(t *testing.T) {
+	secretSnippet := IgnitionSnippet{
+		Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "units"}, Key: "config.ign"},
+	}
+	configMapSnippet := IgnitionSnippet{
+		ConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "files"}, Key: "config.ign"},
+	}
+
+	tests := []struct {
+		name      string
+		ignition  *Ignition
+		wantError bool
+	}{
+		{
+			name:      "nil ignition",
+			ignition:  nil,
+			wantError: false,
+		},
+		{
+			name:      "presigned URL with default expiration",
+			ignition:  &Ignition{Version: "2.3", PresignedURL: &IgnitionPresignedURL{}},
+			wantError: false,
+		},
+		{
+			name:      "presigned URL expiring after 7 days",
+			ignition:  &Ignition{Version: "2.3", PresignedURL: &IgnitionPresignedURL{Expiration: &metav1.Duration{Duration: MaxIgnitionPresignedURLExpiration}}},
+			wantError: false,
+		},
+		{
+			name:      "presigned URL expiring immediately",
+			ignition:  &Ignition{Version: "2.3", PresignedURL: &IgnitionPresignedURL{Expiration: &metav1.Duration{}}},
+			wantError: true,
+		},
+		{
+			name:      "presigned URL expiring after more than 7 days",
+			ignition:  &Ignition{Version: "2.3", PresignedURL: &IgnitionPresignedURL{Expiration: &metav1.Duration{Duration: 8 * 24 * time.Hour}}},
+			wantError: true,
+		},
+		{
+			name:      "snippets with Ignition v3",
+			ignition:  &Ignition{Version: "3.3", Snippets: []IgnitionSnippet{secretSnippet, configMapSnippet}},
+			wantError: false,
+		},
+		{
+			name:      "snippets with Ignition v2",
+			ignition:  &Ignition{Version: "2.3", Snippets: []IgnitionSnippet{secretSnippet}},
+			wantError: true,
+		},
+		{
+			name:      "snippets with default version",
+			ignition:  &Ignition{Snippets: []IgnitionSnippet{secretSnippet}},
+			wantError: true,
+		},
+		{
+			name:      "snippet without a source",
+			ignition:  &Ignition{Version: "3.3", Snippets: []IgnitionSnippet{{}}},
+			wantError: true,
+		},
+		{
+			name: "snippet with both a secret and a config map",
+			ignition: &Ignition{Version: "3.3", Snippets: []IgnitionSnippet{
+				{Secret: secretSnippet.Secret, ConfigMap: configMapSnippet.ConfigMap},
+			}},
+			wantError: true,
+		},
+		{
+			name: "snippet without a key",
+			ignition: &Ignition{Version: "3.3", Snippets: []IgnitionSnippet{
+				{Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "units"}}},
+			}},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateIgnition(tt.ignition, field.NewPath("spec", "ignition"))
+			if (len(errs) > 0) != tt.wantError {
+				t.Errorf("validateIgnition() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
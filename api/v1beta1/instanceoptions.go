@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	// gravitonFamilyRegex matches the AWS Graviton instance families, e.g. m6g, c6gn, t4g or x2gd.
+	gravitonFamilyRegex = regexp.MustCompile(`^[a-z]+[0-9]+[a-z]*g[a-z]*$`)
+
+	// burstableFamilyRegex matches the burstable performance instance families, e.g. t3 or t4g.
+	burstableFamilyRegex = regexp.MustCompile(`^t[0-9]+[a-z]*$`)
+
+	// xenFamilies are the instance families built on the Xen hypervisor, which only boot in legacy BIOS mode.
+	xenFamilies = sets.NewString(
+		"c1", "c3", "c4", "cc2", "cr1", "d2", "f1", "g2", "g3", "g3s", "h1", "hs1",
+		"i2", "i3", "m1", "m2", "m3", "m4", "p2", "p3", "r3", "r4", "t1", "t2", "x1", "x1e",
+	)
+)
+
+// InstanceOptions groups the options of an instance which depend on the instance type and AMI.
+// +kubebuilder:object:generate=false
+type InstanceOptions struct {
+	// InstanceType is the type of the instance, e.g. m5.large. It may be empty.
+	InstanceType string
+	// Spot indicates the instance may run on spot capacity.
+	Spot               bool
+	CPUOptions         *CPUOptions
+	HibernationOptions *HibernationOptions
+	EnclaveOptions     *EnclaveOptions
+	BootMode           BootMode
+	// NetworkInterfaceSpecs are the network interfaces created at launch of the instance.
+	NetworkInterfaceSpecs []NetworkInterfaceSpec
+	// Tenancy, HostID, HostResourceGroupArn and HostAffinity place the instance on dedicated hosts.
+	Tenancy              string
+	HostID               *string
+	HostResourceGroupArn *string
+	HostAffinity         string
+}
+
+// Validate validates the instance options against each other and against what is known about
+// the instance type without calling AWS. The combination with the AMI is checked when the
+// instance or launch template is created.
+func (o InstanceOptions) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	family, size := splitInstanceType(o.InstanceType)
+	graviton := InstanceTypeArchitecture(o.InstanceType) == ArchitectureArm64
+	bareMetal := strings.HasPrefix(size, "metal")
+
+	if o.CPUOptions != nil {
+		if o.CPUOptions.CoreCount < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuOptions", "coreCount"), o.CPUOptions.CoreCount, "must be at least 1"))
+		}
+		if o.CPUOptions.ThreadsPerCore < 1 || o.CPUOptions.ThreadsPerCore > 2 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuOptions", "threadsPerCore"), o.CPUOptions.ThreadsPerCore, "must be 1 or 2"))
+		} else if graviton && o.CPUOptions.ThreadsPerCore != 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuOptions", "threadsPerCore"), o.CPUOptions.ThreadsPerCore, "instance type "+o.InstanceType+" supports one thread per core only"))
+		}
+		if bareMetal {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("cpuOptions"), "cannot be set for bare metal instance types"))
+		}
+	}
+
+	hibernation := o.HibernationOptions != nil && o.HibernationOptions.Configured
+	enclave := o.EnclaveOptions != nil && o.EnclaveOptions.Enabled
+
+	if hibernation {
+		if enclave {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hibernationOptions"), "cannot be set together with enclaveOptions"))
+		}
+		if o.Spot {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hibernationOptions"), "cannot be set for spot instances"))
+		}
+		if bareMetal {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hibernationOptions"), "cannot be set for bare metal instance types"))
+		}
+	}
+
+	if enclave && (bareMetal || family == "a1" || burstableFamilyRegex.MatchString(family)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enclaveOptions"), "instance type "+o.InstanceType+" does not support Nitro Enclaves"))
+	}
+
+	switch o.BootMode {
+	case "":
+	case BootModeLegacyBIOS:
+		if graviton {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bootMode"), o.BootMode, "instance type "+o.InstanceType+" only supports uefi"))
+		}
+	case BootModeUEFI:
+		if xenFamilies.Has(family) && !bareMetal {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bootMode"), o.BootMode, "instance type "+o.InstanceType+" only supports legacy-bios"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("bootMode"), o.BootMode, []string{string(BootModeLegacyBIOS), string(BootModeUEFI)}))
+	}
+
+	allErrs = append(allErrs, validateNetworkInterfaceSpecs(o.NetworkInterfaceSpecs, fldPath.Child("networkInterfaceSpecs"))...)
+	allErrs = append(allErrs, o.validateHostPlacement(fldPath)...)
+
+	return allErrs
+}
+
+func (o InstanceOptions) validateHostPlacement(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if o.Tenancy != "host" {
+		if o.HostID != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostID"), "requires tenancy host"))
+		}
+		if o.HostResourceGroupArn != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostResourceGroupArn"), "requires tenancy host"))
+		}
+		if o.HostAffinity == "host" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostAffinity"), "requires tenancy host"))
+		}
+		return allErrs
+	}
+
+	if o.HostID != nil && o.HostResourceGroupArn != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostResourceGroupArn"), "cannot be set together with hostID"))
+	}
+	if o.Spot {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tenancy"), "spot instances cannot run on dedicated hosts"))
+	}
+
+	return allErrs
+}
+
+func validateNetworkInterfaceSpecs(specs []NetworkInterfaceSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(specs) == 0 {
+		return allErrs
+	}
+
+	deviceIndexes := make(map[int64]struct{}, len(specs))
+	for i, spec := range specs {
+		if _, ok := deviceIndexes[spec.DeviceIndex]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("deviceIndex"), spec.DeviceIndex))
+		}
+		deviceIndexes[spec.DeviceIndex] = struct{}{}
+
+		if spec.SecondaryPrivateIPAddressCount != nil && spec.IPv4PrefixCount != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("ipv4PrefixCount"), "cannot be set together with secondaryPrivateIPAddressCount"))
+		}
+	}
+
+	if _, ok := deviceIndexes[0]; !ok {
+		allErrs = append(allErrs, field.Required(fldPath, "must contain the primary network interface with device index 0"))
+	}
+
+	return allErrs
+}
+
+// InstanceTypeArchitecture returns the processor architecture of the instance type as inferred
+// from its family, e.g. arm64 for m6g.large. It returns an empty string if the architecture
+// cannot be inferred. The controllers look up the architecture with DescribeInstanceTypes instead.
+func InstanceTypeArchitecture(instanceType string) Architecture {
+	family, _ := splitInstanceType(instanceType)
+	switch {
+	case family == "" || strings.HasPrefix(family, "mac"):
+		return ""
+	case family == "a1" || gravitonFamilyRegex.MatchString(family):
+		return ArchitectureArm64
+	default:
+		return ArchitectureX86_64
+	}
+}
+
+// splitInstanceType splits an instance type such as m5.large into its family and size.
+func splitInstanceType(instanceType string) (string, string) {
+	parts := strings.SplitN(instanceType, ".", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateKMSKeyARN validates that a non-empty value is the ARN of a KMS key. Aliases are rejected, as the
+// node IAM policies generated by clusterawsadm grant decrypt on key ARNs.
+func validateKMSKeyARN(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := arn.Parse(value)
+	if err != nil || parsed.Service != "kms" || !strings.HasPrefix(parsed.Resource, "key/") {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be the ARN of a KMS key, e.g. arn:aws:kms:us-east-1:123456789012:key/<key-id>")}
+	}
+
+	return nil
+}
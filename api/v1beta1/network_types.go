@@ -240,6 +240,12 @@ type SubnetSpec struct {
 
 	// Tags is a collection of tags describing the resource.
 	Tags Tags `json:"tags,omitempty"`
+
+	// PrivateDNSNameOptionsOnLaunch is the default hostname type and DNS records of the instances
+	// launched in the subnet. They are set when the provider creates the subnet; for unmanaged subnets
+	// they should match the options of the existing subnet.
+	// +optional
+	PrivateDNSNameOptionsOnLaunch *PrivateDNSName `json:"privateDnsNameOptionsOnLaunch,omitempty"`
 }
 
 // String returns a string representation of the subnet.
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates ProxyConfig fields.
+func (p *ProxyConfig) Validate(fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if p == nil {
+		return errs
+	}
+
+	if p.HTTPProxy == "" && p.HTTPSProxy == "" {
+		errs = append(errs, field.Required(fldPath, "at least one of httpProxy and httpsProxy must be set"))
+	}
+
+	errs = append(errs, validateProxyURL(p.HTTPProxy, fldPath.Child("httpProxy"))...)
+	errs = append(errs, validateProxyURL(p.HTTPSProxy, fldPath.Child("httpsProxy"))...)
+
+	for i, host := range p.NoProxy {
+		if host == "" || strings.ContainsAny(host, ", \t\r\n") {
+			errs = append(errs, field.Invalid(fldPath.Child("noProxy").Index(i), host, "must be a single non-empty host name, domain name, IP address or CIDR block"))
+		}
+	}
+
+	return errs
+}
+
+func validateProxyURL(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.ContainsAny(value, " \t\r\n'") {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be an http or https URL, e.g. http://proxy.example.com:3128")}
+	}
+
+	return nil
+}
+
+// ValidateTrustBundle validates that a non-empty value is a bundle of PEM encoded X.509 certificates.
+func ValidateTrustBundle(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+
+	var certificates int
+	rest := []byte(value)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return field.ErrorList{field.Invalid(fldPath, "", "must only contain PEM encoded certificates, found "+block.Type)}
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return field.ErrorList{field.Invalid(fldPath, "", "failed to parse certificate: "+err.Error())}
+		}
+		certificates++
+	}
+
+	if certificates == 0 || strings.TrimSpace(string(rest)) != "" {
+		return field.ErrorList{field.Invalid(fldPath, "", "must be a bundle of PEM encoded certificates")}
+	}
+
+	return nil
+}
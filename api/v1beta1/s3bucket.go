@@ -21,8 +21,6 @@ import (
 	"net"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
-
-	"sigs.k8s.io/cluster-api-provider-aws/feature"
 )
 
 // Validate validates S3Bucket fields.
@@ -37,12 +35,6 @@ func (b *S3Bucket) Validate() []*field.Error {
 		errs = append(errs, field.Required(field.NewPath("spec", "s3Bucket", "name"), "can't be empty"))
 	}
 
-	// Feature gate is not enabled but ignition is enabled then send a forbidden error.
-	if !feature.Gates.Enabled(feature.BootstrapFormatIgnition) {
-		errs = append(errs, field.Forbidden(field.NewPath("spec", "s3Bucket"),
-			"can be set only if the BootstrapFormatIgnition feature gate is enabled"))
-	}
-
 	if b.ControlPlaneIAMInstanceProfile == "" {
 		errs = append(errs,
 			field.Required(field.NewPath("spec", "s3Bucket", "controlPlaneIAMInstanceProfiles"), "can't be empty"))
@@ -64,6 +56,20 @@ func (b *S3Bucket) Validate() []*field.Error {
 		errs = append(errs, validateS3BucketName(b.Name)...)
 	}
 
+	if b.Encryption != nil {
+		errs = append(errs, validateKMSKeyARN(b.Encryption.KMSKeyARN, field.NewPath("spec", "s3Bucket", "encryption", "kmsKeyArn"))...)
+	}
+
+	if b.AccessLogging != nil {
+		path := field.NewPath("spec", "s3Bucket", "accessLogging", "targetBucket")
+		switch b.AccessLogging.TargetBucket {
+		case "":
+			errs = append(errs, field.Required(path, "can't be empty"))
+		case b.Name:
+			errs = append(errs, field.Invalid(path, b.AccessLogging.TargetBucket, "must not be the bucket itself"))
+		}
+	}
+
 	return errs
 }
 
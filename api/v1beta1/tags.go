@@ -140,6 +140,10 @@ const (
 	// dedicated to this cluster api provider implementation.
 	NameAWSSubnetAssociation = NameAWSProviderPrefix + "association"
 
+	// NameAWSClusterName is the tag name, and the KMS encryption context key, holding the name of
+	// the cluster, e.g. on the IAM roles of the instances of the cluster.
+	NameAWSClusterName = NameAWSProviderPrefix + "cluster-name"
+
 	// SecondarySubnetTagValue is the secondary subnet tag constant value.
 	SecondarySubnetTagValue = "secondary"
 
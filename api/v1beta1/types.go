@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -49,10 +50,27 @@ type AMIReference struct {
 	// +optional
 	ID *string `json:"id,omitempty"`
 
-	// EKSOptimizedLookupType If specified, will look up an EKS Optimized image in SSM Parameter store
-	// +kubebuilder:validation:Enum:=AmazonLinux;AmazonLinuxGPU
+	// EKSOptimizedLookupType If specified, will look up an EKS Optimized image in SSM Parameter store.
+	// The Windows types look up the EKS optimized Windows Server Core or Full AMIs.
+	// +kubebuilder:validation:Enum:=AmazonLinux;AmazonLinuxGPU;WindowsCore2019;WindowsFull2019;WindowsCore2022;WindowsFull2022
 	// +optional
 	EKSOptimizedLookupType *EKSAMILookupType `json:"eksLookupType,omitempty"`
+
+	// SSMParameter is the name of an SSM parameter holding the AMI ID, for example the public parameter
+	// /aws/service/bottlerocket/aws-k8s-{{.K8sVersion}}/x86_64/latest/image_id.
+	// {{.K8sVersion}} is replaced by the major and minor Kubernetes version of the machine, e.g. 1.22.
+	// +optional
+	SSMParameter *string `json:"ssmParameter,omitempty"`
+
+	// Owners are the account IDs or aliases, e.g. amazon or aws-marketplace, of the owners of the AMI
+	// to look up. Used together with Filters, the most recent matching AMI is selected.
+	// +optional
+	Owners []string `json:"owners,omitempty"`
+
+	// Filters are DescribeImages filters used to look up the AMI, e.g. name or product-code.
+	// The most recent available AMI matching all filters is selected.
+	// +optional
+	Filters []Filter `json:"filters,omitempty"`
 }
 
 // Filter is a filter used to identify an AWS resource.
@@ -189,6 +207,10 @@ type Instance struct {
 	// Specifies ENIs attached to instance
 	NetworkInterfaces []string `json:"networkInterfaces,omitempty"`
 
+	// NetworkInterfaceSpecs are the network interfaces created at launch of the instance.
+	// +optional
+	NetworkInterfaceSpecs []NetworkInterfaceSpec `json:"networkInterfaceSpecs,omitempty"`
+
 	// The tags associated with the instance.
 	Tags map[string]string `json:"tags,omitempty"`
 
@@ -202,6 +224,46 @@ type Instance struct {
 	// +optional
 	Tenancy string `json:"tenancy,omitempty"`
 
+	// HostID is the ID of the dedicated host the instance runs on.
+	// +optional
+	HostID *string `json:"hostID,omitempty"`
+
+	// HostResourceGroupArn is the ARN of the host resource group the instance was launched in.
+	// +optional
+	HostResourceGroupArn *string `json:"hostResourceGroupArn,omitempty"`
+
+	// HostAffinity is the affinity of the instance to its dedicated host.
+	// +optional
+	HostAffinity string `json:"hostAffinity,omitempty"`
+
+	// DisableAPITermination indicates whether termination protection is enabled on the instance.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
+	// DisableAPIStop indicates whether stop protection is enabled on the instance.
+	// +optional
+	DisableAPIStop *bool `json:"disableApiStop,omitempty"`
+
+	// CPUOptions is the processor configuration of the instance.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// HibernationOptions indicates whether the instance is enabled for hibernation.
+	// +optional
+	HibernationOptions *HibernationOptions `json:"hibernationOptions,omitempty"`
+
+	// EnclaveOptions indicates whether the instance is enabled for AWS Nitro Enclaves.
+	// +optional
+	EnclaveOptions *EnclaveOptions `json:"enclaveOptions,omitempty"`
+
+	// BootMode is the boot mode of the instance.
+	// +optional
+	BootMode BootMode `json:"bootMode,omitempty"`
+
+	// PrivateDNSName is the private DNS name configuration the instance was launched with.
+	// +optional
+	PrivateDNSName *PrivateDNSName `json:"privateDnsName,omitempty"`
+
 	// IDs of the instance's volumes
 	// +optional
 	VolumeIDs []string `json:"volumeIDs,omitempty"`
@@ -239,6 +301,28 @@ type Volume struct {
 	// The key must already exist and be accessible by the controller.
 	// +optional
 	EncryptionKey string `json:"encryptionKey,omitempty"`
+
+	// SnapshotID is the ID of the EBS snapshot from which the volume is created.
+	// The snapshot must already exist and be accessible by the controller.
+	// +optional
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// DeleteOnTermination indicates whether the volume is deleted when the instance is terminated.
+	// Defaults to true.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+}
+
+// RetainedVolume describes a named EBS volume which outlives the instances it is attached to.
+type RetainedVolume struct {
+	// Name is the value of the Name tag identifying the volume. A volume with this name
+	// in the availability zone of the instance is attached to it, or created if none exists.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Volume is the configuration used to attach the volume and to create it if it does not exist.
+	// DeviceName is required.
+	Volume Volume `json:"volume"`
 }
 
 // VolumeType describes the EBS volume type.
@@ -281,6 +365,182 @@ type SpotMarketOptions struct {
 	MaxPrice *string `json:"maxPrice,omitempty"`
 }
 
+// CPUOptions defines the processor configuration of an instance.
+// See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-optimize-cpu.html
+type CPUOptions struct {
+	// CoreCount is the number of CPU cores for the instance.
+	// +kubebuilder:validation:Minimum=1
+	CoreCount int64 `json:"coreCount"`
+
+	// ThreadsPerCore is the number of threads per CPU core. Set it to 1 to disable multithreading.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2
+	ThreadsPerCore int64 `json:"threadsPerCore"`
+}
+
+// HibernationOptions defines whether an instance is enabled for hibernation.
+// See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Hibernate.html
+type HibernationOptions struct {
+	// Configured enables hibernation for the instance. The root volume must be
+	// an encrypted EBS volume large enough to hold the instance memory.
+	Configured bool `json:"configured"`
+}
+
+// EnclaveOptions defines whether an instance is enabled for AWS Nitro Enclaves.
+// See: https://docs.aws.amazon.com/enclaves/latest/user/nitro-enclave.html
+type EnclaveOptions struct {
+	// Enabled enables AWS Nitro Enclaves for the instance.
+	Enabled bool `json:"enabled"`
+}
+
+// HostnameType describes the type of hostname assigned to an instance.
+// See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-naming.html
+type HostnameType string
+
+var (
+	// HostnameTypeIPName names an instance after its private IPv4 address, e.g. ip-10-0-0-1.ec2.internal.
+	HostnameTypeIPName = HostnameType("ip-name")
+
+	// HostnameTypeResourceName names an instance after its ID, e.g. i-0123456789abcdef0.ec2.internal.
+	HostnameTypeResourceName = HostnameType("resource-name")
+)
+
+// PrivateDNSName defines the hostname type of an instance and the DNS records answering
+// queries for its resource-based hostname.
+type PrivateDNSName struct {
+	// HostnameType is the type of hostname assigned to the instance.
+	// +optional
+	// +kubebuilder:validation:Enum:=ip-name;resource-name
+	HostnameType HostnameType `json:"hostnameType,omitempty"`
+
+	// EnableResourceNameDNSARecord answers DNS queries for the resource-based hostname with an A record.
+	// +optional
+	EnableResourceNameDNSARecord *bool `json:"enableResourceNameDnsARecord,omitempty"`
+
+	// EnableResourceNameDNSAAAARecord answers DNS queries for the resource-based hostname with an AAAA record.
+	// +optional
+	EnableResourceNameDNSAAAARecord *bool `json:"enableResourceNameDnsAAAARecord,omitempty"`
+}
+
+// BootMode describes the boot mode of an instance.
+// See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ami-boot.html
+type BootMode string
+
+var (
+	// BootModeLegacyBIOS is the legacy BIOS boot mode.
+	BootModeLegacyBIOS = BootMode("legacy-bios")
+
+	// BootModeUEFI is the UEFI boot mode, required for UEFI Secure Boot and NitroTPM.
+	BootModeUEFI = BootMode("uefi")
+)
+
+// NetworkInterfaceType describes the type of a network interface.
+// See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/efa.html
+type NetworkInterfaceType string
+
+var (
+	// NetworkInterfaceTypeInterface is a standard elastic network interface.
+	NetworkInterfaceTypeInterface = NetworkInterfaceType("interface")
+
+	// NetworkInterfaceTypeEFA is an Elastic Fabric Adapter.
+	NetworkInterfaceTypeEFA = NetworkInterfaceType("efa")
+)
+
+// NetworkInterfaceSpec defines a network interface which is created and attached to an instance at launch.
+type NetworkInterfaceSpec struct {
+	// DeviceIndex is the position of the interface in the attachment order of the instance.
+	// The interface with device index 0 is the primary network interface.
+	// +kubebuilder:validation:Minimum=0
+	DeviceIndex int64 `json:"deviceIndex"`
+
+	// Subnet is a reference to the subnet to create the interface in. It must be in the
+	// availability zone of the instance. If not specified, the subnet of the instance is used.
+	// +optional
+	Subnet *AWSResourceReference `json:"subnet,omitempty"`
+
+	// InterfaceType is the type of the interface. Defaults to interface.
+	// +optional
+	// +kubebuilder:validation:Enum:=interface;efa
+	InterfaceType NetworkInterfaceType `json:"interfaceType,omitempty"`
+
+	// SecurityGroups is a list of references to security groups which are applied to the
+	// interface in addition to the security groups of the instance.
+	// +optional
+	SecurityGroups []AWSResourceReference `json:"securityGroups,omitempty"`
+
+	// SecondaryPrivateIPAddressCount is the number of secondary private IPv4 addresses
+	// to assign to the interface. Cannot be combined with IPv4PrefixCount.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	SecondaryPrivateIPAddressCount *int64 `json:"secondaryPrivateIPAddressCount,omitempty"`
+
+	// IPv4PrefixCount is the number of /28 IPv4 prefixes to delegate to the interface.
+	// Cannot be combined with SecondaryPrivateIPAddressCount.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	IPv4PrefixCount *int64 `json:"ipv4PrefixCount,omitempty"`
+
+	// DeleteOnTermination indicates whether the interface is deleted when the instance is terminated.
+	// Defaults to true.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+}
+
+// AMIUpdateMode is the mode of an AMI update policy.
+type AMIUpdateMode string
+
+const (
+	// AMIUpdateModeReport reports the most recent AMI found by the lookup without rolling it out.
+	AMIUpdateModeReport = AMIUpdateMode("report")
+
+	// AMIUpdateModeAuto rolls out the most recent AMI found by the lookup.
+	AMIUpdateModeAuto = AMIUpdateMode("auto")
+)
+
+// AMIUpdatePolicy periodically re-runs the AMI lookup to find AMIs published after the machines were created.
+type AMIUpdatePolicy struct {
+	// Mode is report to only report the most recent AMI in the status, or auto to also roll it out.
+	// The auto mode is only supported by AWSMachinePools, where it creates a new launch template
+	// version and starts an instance refresh of the Auto Scaling group.
+	// +kubebuilder:validation:Enum=report;auto
+	// +kubebuilder:default=report
+	// +optional
+	Mode AMIUpdateMode `json:"mode,omitempty"`
+
+	// CheckInterval is how often the AMI lookup is re-run. Defaults to 1h.
+	// +optional
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+
+	// MaintenanceWindow restricts when a newer AMI is rolled out in auto mode.
+	// If not set, newer AMIs are rolled out as soon as they are found.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindow is a window recurring on some or all days of the week.
+type MaintenanceWindow struct {
+	// Days are the days of the week on which the window opens, e.g. Saturday.
+	// The window opens every day if empty.
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// StartTime is the time of day in UTC at which the window opens, formatted as HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Duration is how long the window stays open.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// LatestAMIStatus is the most recent AMI found by the lookup of an AMI update policy.
+type LatestAMIStatus struct {
+	// ID of the AMI.
+	ID string `json:"id"`
+
+	// CheckTime is when the AMI lookup last ran.
+	CheckTime metav1.Time `json:"checkTime"`
+}
+
 // EKSAMILookupType specifies which AWS AMI to use for a AWSMachine and AWSMachinePool.
 type EKSAMILookupType string
 
@@ -289,4 +549,35 @@ const (
 	AmazonLinux EKSAMILookupType = "AmazonLinux"
 	// AmazonLinuxGPU is the AmazonLinux GPU AMI type.
 	AmazonLinuxGPU EKSAMILookupType = "AmazonLinuxGPU"
+	// WindowsCore2019 is the Windows Server 2019 Core AMI type.
+	WindowsCore2019 EKSAMILookupType = "WindowsCore2019"
+	// WindowsFull2019 is the Windows Server 2019 Full AMI type.
+	WindowsFull2019 EKSAMILookupType = "WindowsFull2019"
+	// WindowsCore2022 is the Windows Server 2022 Core AMI type.
+	WindowsCore2022 EKSAMILookupType = "WindowsCore2022"
+	// WindowsFull2022 is the Windows Server 2022 Full AMI type.
+	WindowsFull2022 EKSAMILookupType = "WindowsFull2022"
+)
+
+// IsWindows returns true if the lookup type is one of the EKS optimized Windows AMIs.
+func (t EKSAMILookupType) IsWindows() bool {
+	switch t {
+	case WindowsCore2019, WindowsFull2019, WindowsCore2022, WindowsFull2022:
+		return true
+	default:
+		return false
+	}
+}
+
+// BootstrapDataFormat is the format of the bootstrap data of a Machine, read from the format key
+// of its bootstrap data secret.
+type BootstrapDataFormat string
+
+const (
+	// CloudConfigBootstrapDataFormat is the cloud-init cloud-config format of Linux machines.
+	CloudConfigBootstrapDataFormat BootstrapDataFormat = "cloud-config"
+	// IgnitionBootstrapDataFormat is the Ignition format of Linux machines.
+	IgnitionBootstrapDataFormat BootstrapDataFormat = "ignition"
+	// PowerShellBootstrapDataFormat is a PowerShell script run by EC2Launch v2 on Windows machines.
+	PowerShellBootstrapDataFormat BootstrapDataFormat = "powershell"
 )
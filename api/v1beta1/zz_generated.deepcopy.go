@@ -22,6 +22,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/errors"
@@ -40,6 +42,23 @@ func (in *AMIReference) DeepCopyInto(out *AMIReference) {
 		*out = new(EKSAMILookupType)
 		**out = **in
 	}
+	if in.SSMParameter != nil {
+		in, out := &in.SSMParameter, &out.SSMParameter
+		*out = new(string)
+		**out = **in
+	}
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMIReference.
@@ -52,6 +71,31 @@ func (in *AMIReference) DeepCopy() *AMIReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMIUpdatePolicy) DeepCopyInto(out *AMIUpdatePolicy) {
+	*out = *in
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMIUpdatePolicy.
+func (in *AMIUpdatePolicy) DeepCopy() *AMIUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AMIUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSCluster) DeepCopyInto(out *AWSCluster) {
 	*out = *in
@@ -318,6 +362,16 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(S3Bucket)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DedicatedHosts != nil {
+		in, out := &in.DedicatedHosts, &out.DedicatedHosts
+		*out = make([]DedicatedHostSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterSpec.
@@ -704,27 +758,81 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RetainedVolumes != nil {
+		in, out := &in.RetainedVolumes, &out.RetainedVolumes
+		*out = make([]RetainedVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NetworkInterfaces != nil {
 		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NetworkInterfaceSpecs != nil {
+		in, out := &in.NetworkInterfaceSpecs, &out.NetworkInterfaceSpecs
+		*out = make([]NetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.UncompressedUserData != nil {
 		in, out := &in.UncompressedUserData, &out.UncompressedUserData
 		*out = new(bool)
 		**out = **in
 	}
-	out.CloudInit = in.CloudInit
+	in.CloudInit.DeepCopyInto(&out.CloudInit)
 	if in.Ignition != nil {
 		in, out := &in.Ignition, &out.Ignition
 		*out = new(Ignition)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.SpotMarketOptions != nil {
 		in, out := &in.SpotMarketOptions, &out.SpotMarketOptions
 		*out = new(SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupArn != nil {
+		in, out := &in.HostResourceGroupArn, &out.HostResourceGroupArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableAPIStop != nil {
+		in, out := &in.DisableAPIStop, &out.DisableAPIStop
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		**out = **in
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptions)
+		**out = **in
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(EnclaveOptions)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineSpec.
@@ -750,6 +858,13 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 		*out = new(InstanceState)
 		**out = **in
 	}
+	if in.RetainedVolumeIDs != nil {
+		in, out := &in.RetainedVolumeIDs, &out.RetainedVolumeIDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(errors.MachineStatusError)
@@ -785,6 +900,7 @@ func (in *AWSMachineTemplate) DeepCopyInto(out *AWSMachineTemplate) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplate.
@@ -858,6 +974,11 @@ func (in *AWSMachineTemplateResource) DeepCopy() *AWSMachineTemplateResource {
 func (in *AWSMachineTemplateSpec) DeepCopyInto(out *AWSMachineTemplateSpec) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
+	if in.AMIUpdatePolicy != nil {
+		in, out := &in.AMIUpdatePolicy, &out.AMIUpdatePolicy
+		*out = new(AMIUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateSpec.
@@ -870,6 +991,26 @@ func (in *AWSMachineTemplateSpec) DeepCopy() *AWSMachineTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineTemplateStatus) DeepCopyInto(out *AWSMachineTemplateStatus) {
+	*out = *in
+	if in.LatestAMIAvailable != nil {
+		in, out := &in.LatestAMIAvailable, &out.LatestAMIAvailable
+		*out = new(LatestAMIStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateStatus.
+func (in *AWSMachineTemplateStatus) DeepCopy() *AWSMachineTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMachineTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSResourceReference) DeepCopyInto(out *AWSResourceReference) {
 	*out = *in
@@ -951,6 +1092,11 @@ func (in *Bastion) DeepCopyInto(out *Bastion) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AMILookup != nil {
+		in, out := &in.AMILookup, &out.AMILookup
+		*out = new(AMIReference)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
@@ -1049,6 +1195,21 @@ func (in *CNISpec) DeepCopy() *CNISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUOptions) DeepCopyInto(out *CPUOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptions.
+func (in *CPUOptions) DeepCopy() *CPUOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClassicELB) DeepCopyInto(out *ClassicELB) {
 	*out = *in
@@ -1145,6 +1306,13 @@ func (in *ClassicELBListener) DeepCopy() *ClassicELBListener {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudInit) DeepCopyInto(out *CloudInit) {
 	*out = *in
+	if in.AdditionalParts != nil {
+		in, out := &in.AdditionalParts, &out.AdditionalParts
+		*out = make([]CloudInitPart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInit.
@@ -1157,6 +1325,56 @@ func (in *CloudInit) DeepCopy() *CloudInit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInitPart) DeepCopyInto(out *CloudInitPart) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInitPart.
+func (in *CloudInitPart) DeepCopy() *CloudInitPart {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInitPart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedicatedHostSpec) DeepCopyInto(out *DedicatedHostSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedicatedHostSpec.
+func (in *DedicatedHostSpec) DeepCopy() *DedicatedHostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DedicatedHostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnclaveOptions) DeepCopyInto(out *EnclaveOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnclaveOptions.
+func (in *EnclaveOptions) DeepCopy() *EnclaveOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EnclaveOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -1177,9 +1395,36 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationOptions) DeepCopyInto(out *HibernationOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationOptions.
+func (in *HibernationOptions) DeepCopy() *HibernationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ignition) DeepCopyInto(out *Ignition) {
 	*out = *in
+	if in.PresignedURL != nil {
+		in, out := &in.PresignedURL, &out.PresignedURL
+		*out = new(IgnitionPresignedURL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snippets != nil {
+		in, out := &in.Snippets, &out.Snippets
+		*out = make([]IgnitionSnippet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
@@ -1192,6 +1437,51 @@ func (in *Ignition) DeepCopy() *Ignition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionPresignedURL) DeepCopyInto(out *IgnitionPresignedURL) {
+	*out = *in
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionPresignedURL.
+func (in *IgnitionPresignedURL) DeepCopy() *IgnitionPresignedURL {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionPresignedURL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionSnippet) DeepCopyInto(out *IgnitionSnippet) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionSnippet.
+func (in *IgnitionSnippet) DeepCopy() *IgnitionSnippet {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionSnippet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 	*out = *in
@@ -1298,6 +1588,13 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NetworkInterfaceSpecs != nil {
+		in, out := &in.NetworkInterfaceSpecs, &out.NetworkInterfaceSpecs
+		*out = make([]NetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -1310,6 +1607,46 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = new(SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupArn != nil {
+		in, out := &in.HostResourceGroupArn, &out.HostResourceGroupArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableAPIStop != nil {
+		in, out := &in.DisableAPIStop, &out.DisableAPIStop
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		**out = **in
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptions)
+		**out = **in
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(EnclaveOptions)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumeIDs != nil {
 		in, out := &in.VolumeIDs, &out.VolumeIDs
 		*out = make([]string, len(*in))
@@ -1327,6 +1664,85 @@ func (in *Instance) DeepCopy() *Instance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatestAMIStatus) DeepCopyInto(out *LatestAMIStatus) {
+	*out = *in
+	in.CheckTime.DeepCopyInto(&out.CheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatestAMIStatus.
+func (in *LatestAMIStatus) DeepCopy() *LatestAMIStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LatestAMIStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
+	*out = *in
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(AWSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]AWSResourceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecondaryPrivateIPAddressCount != nil {
+		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IPv4PrefixCount != nil {
+		in, out := &in.IPv4PrefixCount, &out.IPv4PrefixCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
+func (in *NetworkInterfaceSpec) DeepCopy() *NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -1385,6 +1801,67 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSName) DeepCopyInto(out *PrivateDNSName) {
+	*out = *in
+	if in.EnableResourceNameDNSARecord != nil {
+		in, out := &in.EnableResourceNameDNSARecord, &out.EnableResourceNameDNSARecord
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableResourceNameDNSAAAARecord != nil {
+		in, out := &in.EnableResourceNameDNSAAAARecord, &out.EnableResourceNameDNSAAAARecord
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSName.
+func (in *PrivateDNSName) DeepCopy() *PrivateDNSName {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfig.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetainedVolume) DeepCopyInto(out *RetainedVolume) {
+	*out = *in
+	in.Volume.DeepCopyInto(&out.Volume)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetainedVolume.
+func (in *RetainedVolume) DeepCopy() *RetainedVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(RetainedVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -1408,6 +1885,21 @@ func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(S3BucketEncryption)
+		**out = **in
+	}
+	if in.BootstrapDataExpirationDays != nil {
+		in, out := &in.BootstrapDataExpirationDays, &out.BootstrapDataExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AccessLogging != nil {
+		in, out := &in.AccessLogging, &out.AccessLogging
+		*out = new(S3BucketAccessLogging)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Bucket.
@@ -1420,6 +1912,36 @@ func (in *S3Bucket) DeepCopy() *S3Bucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BucketAccessLogging) DeepCopyInto(out *S3BucketAccessLogging) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketAccessLogging.
+func (in *S3BucketAccessLogging) DeepCopy() *S3BucketAccessLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BucketAccessLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BucketEncryption) DeepCopyInto(out *S3BucketEncryption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketEncryption.
+func (in *S3BucketEncryption) DeepCopy() *S3BucketEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BucketEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -1489,6 +2011,11 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PrivateDNSNameOptionsOnLaunch != nil {
+		in, out := &in.PrivateDNSNameOptionsOnLaunch, &out.PrivateDNSNameOptionsOnLaunch
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -1593,6 +2120,11 @@ func (in *Volume) DeepCopyInto(out *Volume) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
@@ -36,6 +36,8 @@ import (
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/bootstrap/eks/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/bootstrap/eks/internal/userdata"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	capauserdata "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	bsutil "sigs.k8s.io/cluster-api/bootstrap/util"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
@@ -51,6 +53,7 @@ import (
 type EKSConfigReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
+	Endpoints        []scope.ServiceEndpoint
 	WatchFilterValue string
 }
 
@@ -195,6 +198,7 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 		DockerConfigJSON: config.Spec.DockerConfigJSON,
 		APIRetryAttempts: config.Spec.APIRetryAttempts,
 		UseMaxPods:       config.Spec.UseMaxPods,
+		Proxy:            controlPlaneProxy(controlPlane, r.Endpoints),
 	}
 	if config.Spec.PauseContainer != nil {
 		nodeInput.PauseContainerAccount = &config.Spec.PauseContainer.AccountNumber
@@ -224,6 +228,15 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 	return ctrl.Result{}, nil
 }
 
+// controlPlaneProxy returns the HTTP proxy and the additional trusted CA certificates of the nodes of
+// the control plane, where the nodes reach the VPC, the API server and the custom AWS service endpoints
+// without the proxy.
+func controlPlaneProxy(controlPlane *ekscontrolplanev1.AWSManagedControlPlane, endpoints []scope.ServiceEndpoint) *capauserdata.Proxy {
+	noProxy := scope.NoProxy(&controlPlane.Spec.NetworkSpec.VPC, controlPlane.Spec.SecondaryCidrBlock, controlPlane.Spec.ControlPlaneEndpoint, endpoints)
+
+	return capauserdata.NewProxy(controlPlane.Spec.Proxy, controlPlane.Spec.AdditionalTrustBundle, noProxy...)
+}
+
 func (r *EKSConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, option controller.Options) error {
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&eksbootstrapv1.EKSConfig{}).
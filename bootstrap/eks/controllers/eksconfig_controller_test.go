@@ -20,9 +20,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
@@ -69,3 +73,22 @@ func TestEKSConfigReconciler_ReturnEarlyIfClusterControlPlaneNotInitialized(t *t
 		gomega.Expect(err).NotTo(HaveOccurred())
 	}).Should(Succeed())
 }
+
+func TestControlPlaneProxy(t *testing.T) {
+	g := NewWithT(t)
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			NetworkSpec:          infrav1.NetworkSpec{VPC: infrav1.VPCSpec{CidrBlock: "10.0.0.0/16"}},
+			SecondaryCidrBlock:   aws.String("100.64.0.0/16"),
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{Host: "abc.gr7.us-east-1.eks.amazonaws.com", Port: 443},
+			Proxy:                &infrav1.ProxyConfig{HTTPSProxy: "http://proxy.example.com:3128"},
+		},
+	}
+	endpoints := []scope.ServiceEndpoint{
+		{ServiceID: "ec2", URL: "https://ec2.example.com", SigningRegion: "us-east-1"},
+	}
+
+	proxy := controlPlaneProxy(controlPlane, endpoints)
+	g.Expect(proxy.NoProxy).To(ContainElements("10.0.0.0/16", "100.64.0.0/16", "abc.gr7.us-east-1.eks.amazonaws.com", "ec2.example.com"))
+}
@@ -22,10 +22,15 @@ import (
 	"text/template"
 
 	"github.com/alessio/shellescape"
+
+	capauserdata "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 )
 
 const (
 	nodeUserData = `#!/bin/bash
+{{- with .Proxy }}
+{{ .Script }}{{ .SystemdScript "containerd" "docker" "kubelet" }}
+{{- end }}
 /etc/eks/bootstrap.sh {{.ClusterName}} {{- template "args" . }}
 `
 )
@@ -45,6 +50,9 @@ type NodeInput struct {
 	// TODO (richardcase): remove the above comment when IPV6 / dual stack is implemented.
 	IPFamily        *string
 	ServiceIPV6Cidr *string
+	// Proxy configures the HTTP proxy and additional trusted CA certificates for the bootstrap
+	// script, and the proxy for the container runtime and the kubelet.
+	Proxy *capauserdata.Proxy
 }
 
 func (ni *NodeInput) DockerConfigJSONEscaped() string {
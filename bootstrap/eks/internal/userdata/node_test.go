@@ -22,6 +22,9 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
 	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	capauserdata "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 )
 
 func TestNewNode(t *testing.T) {
@@ -165,6 +168,32 @@ func TestNewNode(t *testing.T) {
 			},
 			expectedBytes: []byte(`#!/bin/bash
 /etc/eks/bootstrap.sh test-cluster --docker-config-json '{"debug":true}'
+`),
+		},
+		{
+			name: "with proxy",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					Proxy:       capauserdata.NewProxy(&infrav1.ProxyConfig{HTTPSProxy: "http://proxy.example.com:3128"}, "", "10.0.0.0/16"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+# HTTP proxy and additional trusted CA certificates of the cluster.
+export HTTPS_PROXY=http://proxy.example.com:3128 https_proxy=http://proxy.example.com:3128
+export NO_PROXY=localhost,127.0.0.1,169.254.169.254,.internal,10.0.0.0/16 no_proxy=localhost,127.0.0.1,169.254.169.254,.internal,10.0.0.0/16
+# HTTP proxy of the cluster for containerd docker kubelet.
+for unit in containerd docker kubelet; do
+  mkdir -p "/etc/systemd/system/${unit}.service.d"
+  cat >"/etc/systemd/system/${unit}.service.d/http-proxy.conf" <<'CLUSTER_API_PROVIDER_AWS_HTTP_PROXY'
+[Service]
+Environment="HTTPS_PROXY=http://proxy.example.com:3128"
+Environment="NO_PROXY=localhost,127.0.0.1,169.254.169.254,.internal,10.0.0.0/16"
+CLUSTER_API_PROVIDER_AWS_HTTP_PROXY
+done
+systemctl daemon-reload
+
+/etc/eks/bootstrap.sh test-cluster
 `),
 		},
 	}
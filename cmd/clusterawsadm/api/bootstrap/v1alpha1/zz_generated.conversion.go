@@ -206,7 +206,9 @@ func autoConvert_v1beta1_AWSIAMConfigurationSpec_To_v1alpha1_AWSIAMConfiguration
 	out.EventBridge = (*EventBridgeConfig)(unsafe.Pointer(in.EventBridge))
 	out.Partition = in.Partition
 	out.SecureSecretsBackends = *(*[]apiv1beta1.SecretBackend)(unsafe.Pointer(&in.SecureSecretsBackends))
+	// WARNING: in.SecureSecretsKMSKeyARNs requires manual conversion: does not exist in peer-type
 	// WARNING: in.S3Buckets requires manual conversion: does not exist in peer-type
+	// WARNING: in.AMILookupSSMParameterPrefix requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -35,6 +35,8 @@ const (
 	DefaultKMSAliasPattern = "cluster-api-provider-aws-*"
 	// DefaultS3BucketPrefix is the default S3 bucket prefix.
 	DefaultS3BucketPrefix = "cluster-api-provider-aws-"
+	// DefaultAMILookupSSMParameterPrefix is the default prefix of the SSM parameters used to look up AMIs.
+	DefaultAMILookupSSMParameterPrefix = "/aws/service/*"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -97,6 +99,10 @@ func SetDefaults_AWSIAMConfigurationSpec(obj *AWSIAMConfigurationSpec) { //nolin
 	if obj.S3Buckets.NamePrefix == "" {
 		obj.S3Buckets.NamePrefix = DefaultS3BucketPrefix
 	}
+
+	if obj.AMILookupSSMParameterPrefix == "" {
+		obj.AMILookupSSMParameterPrefix = DefaultAMILookupSSMParameterPrefix
+	}
 }
 
 // SetDefaults_AWSIAMConfiguration is used by defaulter-gen.
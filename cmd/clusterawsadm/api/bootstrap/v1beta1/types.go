@@ -214,16 +214,31 @@ type AWSIAMConfigurationSpec struct {
 	Partition string `json:"partition,omitempty"`
 
 	// SecureSecretsBackend, when set to parameter-store will create AWS Systems Manager
-	// Parameter Storage policies. By default or with the value of secrets-manager,
-	// will generate AWS Secrets Manager policies instead.
-	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store
+	// Parameter Storage policies. When set to s3, will scope the nodes and control plane roles
+	// to their own prefix in the S3 buckets, which requires S3Buckets to be enabled.
+	// By default or with the value of secrets-manager, will generate AWS Secrets Manager policies instead.
+	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store;s3
 	SecureSecretsBackends []infrav1.SecretBackend `json:"secureSecretBackends,omitempty"`
 
+	// SecureSecretsKMSKeyARNs are the ARNs of the customer managed AWS KMS keys encrypting the secrets of the
+	// secure secret backends. The controllers are granted encrypt and the nodes decrypt on these keys only,
+	// the latter for the secrets of the cluster named by the sigs.k8s.io/cluster-api-provider-aws/cluster-name
+	// tag of their IAM role.
+	// +optional
+	SecureSecretsKMSKeyARNs []string `json:"secureSecretsKmsKeyArns,omitempty"`
+
 	// S3Buckets, when enabled, will add controller nodes permissions to
 	// create S3 Buckets for workload clusters.
 	// TODO: This field could be a pointer, but it seems it breaks setting default values?
 	// +optional
 	S3Buckets S3Buckets `json:"s3Buckets,omitempty"`
+
+	// AMILookupSSMParameterPrefix is the prefix of the names of the AWS Systems Manager parameters the
+	// controllers are allowed to read to look up AMIs through the ssmParameter of an AMI reference.
+	// Defaults to /aws/service/*, the public parameters of the AMIs published by AWS. Set it to * to
+	// allow looking up AMIs through any parameter of the account.
+	// +optional
+	AMILookupSSMParameterPrefix string `json:"amiLookupSsmParameterPrefix,omitempty"`
 }
 
 // GetObjectKind returns the AAWSIAMConfiguration's TypeMeta.
@@ -86,6 +86,11 @@ func (in *AWSIAMConfigurationSpec) DeepCopyInto(out *AWSIAMConfigurationSpec) {
 		*out = make([]cluster_api_provider_awsapiv1beta1.SecretBackend, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecureSecretsKMSKeyARNs != nil {
+		in, out := &in.SecureSecretsKMSKeyARNs, &out.SecureSecretsKMSKeyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.S3Buckets = in.S3Buckets
 }
 
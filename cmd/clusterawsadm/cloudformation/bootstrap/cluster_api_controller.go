@@ -18,6 +18,7 @@ package bootstrap
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/awslabs/goformation/v4/cloudformation"
 	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
@@ -82,6 +83,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 			Resource: iamv1.Resources{iamv1.Any},
 			Action: iamv1.Actions{
 				"ec2:AllocateAddress",
+				"ec2:AllocateHosts",
 				"ec2:AssociateRouteTable",
 				"ec2:AttachInternetGateway",
 				"ec2:AuthorizeSecurityGroupIngress",
@@ -104,7 +106,11 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeAccountAttributes",
 				"ec2:DescribeAddresses",
 				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeHosts",
 				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceAttribute",
+				"ec2:DescribeInstanceStatus",
+				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
 				"ec2:DescribeImages",
 				"ec2:DescribeNatGateways",
@@ -116,6 +122,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeVpcs",
 				"ec2:DescribeVpcAttribute",
 				"ec2:DescribeVolumes",
+				"ec2:AttachVolume",
+				"ec2:CreateVolume",
 				"ec2:DetachInternetGateway",
 				"ec2:DisassociateRouteTable",
 				"ec2:DisassociateAddress",
@@ -123,6 +131,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:ModifyNetworkInterfaceAttribute",
 				"ec2:ModifySubnetAttribute",
 				"ec2:ReleaseAddress",
+				"ec2:ReleaseHosts",
 				"ec2:RevokeSecurityGroupIngress",
 				"ec2:RunInstances",
 				"ec2:TerminateInstances",
@@ -207,6 +216,15 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"iam:PassRole",
 			},
 		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				fmt.Sprintf("arn:*:ssm:*:*:parameter/%s", strings.TrimPrefix(t.Spec.AMILookupSSMParameterPrefix, "/")),
+			},
+			Action: iamv1.Actions{
+				"ssm:GetParameter",
+			},
+		},
 	}
 	for _, secureSecretBackend := range t.Spec.SecureSecretsBackends {
 		switch secureSecretBackend {
@@ -222,6 +240,14 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 					"secretsmanager:TagResource",
 				},
 			})
+			// Listing secrets does not support resource-level permissions, it is needed to sweep orphaned bootstrap data.
+			statement = append(statement, iamv1.StatementEntry{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{iamv1.Any},
+				Action: iamv1.Actions{
+					"secretsmanager:ListSecrets",
+				},
+			})
 		case infrav1.SecretBackendSSMParameterStore:
 			statement = append(statement, iamv1.StatementEntry{
 				Effect: iamv1.EffectAllow,
@@ -234,8 +260,28 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 					"ssm:AddTagsToResource",
 				},
 			})
+			// Describing parameters does not support resource-level permissions, it is needed to sweep orphaned bootstrap data.
+			statement = append(statement, iamv1.StatementEntry{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{iamv1.Any},
+				Action: iamv1.Actions{
+					"ssm:DescribeParameters",
+				},
+			})
 		}
 	}
+	if len(t.Spec.SecureSecretsKMSKeyARNs) > 0 {
+		// Secrets Manager needs to generate and decrypt the data key of a secret to encrypt it with a customer managed key.
+		statement = append(statement, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources(t.Spec.SecureSecretsKMSKeyARNs),
+			Action: iamv1.Actions{
+				"kms:Decrypt",
+				"kms:Encrypt",
+				"kms:GenerateDataKey",
+			},
+		})
+	}
 	if t.Spec.S3Buckets.Enable {
 		statement = append(statement, iamv1.StatementEntry{
 			Effect: iamv1.EffectAllow,
@@ -246,8 +292,21 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"s3:CreateBucket",
 				"s3:DeleteBucket",
 				"s3:PutObject",
+				"s3:PutObjectTagging",
 				"s3:DeleteObject",
+				"s3:GetBucketLogging",
+				"s3:GetBucketPublicAccessBlock",
+				"s3:GetEncryptionConfiguration",
+				"s3:GetLifecycleConfiguration",
+				"s3:GetObject",
+				"s3:GetObjectTagging",
+				"s3:ListBucket",
+				"s3:PutBucketLogging",
+				"s3:PutBucketOwnershipControls",
 				"s3:PutBucketPolicy",
+				"s3:PutBucketPublicAccessBlock",
+				"s3:PutEncryptionConfiguration",
+				"s3:PutLifecycleConfiguration",
 			},
 		})
 	}
@@ -262,6 +321,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"events:PutRule",
 				"events:PutTargets",
 				"events:RemoveTargets",
+				"sqs:ChangeMessageVisibility",
 				"sqs:CreateQueue",
 				"sqs:DeleteMessage",
 				"sqs:DeleteQueue",
@@ -17,6 +17,10 @@ limitations under the License.
 package bootstrap
 
 import (
+	"fmt"
+
+	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/iam/api/v1beta1"
 )
@@ -49,6 +53,76 @@ func (t Template) secretPolicy(secureSecretsBackend infrav1.SecretBackend) iamv1
 	return iamv1.StatementEntry{}
 }
 
+// s3SecretPolicy allows the role of an instance profile to read and delete the cloud-init userdata
+// stored by the s3 secure secret backend below the prefix of that instance profile only.
+func (t Template) s3SecretPolicy(instanceProfile string) cfn_iam.Role_Policy {
+	return cfn_iam.Role_Policy{
+		PolicyName: t.NewManagedName("s3-secrets"),
+		PolicyDocument: iamv1.PolicyDocument{
+			Version: iamv1.CurrentVersion,
+			Statement: []iamv1.StatementEntry{
+				{
+					Effect: iamv1.EffectAllow,
+					Resource: iamv1.Resources{
+						fmt.Sprintf("arn:*:s3:::%s*/secure/%s/*", t.Spec.S3Buckets.NamePrefix, instanceProfile),
+					},
+					Action: iamv1.Actions{
+						"s3:DeleteObject",
+						"s3:GetObject",
+					},
+				},
+			},
+		},
+	}
+}
+
+// s3SecretBackendEnabled returns true if the s3 secure secret backend is used together with S3 buckets.
+func (t Template) s3SecretBackendEnabled() bool {
+	if !t.Spec.S3Buckets.Enable {
+		return false
+	}
+	for _, secureSecretsBackend := range t.Spec.SecureSecretsBackends {
+		if secureSecretsBackend == infrav1.SecretBackendS3 {
+			return true
+		}
+	}
+	return false
+}
+
+// secretKMSKeyPolicy allows nodes to decrypt the secrets of a secure secret backend which are encrypted
+// with customer managed KMS keys. The permission is scoped by the KMS encryption context of the secrets
+// to the cluster named by the cluster name tag of the IAM role of the nodes.
+func (t Template) secretKMSKeyPolicy(secureSecretsBackend infrav1.SecretBackend) iamv1.StatementEntry {
+	clusterName := fmt.Sprintf("${aws:PrincipalTag/%s}", infrav1.NameAWSClusterName)
+
+	var encryptionContext map[string]string
+	switch secureSecretsBackend {
+	case infrav1.SecretBackendSecretsManager:
+		encryptionContext = map[string]string{
+			"kms:EncryptionContext:SecretARN": fmt.Sprintf("arn:*:secretsmanager:*:*:secret:aws.cluster.x-k8s.io/%s/*", clusterName),
+		}
+	case infrav1.SecretBackendSSMParameterStore:
+		encryptionContext = map[string]string{
+			"kms:EncryptionContext:PARAMETER_ARN": fmt.Sprintf("arn:*:ssm:*:*:parameter/cluster.x-k8s.io/%s/*", clusterName),
+		}
+	case infrav1.SecretBackendS3:
+		encryptionContext = map[string]string{
+			"kms:EncryptionContext:" + infrav1.NameAWSClusterName: clusterName,
+		}
+	}
+
+	return iamv1.StatementEntry{
+		Effect:   iamv1.EffectAllow,
+		Resource: iamv1.Resources(t.Spec.SecureSecretsKMSKeyARNs),
+		Action: iamv1.Actions{
+			"kms:Decrypt",
+		},
+		Condition: iamv1.Conditions{
+			iamv1.StringLike: encryptionContext,
+		},
+	}
+}
+
 func (t Template) sessionManagerPolicy() iamv1.StatementEntry {
 	return iamv1.StatementEntry{
 		Effect:   iamv1.EffectAllow,
@@ -84,11 +158,23 @@ func (t Template) nodeManagedPolicies() []string {
 func (t Template) nodePolicy() *iamv1.PolicyDocument {
 	policyDocument := t.cloudProviderNodeAwsPolicy()
 	for _, secureSecretsBackend := range t.Spec.SecureSecretsBackends {
+		// The s3 backend is scoped per role, see s3SecretPolicy.
+		if secureSecretsBackend == infrav1.SecretBackendS3 {
+			continue
+		}
 		policyDocument.Statement = append(
 			policyDocument.Statement,
 			t.secretPolicy(secureSecretsBackend),
 		)
 	}
+	if len(t.Spec.SecureSecretsKMSKeyARNs) > 0 {
+		for _, secureSecretsBackend := range t.Spec.SecureSecretsBackends {
+			policyDocument.Statement = append(
+				policyDocument.Statement,
+				t.secretKMSKeyPolicy(secureSecretsBackend),
+			)
+		}
+	}
 	policyDocument.Statement = append(
 		policyDocument.Statement,
 		t.sessionManagerPolicy(),
@@ -36,6 +36,9 @@ func (t Template) controlPlanePolicies() []cfn_iam.Role_Policy {
 			},
 		)
 	}
+	if t.s3SecretBackendEnabled() {
+		policies = append(policies, t.s3SecretPolicy(t.NewManagedName("control-plane")))
+	}
 	return policies
 }
 
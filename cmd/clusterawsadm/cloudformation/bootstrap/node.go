@@ -35,6 +35,9 @@ func (t Template) nodePolicies() []cfn_iam.Role_Policy {
 			},
 		)
 	}
+	if t.s3SecretBackendEnabled() {
+		policies = append(policies, t.s3SecretPolicy(t.NewManagedName("nodes")))
+	}
 	return policies
 }
 
@@ -61,6 +61,20 @@ func Test_RenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_secret_kms_keys",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.SecureSecretsBackends = []infrav1.SecretBackend{
+					infrav1.SecretBackendSecretsManager,
+					infrav1.SecretBackendSSMParameterStore,
+				}
+				t.Spec.SecureSecretsKMSKeyARNs = []string{
+					"arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				}
+				return t
+			},
+		},
 		{
 			fixture: "with_s3_bucket",
 			template: func() Template {
@@ -69,6 +83,18 @@ func Test_RenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_s3_secret_backend",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.S3Buckets.Enable = true
+				t.Spec.SecureSecretsBackends = []infrav1.SecretBackend{
+					infrav1.SecretBackendSecretsManager,
+					infrav1.SecretBackendS3,
+				}
+				return t
+			},
+		},
 		{
 			fixture: "customsuffix",
 			template: func() Template {
@@ -122,6 +148,14 @@ func Test_RenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_ami_lookup_ssm_parameter_prefix",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.AMILookupSSMParameterPrefix = "/my-org/amis/*"
+				return t
+			},
+		},
 		{
 			fixture: "with_extra_statements",
 			template: func() Template {
@@ -221,6 +221,11 @@ func (r *AWSClusterReconciler) reconcileDelete(clusterScope *scope.ClusterScope)
 		return reconcile.Result{}, err
 	}
 
+	if err := ec2svc.DeleteDedicatedHosts(); err != nil {
+		clusterScope.Error(err, "error releasing dedicated hosts")
+		return reconcile.Result{}, err
+	}
+
 	if err := sgService.DeleteSecurityGroups(); err != nil {
 		clusterScope.Error(err, "error deleting security groups")
 		return reconcile.Result{}, err
@@ -281,9 +286,15 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 		return reconcile.Result{}, err
 	}
 
+	if err := ec2Service.ReconcileDedicatedHosts(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.DedicatedHostsReadyCondition, infrav1.DedicatedHostsAllocationFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), err.Error())
+		clusterScope.Error(err, "failed to reconcile dedicated hosts")
+		return reconcile.Result{}, err
+	}
+
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(clusterScope)
-		if err := instancestateSvc.ReconcileEC2Events(); err != nil {
+		if err := instancestateSvc.ReconcileEC2Events(feature.Gates.Enabled(feature.MachinePool)); err != nil {
 			// non fatal error, so we continue
 			clusterScope.Error(err, "non-fatal: failed to set up EventBridge")
 		}
@@ -192,6 +192,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				runningCluster := func() {
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileDedicatedHosts().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
@@ -318,6 +319,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileDedicatedHosts().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(expectedErr)
 				}
 				csClient := setup(t, &awsCluster)
@@ -342,6 +344,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileDedicatedHosts().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
 				}
 				csClient := setup(t, &awsCluster)
@@ -366,6 +369,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileDedicatedHosts().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
 				}
 				csClient := setup(t, &awsCluster)
@@ -390,6 +394,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		t.Run("Reconcile success", func(t *testing.T) {
 			deleteCluster := func() {
 				ec2Svc.EXPECT().DeleteBastion().Return(nil)
+				ec2Svc.EXPECT().DeleteDedicatedHosts().Return(nil)
 				elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 				networkSvc.EXPECT().DeleteNetwork().Return(nil)
 				sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
@@ -465,6 +470,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				deleteCluster := func() {
 					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					ec2Svc.EXPECT().DeleteDedicatedHosts().Return(nil)
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(expectedErr)
 				}
@@ -489,6 +495,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				deleteCluster := func() {
 					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					ec2Svc.EXPECT().DeleteDedicatedHosts().Return(nil)
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
 					networkSvc.EXPECT().DeleteNetwork().Return(expectedErr)
@@ -43,9 +43,10 @@ func (r *AWSMachineReconciler) updateMachineAnnotation(machine *infrav1.AWSMachi
 	annotations := machine.GetAnnotations()
 
 	// Set our annotation to the given content.
-	if annotations != nil {
-		annotations[annotation] = content
+	if annotations == nil {
+		annotations = map[string]string{}
 	}
+	annotations[annotation] = content
 
 	// Update the machine object with these annotations
 	machine.SetAnnotations(annotations)
@@ -18,11 +18,11 @@ package controllers
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	ignTypes "github.com/flatcar-linux/ignition/config/v2_3/types"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -43,6 +43,7 @@ import (
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
@@ -73,6 +74,7 @@ type AWSMachineReconciler struct {
 	elbServiceFactory            func(scope.ELBScope) services.ELBInterface
 	secretsManagerServiceFactory func(cloud.ClusterScoper) services.SecretInterface
 	SSMServiceFactory            func(cloud.ClusterScoper) services.SecretInterface
+	s3SecretServiceFactory       func(scope.S3Scope) services.SecretInterface
 	objectStoreServiceFactory    func(cloud.ClusterScoper) services.ObjectStoreInterface
 	Endpoints                    []scope.ServiceEndpoint
 	WatchFilterValue             string
@@ -106,12 +108,25 @@ func (r *AWSMachineReconciler) getSSMService(scope cloud.ClusterScoper) services
 	return ssm.NewService(scope)
 }
 
-func (r *AWSMachineReconciler) getSecretService(machineScope *scope.MachineScope, scope cloud.ClusterScoper) (services.SecretInterface, error) {
+func (r *AWSMachineReconciler) getS3SecretService(s3Scope scope.S3Scope) services.SecretInterface {
+	if r.s3SecretServiceFactory != nil {
+		return r.s3SecretServiceFactory(s3Scope)
+	}
+	return s3.NewSecretService(s3Scope)
+}
+
+func (r *AWSMachineReconciler) getSecretService(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper) (services.SecretInterface, error) {
 	switch machineScope.SecureSecretsBackend() {
 	case infrav1.SecretBackendSSMParameterStore:
-		return r.getSSMService(scope), nil
+		return r.getSSMService(clusterScope), nil
 	case infrav1.SecretBackendSecretsManager:
-		return r.getSecretsManagerService(scope), nil
+		return r.getSecretsManagerService(clusterScope), nil
+	case infrav1.SecretBackendS3:
+		s3Scope, ok := clusterScope.(scope.S3Scope)
+		if !ok || s3Scope.Bucket() == nil {
+			return nil, errors.New("the s3 secret backend requires an S3 bucket to be configured on the AWSCluster")
+		}
+		return r.getS3SecretService(s3Scope), nil
 	}
 	return nil, errors.New("invalid secret backend")
 }
@@ -135,6 +150,7 @@ func (r *AWSMachineReconciler) getObjectStoreService(scope scope.S3Scope) servic
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
 
@@ -293,6 +309,11 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		return ctrl.Result{}, err
 	}
 
+	if err := r.deleteLaunchTemplate(machineScope, ec2Service); err != nil {
+		machineScope.Error(err, "unable to delete launch template")
+		return ctrl.Result{}, err
+	}
+
 	instance, err := r.findInstance(machineScope, ec2Service)
 	if err != nil && err != ec2.ErrInstanceNotFoundByID {
 		machineScope.Error(err, "query to find instance failed")
@@ -343,6 +364,21 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	default:
 		machineScope.Info("Terminating EC2 instance", "instance-id", instance.ID)
 
+		// Network interfaces which are kept after termination still carry the cluster's core security groups,
+		// so look them up while they are attached to the instance.
+		networkInterfaceIDs := append([]string{}, machineScope.AWSMachine.Spec.NetworkInterfaces...)
+		if retainsNetworkInterfaces(machineScope.AWSMachine.Spec.NetworkInterfaceSpecs) {
+			enis, err := ec2Service.GetInstanceSecurityGroups(instance.ID)
+			if err != nil {
+				machineScope.Error(err, "failed to get network interfaces of instance")
+				return ctrl.Result{}, err
+			}
+			for id := range enis {
+				networkInterfaceIDs = append(networkInterfaceIDs, id)
+			}
+			sort.Strings(networkInterfaceIDs)
+		}
+
 		// Set the InstanceReadyCondition and patch the object before the blocking operation
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 		if err := machineScope.PatchObject(); err != nil {
@@ -359,7 +395,7 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
 		// If the AWSMachine specifies NetworkStatus Interfaces, detach the cluster's core Security Groups from them as part of deletion.
-		if len(machineScope.AWSMachine.Spec.NetworkInterfaces) > 0 {
+		if len(networkInterfaceIDs) > 0 {
 			core, err := ec2Service.GetCoreSecurityGroups(machineScope)
 			if err != nil {
 				machineScope.Error(err, "failed to get core security groups to detach from instance's network interfaces")
@@ -377,8 +413,9 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 				return ctrl.Result{}, err
 			}
 
-			for _, id := range machineScope.AWSMachine.Spec.NetworkInterfaces {
-				if err := ec2Service.DetachSecurityGroupsFromNetworkInterface(core, id); err != nil {
+			for _, id := range networkInterfaceIDs {
+				// Interfaces which are deleted on termination are gone by now.
+				if err := ec2Service.DetachSecurityGroupsFromNetworkInterface(core, id); err != nil && !awserrors.IsNotFound(errors.Cause(err)) {
 					machineScope.Error(err, "failed to detach security groups from instance's network interfaces")
 					conditions.MarkFalse(machineScope.AWSMachine, infrav1.SecurityGroupsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
 					return ctrl.Result{}, err
@@ -397,6 +434,59 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	return ctrl.Result{}, nil
 }
 
+// deleteLaunchTemplate deletes the launch template the instance was created from, if any.
+// Instances created from a launch template keep running when it is deleted.
+func (r *AWSMachineReconciler) deleteLaunchTemplate(machineScope *scope.MachineScope, ec2Service services.EC2Interface) error {
+	if !machineScope.AWSMachine.Spec.UseLaunchTemplate {
+		return nil
+	}
+
+	// The status is blank after a move, look up the ID of the existing launch template.
+	launchTemplateID := machineScope.GetLaunchTemplateIDStatus()
+	if launchTemplateID == "" {
+		id, err := ec2Service.GetLaunchTemplateID(machineScope.LaunchTemplateName())
+		if err != nil {
+			return err
+		}
+		launchTemplateID = id
+	}
+
+	if launchTemplateID == "" {
+		return nil
+	}
+
+	// Leave a launch template of the same name created by someone else alone.
+	owned, err := ec2Service.IsLaunchTemplateOwned(launchTemplateID)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		machineScope.Info("Not deleting launch template which is not owned by the cluster", "id", launchTemplateID)
+		machineScope.SetLaunchTemplateIDStatus("")
+		machineScope.SetLaunchTemplateVersionStatus("")
+		return nil
+	}
+
+	if err := ec2Service.DeleteLaunchTemplate(launchTemplateID); err != nil && !awserrors.IsNotFound(errors.Cause(err)) {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedDeleteLaunchTemplate", "Failed to delete launch template %q: %v", launchTemplateID, err)
+		return err
+	}
+
+	machineScope.SetLaunchTemplateIDStatus("")
+	machineScope.SetLaunchTemplateVersionStatus("")
+	return nil
+}
+
+// retainsNetworkInterfaces returns true if any of the network interfaces is kept when the instance is terminated.
+func retainsNetworkInterfaces(specs []infrav1.NetworkInterfaceSpec) bool {
+	for _, spec := range specs {
+		if spec.DeleteOnTermination != nil && !*spec.DeleteOnTermination {
+			return true
+		}
+	}
+	return false
+}
+
 // findInstance queries the EC2 apis and retrieves the instance if it exists.
 // If providerID is empty, finds instance by tags and if it cannot be found, returns empty instance with nil error.
 // If providerID is set, either finds the instance by ID or returns error.
@@ -459,12 +549,29 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 
 	ec2svc := r.getEC2Service(ec2Scope)
 
-	// Find existing instance
-	instance, err := r.findInstance(machineScope, ec2svc)
-	if err != nil {
-		machineScope.Error(err, "unable to find instance")
-		conditions.MarkUnknown(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceNotFoundReason, err.Error())
-		return ctrl.Result{}, err
+	var instance *infrav1.Instance
+	var err error
+	if machineScope.IsAdoptingInstance() {
+		// Adopt the existing instance referenced by Spec.InstanceID. Adoption only happens here, so that
+		// deleting an AWSMachine whose instance was never adopted leaves the instance untouched.
+		instance, err = ec2svc.AdoptInstance(machineScope)
+		if err != nil {
+			machineScope.Error(err, "unable to adopt instance")
+			return ctrl.Result{}, err
+		}
+		// An instance which does not match the spec is never replaced by a new one, the InstanceAdopted condition reports the mismatches.
+		if instance == nil {
+			machineScope.Info("Instance does not match the AWSMachine spec, skipping adoption", "instance-id", *machineScope.AWSMachine.Spec.InstanceID)
+			return ctrl.Result{}, nil
+		}
+	} else {
+		// Find existing instance
+		instance, err = r.findInstance(machineScope, ec2svc)
+		if err != nil {
+			machineScope.Error(err, "unable to find instance")
+			conditions.MarkUnknown(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceNotFoundReason, err.Error())
+			return ctrl.Result{}, err
+		}
 	}
 
 	// If the AWSMachine doesn't have our finalizer, add it.
@@ -504,6 +611,11 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		if err := instancestateSvc.AddInstanceToEventPattern(instance.ID); err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "failed to add instance to Event Bridge instance state rule")
 		}
+		if machineScope.AWSMachine.Spec.SpotMarketOptions != nil {
+			if err := instancestateSvc.AddSpotInstanceToEventPattern(instance.ID); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "failed to add instance to Event Bridge spot interruption rule")
+			}
+		}
 	}
 
 	// Make sure Spec.ProviderID and Spec.InstanceID are always set.
@@ -530,6 +642,7 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 	case infrav1.InstanceStateStopping, infrav1.InstanceStateStopped:
 		machineScope.SetNotReady()
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStoppedReason, clusterv1.ConditionSeverityError, "")
+		ec2.ClearInstanceStatusConditions(machineScope.AWSMachine)
 	case infrav1.InstanceStateRunning:
 		machineScope.SetReady()
 		conditions.MarkTrue(machineScope.AWSMachine, infrav1.InstanceReadyCondition)
@@ -558,6 +671,16 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		machineScope.SetFailureMessage(errors.Errorf("EC2 instance state %q is unexpected", instance.State))
 	}
 
+	// retained volumes can only be attached to running or stopped instances
+	retainedVolumesPending := false
+	if len(machineScope.AWSMachine.Spec.RetainedVolumes) > 0 && (instance.State == infrav1.InstanceStateRunning || instance.State == infrav1.InstanceStateStopped) {
+		retainedVolumesPending, err = ec2svc.ReconcileRetainedVolumes(machineScope, instance)
+		if err != nil {
+			machineScope.Error(err, "failed to reconcile retained volumes")
+			return ctrl.Result{}, err
+		}
+	}
+
 	// tasks that can take place during all known instance states
 	if machineScope.InstanceIsInKnownState() {
 		_, err = r.ensureTags(ec2svc, machineScope.AWSMachine, machineScope.GetInstanceID(), machineScope.AdditionalTags())
@@ -578,7 +701,19 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 
 	// tasks that can only take place during operational instance states
 	if machineScope.InstanceIsOperational() {
-		machineScope.SetAddresses(instance.Addresses)
+		machineScope.SetAddresses(ec2.InstanceAddresses(instance, instance.PrivateDNSName, machineScope.InfraCluster.Region()))
+
+		if err := r.ensureInstanceProtection(ec2svc, machineScope, instance.ID); err != nil {
+			machineScope.Error(err, "failed to ensure instance protection")
+			return ctrl.Result{}, err
+		}
+
+		if instance.State == infrav1.InstanceStateRunning {
+			if err := ec2svc.ReconcileInstanceStatus(machineScope, instance); err != nil {
+				// The status checks are informational, so failing to get them does not block the reconciliation.
+				machineScope.Error(err, "non-fatal: failed to reconcile instance status")
+			}
+		}
 
 		existingSecurityGroups, err := ec2svc.GetInstanceSecurityGroups(*machineScope.GetInstanceID())
 		if err != nil {
@@ -596,6 +731,11 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		conditions.MarkTrue(machineScope.AWSMachine, infrav1.SecurityGroupsReadyCondition)
 	}
 
+	// requeue to attach the retained volumes which are still being created
+	if retainedVolumesPending {
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -655,24 +795,38 @@ func (r *AWSMachineReconciler) resolveUserData(machineScope *scope.MachineScope,
 		return nil, "", err
 	}
 
-	if machineScope.UseSecretsManager(userDataFormat) {
-		userData, err = r.cloudInitUserData(machineScope, clusterScope, userData)
-	}
-
-	if machineScope.UseIgnition(userDataFormat) {
-		userData, err = r.ignitionUserData(machineScope, objectStoreSvc, userData)
+	switch {
+	case machineScope.UseSecretsManager(userDataFormat):
+		userData, err = r.cloudInitUserData(machineScope, clusterScope, userData, userDataFormat)
+	case machineScope.UseIgnition(userDataFormat):
+		userData, err = r.ignitionUserData(machineScope, clusterScope, objectStoreSvc, userData)
+	case machineScope.UseWindows(userDataFormat):
+		userData, err = userdata.NewWindowsScript(userData)
 	}
 
 	return userData, userDataFormat, err
 }
 
-func (r *AWSMachineReconciler) cloudInitUserData(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, userData []byte) ([]byte, error) {
+// cloudInitUserData stores the bootstrap data in the secure secrets backend and returns userdata fetching it,
+// either as a cloud-init boothook followed by the additional cloud-init parts of the machine or, for Windows
+// machines, as an EC2Launch v2 PowerShell script.
+func (r *AWSMachineReconciler) cloudInitUserData(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, userData []byte, userDataFormat string) ([]byte, error) {
 	secretSvc, secretBackendErr := r.getSecretService(machineScope, clusterScope)
 	if secretBackendErr != nil {
 		machineScope.Error(secretBackendErr, "unable to reconcile machine")
 		return nil, secretBackendErr
 	}
 
+	// Resolve the additional parts before creating the secrets, so missing parts do not orphan them.
+	additionalParts, err := machineScope.GetCloudInitAdditionalParts()
+	if err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedGetCloudInitParts", err.Error())
+		return nil, err
+	}
+	if len(additionalParts) > 0 && machineScope.UseWindows(userDataFormat) {
+		return nil, errors.New("additional cloud-init parts are not supported with PowerShell bootstrap data")
+	}
+
 	compressedUserData, compressErr := userdata.GzipBytes(userData)
 	if compressErr != nil {
 		return nil, compressErr
@@ -692,46 +846,115 @@ func (r *AWSMachineReconciler) cloudInitUserData(machineScope *scope.MachineScop
 		machineScope.Error(serviceErr, "Failed to create AWS Secret entry", "secretPrefix", prefix)
 		return nil, serviceErr
 	}
-	encryptedCloudInit, err := secretSvc.UserData(machineScope.GetSecretPrefix(), machineScope.GetSecretCount(), machineScope.InfraCluster.Region(), r.Endpoints)
+	generateUserData := secretSvc.UserData
+	if machineScope.UseWindows(userDataFormat) {
+		generateUserData = secretSvc.WindowsUserData
+	}
+	infraCluster := machineScope.InfraCluster
+	proxy := userdata.NewProxy(infraCluster.Proxy(), infraCluster.AdditionalTrustBundle(), infraCluster.NoProxy()...)
+	encryptedCloudInit, err := generateUserData(machineScope.GetSecretPrefix(), machineScope.GetSecretCount(), infraCluster.Region(), r.Endpoints, proxy)
 	if err != nil {
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedGenerateAWSSecretsCloudInit", err.Error())
 		return nil, err
 	}
-	return encryptedCloudInit, nil
+	if len(additionalParts) > 0 {
+		encryptedCloudInit, err = userdata.AppendCloudInitParts(encryptedCloudInit, additionalParts)
+		if err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedGenerateAWSSecretsCloudInit", err.Error())
+			return nil, err
+		}
+	}
+	return r.fitUserData(machineScope, encryptedCloudInit, userDataFormat)
 }
 
-func (r *AWSMachineReconciler) ignitionUserData(scope *scope.MachineScope, objectStoreSvc services.ObjectStoreInterface, userData []byte) ([]byte, error) {
+// fitUserData gzip compresses userdata exceeding the userdata size limit of EC2, unless it is compressed when
+// creating the instance anyway, and returns an error if the userdata exceeds the limit compressed as well.
+func (r *AWSMachineReconciler) fitUserData(machineScope *scope.MachineScope, userData []byte, userDataFormat string) ([]byte, error) {
+	size := len(userData)
+	switch {
+	case machineScope.CompressUserData(userDataFormat):
+		compressedUserData, err := userdata.GzipBytes(userData)
+		if err != nil {
+			return nil, err
+		}
+		size = len(compressedUserData)
+	case size > userdata.MaxUserDataSize && !machineScope.UseWindows(userDataFormat):
+		compressedUserData, err := userdata.GzipBytes(userData)
+		if err != nil {
+			return nil, err
+		}
+		userData = compressedUserData
+		size = len(userData)
+	}
+
+	if size > userdata.MaxUserDataSize {
+		err := errors.Errorf("userdata is %d bytes, which exceeds the EC2 limit of %d bytes", size, userdata.MaxUserDataSize)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "UserDataTooLarge", err.Error())
+		return nil, err
+	}
+
+	return userData, nil
+}
+
+func (r *AWSMachineReconciler) ignitionUserData(scope *scope.MachineScope, clusterScope cloud.ClusterScoper, objectStoreSvc services.ObjectStoreInterface, userData []byte) ([]byte, error) {
 	if objectStoreSvc == nil {
 		return nil, errors.New("object store service not available")
 	}
 
+	// The bucket policy denies the IAM instance profiles reading the bootstrap data of presigned Ignition URLs,
+	// so machines referencing it by its s3:// URL would not be able to boot.
+	if presignedIgnitionURLs(clusterScope) && (scope.AWSMachine.Spec.Ignition == nil || scope.AWSMachine.Spec.Ignition.PresignedURL == nil) {
+		err := errors.New("the S3 bucket of the cluster requires presigned Ignition URLs, spec.ignition.presignedURL must be set")
+		r.Recorder.Eventf(scope.AWSMachine, corev1.EventTypeWarning, "FailedIgnitionBootstrapDataAccess", err.Error())
+		return nil, err
+	}
+
 	objectURL, err := objectStoreSvc.Create(scope, userData)
 	if err != nil {
 		return nil, errors.Wrap(err, "creating userdata object")
 	}
 
-	ignData := &ignTypes.Config{
-		Ignition: ignTypes.Ignition{
-			Version: "2.3.0",
-			Config: ignTypes.IgnitionConfig{
-				Append: []ignTypes.ConfigReference{
-					{
-						Source: objectURL,
-					},
-				},
-			},
-		},
+	// Reference the object by a presigned URL, so the instance does not need to be allowed to read from the bucket.
+	if ignition := scope.AWSMachine.Spec.Ignition; ignition != nil && ignition.PresignedURL != nil {
+		expiration := infrav1.DefaultIgnitionPresignedURLExpiration
+		if ignition.PresignedURL.Expiration != nil {
+			expiration = ignition.PresignedURL.Expiration.Duration
+		}
+
+		objectURL, err = objectStoreSvc.PresignedURL(scope, expiration)
+		if err != nil {
+			r.Recorder.Eventf(scope.AWSMachine, corev1.EventTypeWarning, "FailedPresignIgnition", err.Error())
+			return nil, errors.Wrap(err, "presigning userdata object URL")
+		}
 	}
 
-	ignitionUserData, err := json.Marshal(ignData)
+	snippets, err := scope.GetIgnitionSnippets()
+	if err != nil {
+		r.Recorder.Eventf(scope.AWSMachine, corev1.EventTypeWarning, "FailedGetIgnitionSnippets", err.Error())
+		return nil, err
+	}
+
+	var version string
+	if scope.AWSMachine.Spec.Ignition != nil {
+		version = scope.AWSMachine.Spec.Ignition.Version
+	}
+
+	ignitionUserData, err := generateIgnition(version, objectURL, snippets)
 	if err != nil {
 		r.Recorder.Eventf(scope.AWSMachine, corev1.EventTypeWarning, "FailedGenerateIgnition", err.Error())
-		return nil, errors.Wrap(err, "serializing generated data")
+		return nil, errors.Wrap(err, "generating Ignition config")
 	}
 
 	return ignitionUserData, nil
 }
 
+// presignedIgnitionURLs returns whether the S3 bucket of the cluster denies reading the Ignition bootstrap data
+// by anything but presigned URLs.
+func presignedIgnitionURLs(clusterScope cloud.ClusterScoper) bool {
+	s3Scope, ok := clusterScope.(scope.S3Scope)
+	return ok && s3Scope.Bucket() != nil && s3Scope.Bucket().PresignedIgnitionURLs
+}
+
 func (r *AWSMachineReconciler) deleteBootstrapData(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, objectStoreScope scope.S3Scope) error {
 	if err := r.deleteEncryptedBootstrapDataSecret(machineScope, clusterScope); err != nil {
 		return err
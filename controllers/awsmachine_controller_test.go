@@ -77,6 +77,9 @@ func TestAWSMachineReconciler_IntegrationTests(t *testing.T) {
 
 		expect := func(m *mock_ec2iface.MockEC2APIMockRecorder, s *mock_services.MockSecretInterfaceMockRecorder, e *mock_elbiface.MockELBAPIMockRecorder) {
 			mockedCreateInstanceCalls(m)
+			m.DescribeInstanceStatus(gomock.Eq(&ec2.DescribeInstanceStatusInput{
+				InstanceIds: aws.StringSlice([]string{"two"}),
+			})).Return(&ec2.DescribeInstanceStatusOutput{}, nil).MaxTimes(1)
 			mockedCreateSecretCall(s)
 			mockedCreateLBCalls(t, e)
 		}
@@ -486,7 +489,7 @@ func expectConditions(g *WithT, m *infrav1.AWSMachine, expected []conditionAsser
 
 func mockedCreateSecretCall(s *mock_services.MockSecretInterfaceMockRecorder) {
 	s.Create(gomock.AssignableToTypeOf(&scope.MachineScope{}), gomock.AssignableToTypeOf([]byte{}))
-	s.UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf([]scope.ServiceEndpoint{}))
+	s.UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf([]scope.ServiceEndpoint{}), gomock.Any())
 }
 
 func mockedCreateInstanceCalls(m *mock_ec2iface.MockEC2APIMockRecorder) {
@@ -19,8 +19,11 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"flag"
 	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -47,6 +50,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -102,7 +106,26 @@ func TestAWSMachineReconciler(t *testing.T) {
 			},
 		}
 
-		client := fake.NewClientBuilder().WithObjects(awsMachine, secret, secretIgnition).Build()
+		secretWindows := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "bootstrap-data-windows",
+			},
+			Data: map[string][]byte{
+				"value":  []byte("powershell-script"),
+				"format": []byte("powershell"),
+			},
+		}
+
+		ignitionSnippet := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ignition-snippet",
+			},
+			Data: map[string]string{
+				"config.ign": `{"ignition":{"version":"3.0.0"},"systemd":{"units":[{"name":"snippet.service","enabled":true}]}}`,
+			},
+		}
+
+		client := fake.NewClientBuilder().WithObjects(awsMachine, secret, secretIgnition, secretWindows, ignitionSnippet).Build()
 		ms, err = scope.NewMachineScope(
 			scope.MachineScopeParams{
 				Client: client,
@@ -176,6 +199,9 @@ func TestAWSMachineReconciler(t *testing.T) {
 			secretsManagerServiceFactory: func(cloud.ClusterScoper) services.SecretInterface {
 				return secretSvc
 			},
+			s3SecretServiceFactory: func(scope.S3Scope) services.SecretInterface {
+				return secretSvc
+			},
 			objectStoreServiceFactory: func(cloud.ClusterScoper) services.ObjectStoreInterface {
 				return objectStoreSvc
 			},
@@ -306,12 +332,86 @@ func TestAWSMachineReconciler(t *testing.T) {
 				ec2Svc.EXPECT().InstanceIfExists(gomock.Any()).Return(nil, nil)
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 
 				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 				g.Expect(ms.AWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
 				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
 			})
+
+			t.Run("should fetch Windows bootstrap data from the secrets backend with a PowerShell script", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				providerID(t, g)
+				ms.Machine.Spec.Bootstrap.DataSecretName = pointer.StringPtr("bootstrap-data-windows")
+				expectedErr := errors.New("Invalid instance")
+				ec2Svc.EXPECT().InstanceIfExists(gomock.Any()).Return(nil, nil)
+				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				secretSvc.EXPECT().WindowsUserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("ec2launch"), nil).Times(1)
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), []byte("ec2launch"), "powershell").Return(nil, expectedErr)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			})
+
+			t.Run("should wrap Windows bootstrap data in an EC2Launch document when skipping the secrets backend", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				providerID(t, g)
+				ms.Machine.Spec.Bootstrap.DataSecretName = pointer.StringPtr("bootstrap-data-windows")
+				ms.AWSMachine.Spec.CloudInit.InsecureSkipSecretsManager = true
+				expectedErr := errors.New("Invalid instance")
+				ec2Svc.EXPECT().InstanceIfExists(gomock.Any()).Return(nil, nil)
+				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), "powershell").DoAndReturn(func(_ *scope.MachineScope, userData []byte, _ string) (*infrav1.Instance, error) {
+					g.Expect(string(userData)).To(ContainSubstring("executeScript"))
+					g.Expect(string(userData)).To(ContainSubstring("powershell-script"))
+					return nil, expectedErr
+				})
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			})
+		})
+
+		t.Run("when adopting an existing instance", func(t *testing.T) {
+			t.Run("should not create an instance if the instance does not match the spec", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				ms.AWSMachine.Spec.InstanceID = aws.String("i-adopted")
+				ec2Svc.EXPECT().AdoptInstance(ms).Return(nil, nil)
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Times(0)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(ms.AWSMachine.Spec.ProviderID).To(BeNil())
+				g.Expect(ms.AWSMachine.Finalizers).NotTo(ContainElement(infrav1.MachineFinalizer))
+			})
+
+			t.Run("should return an error if the instance cannot be adopted", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				ms.AWSMachine.Spec.InstanceID = aws.String("i-adopted")
+				expectedErr := errors.New("failed to describe instance")
+				ec2Svc.EXPECT().AdoptInstance(ms).Return(nil, expectedErr)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			})
 		})
 
 		t.Run("should fail to find instance if no provider ID provided", func(t *testing.T) {
@@ -342,6 +442,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
 				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
 			}
 
 			t.Run("instance security group errors", func(t *testing.T) {
@@ -363,7 +464,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					instanceCreate(t, g)
 					getInstanceSecurityGroups(t, g)
 
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 					_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 					g.Expect(ms.AWSMachine.Spec.ProviderID).To(PointTo(Equal(providerID)))
 				})
@@ -376,7 +477,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					instanceCreate(t, g)
 					getInstanceSecurityGroups(t, g)
 
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 					instance.State = infrav1.InstanceStatePending
 					_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 					g.Expect(ms.AWSMachine.Status.InstanceState).To(PointTo(Equal(infrav1.InstanceStatePending)))
@@ -395,8 +496,9 @@ func TestAWSMachineReconciler(t *testing.T) {
 					instanceCreate(t, g)
 					getInstanceSecurityGroups(t, g)
 
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 					instance.State = infrav1.InstanceStateRunning
+					ec2Svc.EXPECT().ReconcileInstanceStatus(gomock.Any(), gomock.Any()).Return(nil)
 					_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 					g.Expect(ms.AWSMachine.Status.InstanceState).To(PointTo(Equal(infrav1.InstanceStateRunning)))
 					g.Expect(ms.AWSMachine.Status.Ready).To(Equal(true))
@@ -417,7 +519,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				klog.SetOutput(buf)
 				instance.State = "NewAWSMachineState"
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 				_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 				g.Expect(ms.AWSMachine.Status.Ready).To(Equal(false))
@@ -432,7 +534,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 					ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).
 						Return(map[string][]string{"eid": {}}, nil)
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 					ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil)
 					secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 				}
@@ -524,6 +626,68 @@ func TestAWSMachineReconciler(t *testing.T) {
 				})
 			})
 
+			t.Run("instance protection", func(t *testing.T) {
+				t.Run("should not modify the protection when it matches the instance", func(t *testing.T) {
+					g := NewWithT(t)
+					awsMachine := getAWSMachine()
+					awsMachine.Spec.DisableAPITermination = pointer.BoolPtr(true)
+					awsMachine.Spec.DisableAPIStop = pointer.BoolPtr(true)
+					setup(t, g, awsMachine)
+					defer teardown(t, g)
+
+					ec2Svc.EXPECT().GetInstanceProtection("myMachine").Return(true, true, nil)
+					ec2Svc.EXPECT().SetTerminationProtection(gomock.Any(), gomock.Any()).Times(0)
+					ec2Svc.EXPECT().SetStopProtection(gomock.Any(), gomock.Any()).Times(0)
+
+					err := reconciler.ensureInstanceProtection(ec2Svc, ms, "myMachine")
+					g.Expect(err).To(BeNil())
+				})
+
+				t.Run("should only modify the protection which differs from the instance", func(t *testing.T) {
+					g := NewWithT(t)
+					awsMachine := getAWSMachine()
+					awsMachine.Spec.DisableAPITermination = pointer.BoolPtr(true)
+					setup(t, g, awsMachine)
+					defer teardown(t, g)
+
+					ec2Svc.EXPECT().GetInstanceProtection("myMachine").Return(true, true, nil)
+					ec2Svc.EXPECT().SetTerminationProtection(gomock.Any(), gomock.Any()).Times(0)
+					ec2Svc.EXPECT().SetStopProtection("myMachine", false).Return(nil)
+
+					err := reconciler.ensureInstanceProtection(ec2Svc, ms, "myMachine")
+					g.Expect(err).To(BeNil())
+				})
+
+				t.Run("should restore the protection when it was changed outside of the controller", func(t *testing.T) {
+					g := NewWithT(t)
+					awsMachine := getAWSMachine()
+					awsMachine.Spec.DisableAPITermination = pointer.BoolPtr(true)
+					setup(t, g, awsMachine)
+					defer teardown(t, g)
+
+					ec2Svc.EXPECT().GetInstanceProtection("myMachine").Return(false, false, nil)
+					ec2Svc.EXPECT().SetTerminationProtection("myMachine", true).Return(nil)
+					ec2Svc.EXPECT().SetStopProtection(gomock.Any(), gomock.Any()).Times(0)
+
+					err := reconciler.ensureInstanceProtection(ec2Svc, ms, "myMachine")
+					g.Expect(err).To(BeNil())
+				})
+
+				t.Run("should return an error when the protection of the instance cannot be described", func(t *testing.T) {
+					g := NewWithT(t)
+					awsMachine := getAWSMachine()
+					setup(t, g, awsMachine)
+					defer teardown(t, g)
+
+					ec2Svc.EXPECT().GetInstanceProtection("myMachine").Return(false, false, errors.New("access denied"))
+					ec2Svc.EXPECT().SetTerminationProtection(gomock.Any(), gomock.Any()).Times(0)
+					ec2Svc.EXPECT().SetStopProtection(gomock.Any(), gomock.Any()).Times(0)
+
+					err := reconciler.ensureInstanceProtection(ec2Svc, ms, "myMachine")
+					g.Expect(err).ToNot(BeNil())
+				})
+			})
+
 			t.Run("temporarily stopping then starting the AWSMachine(stateless)", func(t *testing.T) {
 				var buf *bytes.Buffer
 				getCoreSecurityGroups := func(t *testing.T, g *WithT) {
@@ -533,7 +697,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					klog.SetOutput(buf)
 					ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).
 						Return(map[string][]string{"eid": {}}, nil).Times(1)
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 					ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
 					secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 				}
@@ -551,7 +715,11 @@ func TestAWSMachineReconciler(t *testing.T) {
 					g.Expect(ms.AWSMachine.Status.InstanceState).To(PointTo(Equal(infrav1.InstanceStateStopping)))
 					g.Expect(ms.AWSMachine.Status.Ready).To(Equal(false))
 					g.Expect(buf.String()).To(ContainSubstring(("EC2 instance state changed")))
-					expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceStoppedReason}})
+					expectConditions(g, ms.AWSMachine, []conditionAssertion{
+						{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceStoppedReason},
+						{infrav1.InstanceReachableCondition, corev1.ConditionUnknown, clusterv1.ConditionSeverityNone, infrav1.InstanceStoppedReason},
+						{infrav1.NoScheduledEventsCondition, corev1.ConditionUnknown, clusterv1.ConditionSeverityNone, infrav1.InstanceStoppedReason},
+					})
 				})
 
 				t.Run("should then set instance to stopped and unready", func(t *testing.T) {
@@ -567,7 +735,11 @@ func TestAWSMachineReconciler(t *testing.T) {
 					g.Expect(ms.AWSMachine.Status.InstanceState).To(PointTo(Equal(infrav1.InstanceStateStopped)))
 					g.Expect(ms.AWSMachine.Status.Ready).To(Equal(false))
 					g.Expect(buf.String()).To(ContainSubstring(("EC2 instance state changed")))
-					expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceStoppedReason}})
+					expectConditions(g, ms.AWSMachine, []conditionAssertion{
+						{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceStoppedReason},
+						{infrav1.InstanceReachableCondition, corev1.ConditionUnknown, clusterv1.ConditionSeverityNone, infrav1.InstanceStoppedReason},
+						{infrav1.NoScheduledEventsCondition, corev1.ConditionUnknown, clusterv1.ConditionSeverityNone, infrav1.InstanceStoppedReason},
+					})
 				})
 
 				t.Run("should then set instance to running and ready once it is restarted", func(t *testing.T) {
@@ -579,6 +751,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					getCoreSecurityGroups(t, g)
 
 					instance.State = infrav1.InstanceStateRunning
+					ec2Svc.EXPECT().ReconcileInstanceStatus(gomock.Any(), gomock.Any()).Return(nil)
 					_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 					g.Expect(ms.AWSMachine.Status.InstanceState).To(PointTo(Equal(infrav1.InstanceStateRunning)))
 					g.Expect(ms.AWSMachine.Status.Ready).To(Equal(true))
@@ -594,7 +767,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					klog.SetOutput(buf)
 					secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
 					secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				}
 
 				t.Run("should warn if an instance is shutting-down", func(t *testing.T) {
@@ -642,7 +815,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				}
 
 				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerELB(gomock.Any()).Return(true, nil)
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
@@ -667,7 +840,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerELB(gomock.Any()).Return(false, nil)
 				elbSvc.EXPECT().RegisterInstanceWithAPIServerELB(gomock.Any()).Return(nil)
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
@@ -708,7 +881,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					Name:      "test",
 				}
 
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(errors.New("failed to delete entries from AWS Secret")).Times(1)
 
@@ -738,7 +911,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New(expectedError)).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New(expectedError)).Times(1)
 
 				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 				g.Expect(err.Error()).To(ContainSubstring(expectedError))
@@ -762,7 +935,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil)
 				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
 				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerELB(gomock.Any()).Return(false, errors.New("error describing ELB"))
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
 
 				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
@@ -790,7 +963,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerELB(gomock.Any()).Return(false, nil)
 				elbSvc.EXPECT().RegisterInstanceWithAPIServerELB(gomock.Any()).Return(errors.New("failed to attach ELB"))
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil).Times(1)
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 
 				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 				g.Expect(err).ToNot(BeNil())
@@ -818,7 +991,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					ec2Svc.EXPECT().InstanceIfExists(gomock.Any()).Return(nil, nil)
 					ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil)
 					secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil)
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 				}
 
 				t.Run("Should fail to return machine annotations after instance is created", func(t *testing.T) {
@@ -863,8 +1036,9 @@ func TestAWSMachineReconciler(t *testing.T) {
 					t.Helper()
 					ec2Svc.EXPECT().InstanceIfExists(gomock.Any()).Return(nil, nil)
 					ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil)
+					ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil)
 					secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil)
-					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 					ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil)
 				}
 
@@ -992,8 +1166,9 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil).AnyTimes()
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(secretPrefix, int32(1), nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
 				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil).AnyTimes()
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
 
@@ -1002,6 +1177,184 @@ func TestAWSMachineReconciler(t *testing.T) {
 				}
 				_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 			})
+
+			t.Run("should leverage the S3 bucket of the cluster", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				awsMachine.Spec.CloudInit.SecureSecretsBackend = infrav1.SecretBackendS3
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				cs.AWSCluster.Spec.S3Bucket = &infrav1.S3Bucket{Name: "bucket"}
+				instance = &infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStatePending,
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil).AnyTimes()
+				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("s3://bucket/secure/profile/test/abc", int32(1), nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil).AnyTimes()
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
+				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
+
+				_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(ms.GetSecretPrefix()).To(Equal("s3://bucket/secure/profile/test/abc"))
+			})
+
+			t.Run("should fail to use the S3 backend when the cluster has no S3 bucket", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				awsMachine.Spec.CloudInit.SecureSecretsBackend = infrav1.SecretBackendS3
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil).AnyTimes()
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(HaveOccurred())
+			})
+
+			t.Run("should pass the HTTP proxy of the cluster to the secret fetch script", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				cs.AWSCluster.Spec.NetworkSpec.VPC.CidrBlock = "10.0.0.0/16"
+				cs.AWSCluster.Spec.Proxy = &infrav1.ProxyConfig{
+					HTTPSProxy: "http://proxy.example.com:3128",
+					NoProxy:    []string{".example.com"},
+				}
+				instance = &infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStatePending,
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil).AnyTimes()
+				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(secretPrefix, int32(1), nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, _ int32, _ string, _ []scope.ServiceEndpoint, proxy *userdata.Proxy) ([]byte, error) {
+					g.Expect(proxy).NotTo(BeNil())
+					g.Expect(proxy.HTTPSProxy).To(Equal("http://proxy.example.com:3128"))
+					g.Expect(proxy.NoProxy).To(ContainElements(".example.com", "10.0.0.0/16", "169.254.169.254"))
+					return nil, nil
+				}).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil).AnyTimes()
+				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
+				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+
+			secretFetchUserData := []byte("MIME-Version: 1.0\nContent-Type: multipart/mixed; boundary=\"boundary\"\n\n--boundary\r\n" +
+				"Content-Type: text/cloud-boothook\r\n\r\n#!/bin/bash\r\n--boundary--\r\n")
+
+			t.Run("should append the additional cloud-init parts", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				awsMachine.Spec.CloudInit.AdditionalParts = []infrav1.CloudInitPart{
+					{
+						Name:        "hello.sh",
+						ContentType: infrav1.CloudInitPartContentTypeShellScript,
+						Data:        "#!/bin/sh\necho hello\n",
+					},
+					{
+						Name:        "from-secret.sh",
+						ContentType: infrav1.CloudInitPartContentTypeShellScript,
+						Secret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "bootstrap-data"},
+							Key:                  "value",
+						},
+					},
+				}
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				instance = &infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStatePending,
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil).AnyTimes()
+				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(secretPrefix, int32(1), nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secretFetchUserData, nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ *scope.MachineScope, userData []byte, _ string) (*infrav1.Instance, error) {
+					g.Expect(string(userData)).To(ContainSubstring("text/cloud-boothook"))
+					g.Expect(string(userData)).To(ContainSubstring("filename=hello.sh"))
+					g.Expect(string(userData)).To(ContainSubstring("echo hello"))
+					g.Expect(string(userData)).To(ContainSubstring("filename=from-secret.sh"))
+					g.Expect(string(userData)).To(ContainSubstring("shell-script"))
+					g.Expect(strings.Index(string(userData), "hello.sh")).To(BeNumerically("<", strings.Index(string(userData), "from-secret.sh")))
+					return instance, nil
+				}).Times(1)
+				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
+				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+
+			t.Run("should gzip userdata exceeding the EC2 limit", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				awsMachine.Spec.CloudInit.AdditionalParts = []infrav1.CloudInitPart{
+					{
+						Name:        "large.sh",
+						ContentType: infrav1.CloudInitPartContentTypeShellScript,
+						Data:        "#!/bin/sh\n" + strings.Repeat("echo hello\n", 2000),
+					},
+				}
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				instance = &infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStatePending,
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil).AnyTimes()
+				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(secretPrefix, int32(1), nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secretFetchUserData, nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ *scope.MachineScope, userData []byte, _ string) (*infrav1.Instance, error) {
+					g.Expect(len(userData)).To(BeNumerically("<=", userdata.MaxUserDataSize))
+					g.Expect(userData[:2]).To(Equal([]byte{0x1f, 0x8b}))
+					return instance, nil
+				}).Times(1)
+				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
+				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+
+			t.Run("should fail when the userdata exceeds the EC2 limit compressed", func(t *testing.T) {
+				g := NewWithT(t)
+				random := make([]byte, 20000)
+				_, _ = rand.New(rand.NewSource(1)).Read(random) //nolint:gosec
+				awsMachine := getAWSMachine()
+				awsMachine.Spec.CloudInit.AdditionalParts = []infrav1.CloudInitPart{
+					{
+						Name:        "random.sh",
+						ContentType: infrav1.CloudInitPartContentTypeShellScript,
+						Data:        base64.StdEncoding.EncodeToString(random),
+					},
+				}
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil).AnyTimes()
+				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(secretPrefix, int32(1), nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secretFetchUserData, nil).Times(1)
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(HaveOccurred())
+			})
 		})
 
 		t.Run("Secrets management lifecycle when there's a node ref and a secret ARN", func(t *testing.T) {
@@ -1035,6 +1388,8 @@ func TestAWSMachineReconciler(t *testing.T) {
 				setNodeRef(t, g)
 
 				instance.State = infrav1.InstanceStateRunning
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil)
+				ec2Svc.EXPECT().ReconcileInstanceStatus(gomock.Any(), gomock.Any()).Return(nil)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).
 					Return(map[string][]string{"eid": {}}, nil).Times(1)
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
@@ -1106,6 +1461,8 @@ func TestAWSMachineReconciler(t *testing.T) {
 				setSSM(t, g)
 
 				instance.State = infrav1.InstanceStateRunning
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil)
+				ec2Svc.EXPECT().ReconcileInstanceStatus(gomock.Any(), gomock.Any()).Return(nil)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).
 					Return(map[string][]string{"eid": {}}, nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
@@ -1187,8 +1544,9 @@ func TestAWSMachineReconciler(t *testing.T) {
 				}
 				instance.State = infrav1.InstanceStatePending
 				secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(secretPrefix, int32(1), nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
 				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil).AnyTimes()
-				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
 
@@ -1234,6 +1592,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				fakeS3URL := "s3://foo"
 
 				objectStoreSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fakeS3URL, nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
 				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(instance, nil).AnyTimes()
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
@@ -1245,6 +1604,126 @@ func TestAWSMachineReconciler(t *testing.T) {
 				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 				g.Expect(err).To(BeNil())
 			})
+
+			t.Run("should reference the S3 object with a presigned URL", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				getInstances(t, g)
+				useIgnition(t, g)
+				ms.AWSMachine.Spec.Ignition = &infrav1.Ignition{
+					PresignedURL: &infrav1.IgnitionPresignedURL{},
+				}
+
+				instance = &infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStatePending,
+				}
+				fakePresignedURL := "https://foo.s3.amazonaws.com/node/bar?X-Amz-Expires=3600"
+
+				objectStoreSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("s3://foo/node/bar", nil).Times(1)
+				objectStoreSvc.EXPECT().PresignedURL(gomock.Any(), infrav1.DefaultIgnitionPresignedURLExpiration).Return(fakePresignedURL, nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ *scope.MachineScope, userData []byte, _ string) (*infrav1.Instance, error) {
+					g.Expect(string(userData)).To(ContainSubstring(fakePresignedURL))
+					g.Expect(string(userData)).NotTo(ContainSubstring("s3://"))
+					return instance, nil
+				}).Times(1)
+				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
+				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+
+			t.Run("should require a presigned URL if the S3 bucket denies reading the Ignition bootstrap data", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				getInstances(t, g)
+				useIgnition(t, g)
+				cs.AWSCluster.Spec.S3Bucket = &infrav1.S3Bucket{PresignedIgnitionURLs: true}
+
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(MatchError(ContainSubstring("spec.ignition.presignedURL must be set")))
+				g.Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedIgnitionBootstrapDataAccess")))
+			})
+
+			t.Run("should merge Ignition snippets into an Ignition v3 config", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				getInstances(t, g)
+				useIgnition(t, g)
+				ms.AWSMachine.Spec.Ignition = &infrav1.Ignition{
+					Version: "3.3",
+					Snippets: []infrav1.IgnitionSnippet{
+						{
+							ConfigMap: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "ignition-snippet"},
+								Key:                  "config.ign",
+							},
+						},
+						{
+							Secret: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "missing-ignition-snippet"},
+								Key:                  "config.ign",
+								Optional:             pointer.BoolPtr(true),
+							},
+						},
+					},
+				}
+
+				instance = &infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStatePending,
+				}
+
+				objectStoreSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("s3://foo/node/bar", nil).Times(1)
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil).AnyTimes()
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ *scope.MachineScope, userData []byte, _ string) (*infrav1.Instance, error) {
+					g.Expect(string(userData)).To(ContainSubstring(`"version":"3.3.0"`))
+					g.Expect(string(userData)).To(ContainSubstring(`"merge":[{"source":"s3://foo/node/bar"`))
+					g.Expect(string(userData)).To(ContainSubstring(`"name":"snippet.service"`))
+					return instance, nil
+				}).Times(1)
+				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
+				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+
+			t.Run("should fail when a required Ignition snippet does not exist", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				getInstances(t, g)
+				useIgnition(t, g)
+				ms.AWSMachine.Spec.Ignition = &infrav1.Ignition{
+					Version: "3.3",
+					Snippets: []infrav1.IgnitionSnippet{
+						{
+							Secret: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "missing-ignition-snippet"},
+								Key:                  "config.ign",
+							},
+						},
+					},
+				}
+
+				objectStoreSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("s3://foo/node/bar", nil).Times(1)
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).ToNot(BeNil())
+			})
 		})
 
 		t.Run("there's a node ref and a secret ARN", func(t *testing.T) {
@@ -1281,6 +1760,8 @@ func TestAWSMachineReconciler(t *testing.T) {
 				useIgnition(t, g)
 
 				instance.State = infrav1.InstanceStateRunning
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil)
+				ec2Svc.EXPECT().ReconcileInstanceStatus(gomock.Any(), gomock.Any()).Return(nil)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
 				objectStoreSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
@@ -1363,6 +1844,8 @@ func TestAWSMachineReconciler(t *testing.T) {
 				getInstances(t, g)
 
 				instance.State = infrav1.InstanceStateRunning
+				ec2Svc.EXPECT().GetInstanceProtection(gomock.Any()).Return(false, false, nil)
+				ec2Svc.EXPECT().ReconcileInstanceStatus(gomock.Any(), gomock.Any()).Return(nil)
 				ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil).Times(1)
 				ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil).Times(1)
 				objectStoreSvc.EXPECT().Delete(gomock.Any()).Return(nil).MaxTimes(0)
@@ -1519,6 +2002,51 @@ func TestAWSMachineReconciler(t *testing.T) {
 			g.Expect(buf.String()).To(ContainSubstring("EC2 instance is shutting down or already terminated"))
 			g.Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
 		})
+		t.Run("should delete the launch template the instance was created from", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			ms.AWSMachine.Spec.UseLaunchTemplate = true
+			ms.AWSMachine.Status.LaunchTemplateID = "lt-1"
+			ms.AWSMachine.Status.LaunchTemplateVersion = "1"
+
+			ec2Svc.EXPECT().IsLaunchTemplateOwned("lt-1").Return(true, nil)
+			ec2Svc.EXPECT().DeleteLaunchTemplate("lt-1").Return(nil)
+			ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+				State: infrav1.InstanceStateTerminated,
+			}, nil)
+			secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSMachine.Status.LaunchTemplateID).To(BeEmpty())
+			g.Expect(ms.AWSMachine.Status.LaunchTemplateVersion).To(BeEmpty())
+			g.Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+		})
+		t.Run("should not delete a launch template of the same name not owned by the cluster", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			ms.AWSMachine.Spec.UseLaunchTemplate = true
+
+			ec2Svc.EXPECT().GetLaunchTemplateID(ms.LaunchTemplateName()).Return("lt-other", nil)
+			ec2Svc.EXPECT().IsLaunchTemplateOwned("lt-other").Return(false, nil)
+			ec2Svc.EXPECT().DeleteLaunchTemplate(gomock.Any()).Times(0)
+			ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+				State: infrav1.InstanceStateTerminated,
+			}, nil)
+			secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+		})
 		t.Run("instance not shutting down yet", func(t *testing.T) {
 			id := "aws:////myid"
 			getRunningInstance := func(t *testing.T, g *WithT) {
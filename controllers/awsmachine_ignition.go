@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+
+	ignV30 "github.com/coreos/ignition/v2/config/v3_0"
+	ignV30Types "github.com/coreos/ignition/v2/config/v3_0/types"
+	ignV31 "github.com/coreos/ignition/v2/config/v3_1"
+	ignV31Types "github.com/coreos/ignition/v2/config/v3_1/types"
+	ignV32 "github.com/coreos/ignition/v2/config/v3_2"
+	ignV32Types "github.com/coreos/ignition/v2/config/v3_2/types"
+	ignV33 "github.com/coreos/ignition/v2/config/v3_3"
+	ignV33Types "github.com/coreos/ignition/v2/config/v3_3/types"
+	ignTypes "github.com/flatcar-linux/ignition/config/v2_3/types"
+	"github.com/pkg/errors"
+)
+
+// generateIgnition returns an Ignition config of the given config spec version, which references the
+// bootstrap data at source. The snippets are merged into the config in order, so the bootstrap data
+// takes precedence over them, and the later snippets over the earlier ones. Snippets must not use a
+// later config spec version than the generated config.
+func generateIgnition(version, source string, snippets [][]byte) ([]byte, error) {
+	switch version {
+	case "", "2.3":
+		if len(snippets) > 0 {
+			return nil, errors.New("Ignition snippets require Ignition version 3.0 or later")
+		}
+
+		return json.Marshal(&ignTypes.Config{
+			Ignition: ignTypes.Ignition{
+				Version: "2.3.0",
+				Config: ignTypes.IgnitionConfig{
+					Append: []ignTypes.ConfigReference{
+						{
+							Source: source,
+						},
+					},
+				},
+			},
+		})
+	case "3.0":
+		return generateIgnitionV30(source, snippets)
+	case "3.1":
+		return generateIgnitionV31(source, snippets)
+	case "3.2":
+		return generateIgnitionV32(source, snippets)
+	case "3.3":
+		return generateIgnitionV33(source, snippets)
+	default:
+		return nil, errors.Errorf("unsupported Ignition version %q", version)
+	}
+}
+
+func generateIgnitionV30(source string, snippets [][]byte) ([]byte, error) {
+	config := ignV30Types.Config{
+		Ignition: ignV30Types.Ignition{
+			Version: ignV30Types.MaxVersion.String(),
+			Config: ignV30Types.IgnitionConfig{
+				Merge: []ignV30Types.ConfigReference{
+					{
+						Source: &source,
+					},
+				},
+			},
+		},
+	}
+
+	for i, snippet := range snippets {
+		child, _, err := ignV30.ParseCompatibleVersion(snippet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing Ignition snippet %d", i)
+		}
+		config = ignV30.Merge(config, child)
+	}
+
+	return json.Marshal(&config)
+}
+
+func generateIgnitionV31(source string, snippets [][]byte) ([]byte, error) {
+	config := ignV31Types.Config{
+		Ignition: ignV31Types.Ignition{
+			Version: ignV31Types.MaxVersion.String(),
+			Config: ignV31Types.IgnitionConfig{
+				Merge: []ignV31Types.Resource{
+					{
+						Source: &source,
+					},
+				},
+			},
+		},
+	}
+
+	for i, snippet := range snippets {
+		child, _, err := ignV31.ParseCompatibleVersion(snippet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing Ignition snippet %d", i)
+		}
+		config = ignV31.Merge(config, child)
+	}
+
+	return json.Marshal(&config)
+}
+
+func generateIgnitionV32(source string, snippets [][]byte) ([]byte, error) {
+	config := ignV32Types.Config{
+		Ignition: ignV32Types.Ignition{
+			Version: ignV32Types.MaxVersion.String(),
+			Config: ignV32Types.IgnitionConfig{
+				Merge: []ignV32Types.Resource{
+					{
+						Source: &source,
+					},
+				},
+			},
+		},
+	}
+
+	for i, snippet := range snippets {
+		child, _, err := ignV32.ParseCompatibleVersion(snippet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing Ignition snippet %d", i)
+		}
+		config = ignV32.Merge(config, child)
+	}
+
+	return json.Marshal(&config)
+}
+
+func generateIgnitionV33(source string, snippets [][]byte) ([]byte, error) {
+	config := ignV33Types.Config{
+		Ignition: ignV33Types.Ignition{
+			Version: ignV33Types.MaxVersion.String(),
+			Config: ignV33Types.IgnitionConfig{
+				Merge: []ignV33Types.Resource{
+					{
+						Source: &source,
+					},
+				},
+			},
+		},
+	}
+
+	for i, snippet := range snippets {
+		child, _, err := ignV33.ParseCompatibleVersion(snippet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing Ignition snippet %d", i)
+		}
+		config = ignV33.Merge(config, child)
+	}
+
+	return json.Marshal(&config)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	service "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+)
+
+// ensureInstanceProtection ensures that the termination and stop protection of the instance
+// match the machine. The protection is only modified when it differs from the one of the instance,
+// so that changes made outside of the controller are reverted as well.
+func (r *AWSMachineReconciler) ensureInstanceProtection(svc service.EC2Interface, machineScope *scope.MachineScope, instanceID string) error {
+	disableAPITermination := machineScope.DisableAPITermination()
+	disableAPIStop := machineScope.DisableAPIStop()
+
+	currentDisableAPITermination, currentDisableAPIStop, err := svc.GetInstanceProtection(instanceID)
+	if err != nil {
+		return err
+	}
+
+	if disableAPITermination != currentDisableAPITermination {
+		if err := svc.SetTerminationProtection(instanceID, disableAPITermination); err != nil {
+			return err
+		}
+	}
+
+	if disableAPIStop != currentDisableAPIStop {
+		if err := svc.SetStopProtection(instanceID, disableAPIStop); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// AWSMachineTemplateReconciler reconciles the AMI update policy of AWSMachineTemplates.
+type AWSMachineTemplateReconciler struct {
+	client.Client
+	Recorder          record.EventRecorder
+	ec2ServiceFactory func(scope.EC2Scope) services.EC2Interface
+	Endpoints         []scope.ServiceEndpoint
+	WatchFilterValue  string
+}
+
+func (r *AWSMachineTemplateReconciler) getEC2Service(scope scope.EC2Scope) services.EC2Interface {
+	if r.ec2ServiceFactory != nil {
+		return r.ec2ServiceFactory(scope)
+	}
+
+	return ec2.NewService(scope)
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinetemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get;list;watch
+
+func (r *AWSMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Fetch the AWSMachineTemplate.
+	awsMachineTemplate := &infrav1.AWSMachineTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, awsMachineTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	policy := awsMachineTemplate.Spec.AMIUpdatePolicy
+	if policy == nil {
+		return ctrl.Result{}, nil
+	}
+
+	// Wait for the check interval to elapse since the last AMI lookup.
+	if latest := awsMachineTemplate.Status.LatestAMIAvailable; latest != nil {
+		if remaining := policy.GetCheckInterval() - time.Since(latest.CheckTime.Time); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	cluster, kubernetesVersion, err := r.getClusterAndVersion(ctx, awsMachineTemplate)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("AWSMachineTemplate is not used by a MachineDeployment and has no cluster label")
+		return ctrl.Result{}, nil
+	}
+
+	if annotations.IsPaused(cluster, awsMachineTemplate) {
+		log.Info("AWSMachineTemplate or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+
+	infraCluster, err := r.getInfraCluster(ctx, log, cluster, awsMachineTemplate)
+	if err != nil {
+		return ctrl.Result{}, errors.New("error getting infra provider cluster or control plane object")
+	}
+	if infraCluster == nil {
+		log.Info("AWSCluster or AWSManagedControlPlane is not ready yet")
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(awsMachineTemplate, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the status when exiting this function so we can persist the result of the lookup.
+	defer func() {
+		if err := patchHelper.Patch(ctx, awsMachineTemplate); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	imageID, err := r.getEC2Service(infraCluster).DiscoverMachineAMI(&awsMachineTemplate.Spec.Template.Spec, kubernetesVersion)
+	if err != nil {
+		r.Recorder.Eventf(awsMachineTemplate, corev1.EventTypeWarning, "FailedAMILookup", "Failed to look up the latest AMI: %v", err)
+		return ctrl.Result{}, err
+	}
+
+	if latest := awsMachineTemplate.Status.LatestAMIAvailable; latest != nil && latest.ID != imageID {
+		r.Recorder.Eventf(awsMachineTemplate, corev1.EventTypeNormal, "NewerAMIAvailable", "AMI %s is available, replacing AMI %s", imageID, latest.ID)
+	}
+
+	awsMachineTemplate.Status.LatestAMIAvailable = &infrav1.LatestAMIStatus{
+		ID:        imageID,
+		CheckTime: metav1.Now(),
+	}
+
+	return ctrl.Result{RequeueAfter: policy.GetCheckInterval()}, nil
+}
+
+func (r *AWSMachineTemplateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1.AWSMachineTemplate{}).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(log, r.WatchFilterValue)).
+		Complete(r)
+}
+
+// getClusterAndVersion returns the cluster and the Kubernetes version of the first MachineDeployment using the
+// template. Templates not used by a MachineDeployment fall back to the cluster label and the cluster topology version.
+func (r *AWSMachineTemplateReconciler) getClusterAndVersion(ctx context.Context, awsMachineTemplate *infrav1.AWSMachineTemplate) (*clusterv1.Cluster, string, error) {
+	machineDeployments := &clusterv1.MachineDeploymentList{}
+	if err := r.List(ctx, machineDeployments, client.InNamespace(awsMachineTemplate.Namespace)); err != nil {
+		return nil, "", errors.Wrap(err, "failed to list MachineDeployments")
+	}
+
+	for _, md := range machineDeployments.Items {
+		ref := md.Spec.Template.Spec.InfrastructureRef
+		if ref.Kind != "AWSMachineTemplate" || ref.Name != awsMachineTemplate.Name {
+			continue
+		}
+
+		cluster, err := util.GetClusterByName(ctx, r.Client, md.Namespace, md.Spec.ClusterName)
+		if err != nil {
+			return nil, "", err
+		}
+		return cluster, pointer.StringDeref(md.Spec.Template.Spec.Version, ""), nil
+	}
+
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, awsMachineTemplate.ObjectMeta)
+	if err != nil {
+		return nil, "", nil // nolint:nilerr
+	}
+	if cluster.Spec.Topology != nil {
+		return cluster, cluster.Spec.Topology.Version, nil
+	}
+	return cluster, "", nil
+}
+
+func (r *AWSMachineTemplateReconciler) getInfraCluster(ctx context.Context, log logr.Logger, cluster *clusterv1.Cluster, awsMachineTemplate *infrav1.AWSMachineTemplate) (scope.EC2Scope, error) {
+	if cluster.Spec.ControlPlaneRef != nil && cluster.Spec.ControlPlaneRef.Kind == AWSManagedControlPlaneRefKind {
+		controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+		controlPlaneName := client.ObjectKey{
+			Namespace: awsMachineTemplate.Namespace,
+			Name:      cluster.Spec.ControlPlaneRef.Name,
+		}
+
+		if err := r.Get(ctx, controlPlaneName, controlPlane); err != nil {
+			// AWSManagedControlPlane is not ready
+			return nil, nil // nolint:nilerr
+		}
+
+		return scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client:         r.Client,
+			Logger:         &log,
+			Cluster:        cluster,
+			ControlPlane:   controlPlane,
+			ControllerName: "awsManagedControlPlane",
+			Endpoints:      r.Endpoints,
+		})
+	}
+
+	awsCluster := &infrav1.AWSCluster{}
+	infraClusterName := client.ObjectKey{
+		Namespace: awsMachineTemplate.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+
+	if err := r.Get(ctx, infraClusterName, awsCluster); err != nil {
+		// AWSCluster is not ready
+		return nil, nil // nolint:nilerr
+	}
+
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:         r.Client,
+		Logger:         &log,
+		Cluster:        cluster,
+		AWSCluster:     awsCluster,
+		ControllerName: "awsmachinetemplate",
+		Endpoints:      r.Endpoints,
+	})
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestAWSMachineTemplateReconciler_Reconcile(t *testing.T) {
+	newTemplate := func(policy *infrav1.AMIUpdatePolicy, latest *infrav1.LatestAMIStatus) *infrav1.AWSMachineTemplate {
+		return &infrav1.AWSMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "template", Namespace: "default"},
+			Spec: infrav1.AWSMachineTemplateSpec{
+				Template: infrav1.AWSMachineTemplateResource{
+					Spec: infrav1.AWSMachineSpec{InstanceType: "m5.large"},
+				},
+				AMIUpdatePolicy: policy,
+			},
+			Status: infrav1.AWSMachineTemplateStatus{LatestAMIAvailable: latest},
+		}
+	}
+	objects := func(template *infrav1.AWSMachineTemplate) []client.Object {
+		return []client.Object{
+			template,
+			&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: &corev1.ObjectReference{Kind: "AWSCluster", Name: "test-cluster"},
+				},
+			},
+			&infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				Spec:       infrav1.AWSClusterSpec{Region: "us-east-1"},
+			},
+			&clusterv1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: "default"},
+				Spec: clusterv1.MachineDeploymentSpec{
+					ClusterName: "test-cluster",
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							ClusterName: "test-cluster",
+							Version:     pointer.String("v1.22.3"),
+							InfrastructureRef: corev1.ObjectReference{
+								Kind: "AWSMachineTemplate",
+								Name: "template",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		template     *infrav1.AWSMachineTemplate
+		expect       func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantRequeue  bool
+		wantLatestID string
+		wantEvent    bool
+	}{
+		{
+			name:     "should do nothing without an AMI update policy",
+			template: newTemplate(nil, nil),
+			expect:   func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+		},
+		{
+			name:     "should report the latest AMI found by the lookup",
+			template: newTemplate(&infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport}, nil),
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.DiscoverMachineAMI(gomock.Any(), "v1.22.3").Return("ami-latest", nil)
+			},
+			wantRequeue:  true,
+			wantLatestID: "ami-latest",
+		},
+		{
+			name: "should emit an event when a newer AMI is found",
+			template: newTemplate(&infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport},
+				&infrav1.LatestAMIStatus{ID: "ami-old", CheckTime: metav1.NewTime(time.Now().Add(-2 * time.Hour))}),
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.DiscoverMachineAMI(gomock.Any(), "v1.22.3").Return("ami-latest", nil)
+			},
+			wantRequeue:  true,
+			wantLatestID: "ami-latest",
+			wantEvent:    true,
+		},
+		{
+			name: "should not look up the AMI before the check interval elapsed",
+			template: newTemplate(&infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport},
+				&infrav1.LatestAMIStatus{ID: "ami-old", CheckTime: metav1.NewTime(time.Now().Add(-time.Minute))}),
+			expect:       func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+			wantRequeue:  true,
+			wantLatestID: "ami-old",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			tc.expect(ec2Svc.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects(tc.template)...).Build()
+			recorder := record.NewFakeRecorder(1)
+
+			reconciler := &AWSMachineTemplateReconciler{
+				Client:   c,
+				Recorder: recorder,
+				ec2ServiceFactory: func(scope.EC2Scope) services.EC2Interface {
+					return ec2Svc
+				},
+			}
+
+			result, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tc.template)})
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter > 0).To(Equal(tc.wantRequeue))
+
+			template := &infrav1.AWSMachineTemplate{}
+			g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(tc.template), template)).To(Succeed())
+			if tc.wantLatestID == "" {
+				g.Expect(template.Status.LatestAMIAvailable).To(BeNil())
+			} else {
+				g.Expect(template.Status.LatestAMIAvailable).ToNot(BeNil())
+				g.Expect(template.Status.LatestAMIAvailable.ID).To(Equal(tc.wantLatestID))
+			}
+
+			if tc.wantEvent {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring("NewerAMIAvailable")))
+			} else {
+				g.Expect(recorder.Events).ToNot(Receive())
+			}
+		})
+	}
+}
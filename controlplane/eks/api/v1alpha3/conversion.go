@@ -42,7 +42,16 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Status.IdentityProviderStatus = restored.Status.IdentityProviderStatus
 	dst.Status.Bastion = restored.Status.Bastion
+	dst.Spec.Bastion.AMILookup = restored.Spec.Bastion.AMILookup
 	dst.Spec.OIDCIdentityProviderConfig = restored.Spec.OIDCIdentityProviderConfig
+	dst.Spec.Proxy = restored.Spec.Proxy
+	dst.Spec.AdditionalTrustBundle = restored.Spec.AdditionalTrustBundle
+
+	for i := range dst.Spec.NetworkSpec.Subnets {
+		if i < len(restored.Spec.NetworkSpec.Subnets) {
+			dst.Spec.NetworkSpec.Subnets[i].PrivateDNSNameOptionsOnLaunch = restored.Spec.NetworkSpec.Subnets[i].PrivateDNSNameOptionsOnLaunch
+		}
+	}
 
 	return nil
 }
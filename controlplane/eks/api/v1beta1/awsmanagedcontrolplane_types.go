@@ -166,6 +166,18 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// Amazon VPC CNI addon or if you have specified a secondary CIDR block.
 	// +kubebuilder:default=false
 	DisableVPCCNI bool `json:"disableVPCCNI,omitempty"`
+
+	// Proxy is the HTTP proxy used by the nodes and the bastion of the cluster to reach the AWS
+	// APIs and the internet. It is configured for the EKS bootstrap script and the container runtime
+	// and kubelet of nodes bootstrapped by EKSConfig.
+	// +optional
+	Proxy *infrav1.ProxyConfig `json:"proxy,omitempty"`
+
+	// AdditionalTrustBundle is a PEM encoded bundle of CA certificates which the nodes and the
+	// bastion of the cluster trust in addition to the system trust store, e.g. to reach a TLS
+	// intercepting proxy.
+	// +optional
+	AdditionalTrustBundle string `json:"additionalTrustBundle,omitempty"`
 }
 
 // EndpointAccess specifies how control plane endpoints are accessible.
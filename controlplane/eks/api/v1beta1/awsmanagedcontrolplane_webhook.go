@@ -95,6 +95,8 @@ func (r *AWSManagedControlPlane) ValidateCreate() error {
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.Proxy.Validate(field.NewPath("spec", "proxy"))...)
+	allErrs = append(allErrs, infrav1.ValidateTrustBundle(r.Spec.AdditionalTrustBundle, field.NewPath("spec", "additionalTrustBundle"))...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -127,6 +129,8 @@ func (r *AWSManagedControlPlane) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.Proxy.Validate(field.NewPath("spec", "proxy"))...)
+	allErrs = append(allErrs, infrav1.ValidateTrustBundle(r.Spec.AdditionalTrustBundle, field.NewPath("spec", "additionalTrustBundle"))...)
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
 		allErrs = append(allErrs,
@@ -45,6 +45,21 @@ func (r *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		}
 		infrav1alpha3.RestoreRootVolume(restored.Spec.AWSLaunchTemplate.RootVolume, dst.Spec.AWSLaunchTemplate.RootVolume)
 	}
+	dst.Spec.AWSLaunchTemplate.CPUOptions = restored.Spec.AWSLaunchTemplate.CPUOptions
+	dst.Spec.AWSLaunchTemplate.HibernationOptions = restored.Spec.AWSLaunchTemplate.HibernationOptions
+	dst.Spec.AWSLaunchTemplate.EnclaveOptions = restored.Spec.AWSLaunchTemplate.EnclaveOptions
+	dst.Spec.AWSLaunchTemplate.BootMode = restored.Spec.AWSLaunchTemplate.BootMode
+	dst.Spec.AWSLaunchTemplate.NetworkInterfaceSpecs = restored.Spec.AWSLaunchTemplate.NetworkInterfaceSpecs
+	dst.Spec.AWSLaunchTemplate.PrivateDNSName = restored.Spec.AWSLaunchTemplate.PrivateDNSName
+	dst.Spec.AWSLaunchTemplate.Tenancy = restored.Spec.AWSLaunchTemplate.Tenancy
+	dst.Spec.AWSLaunchTemplate.HostID = restored.Spec.AWSLaunchTemplate.HostID
+	dst.Spec.AWSLaunchTemplate.HostResourceGroupArn = restored.Spec.AWSLaunchTemplate.HostResourceGroupArn
+	dst.Spec.AWSLaunchTemplate.HostAffinity = restored.Spec.AWSLaunchTemplate.HostAffinity
+	dst.Spec.AWSLaunchTemplate.DisableAPITermination = restored.Spec.AWSLaunchTemplate.DisableAPITermination
+	dst.Spec.AWSLaunchTemplate.DisableAPIStop = restored.Spec.AWSLaunchTemplate.DisableAPIStop
+	dst.Spec.AWSLaunchTemplate.AMIUpdatePolicy = restored.Spec.AWSLaunchTemplate.AMIUpdatePolicy
+	dst.Status.LatestAMIAvailable = restored.Status.LatestAMIAvailable
+	dst.Status.AMILookupHash = restored.Status.AMILookupHash
 	return nil
 }
 
@@ -196,3 +211,13 @@ func Convert_v1beta1_Volume_To_v1alpha3_Volume(in *infrav1.Volume, out *infrav1a
 func Convert_v1alpha3_Volume_To_v1beta1_Volume(in *infrav1alpha3.Volume, out *infrav1.Volume, s apiconversion.Scope) error {
 	return infrav1alpha3.Convert_v1alpha3_Volume_To_v1beta1_Volume(in, out, s)
 }
+
+// Convert_v1beta1_AWSLaunchTemplate_To_v1alpha3_AWSLaunchTemplate is a conversion function.
+func Convert_v1beta1_AWSLaunchTemplate_To_v1alpha3_AWSLaunchTemplate(in *infrav1exp.AWSLaunchTemplate, out *AWSLaunchTemplate, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSLaunchTemplate_To_v1alpha3_AWSLaunchTemplate(in, out, s)
+}
+
+// Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha3_AWSMachinePoolStatus is a conversion function.
+func Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha3_AWSMachinePoolStatus(in *infrav1exp.AWSMachinePoolStatus, out *AWSMachinePoolStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachinePoolStatus_To_v1alpha3_AWSMachinePoolStatus(in, out, s)
+}
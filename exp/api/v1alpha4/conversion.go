@@ -28,14 +28,54 @@ import (
 // ConvertTo converts the v1alpha4 AWSMachinePool receiver to a v1beta1 AWSMachinePool.
 func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1exp.AWSMachinePool)
-	return Convert_v1alpha4_AWSMachinePool_To_v1beta1_AWSMachinePool(src, dst, nil)
+	if err := Convert_v1alpha4_AWSMachinePool_To_v1beta1_AWSMachinePool(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &infrav1exp.AWSMachinePool{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreAWSLaunchTemplate(&restored.Spec.AWSLaunchTemplate, &dst.Spec.AWSLaunchTemplate)
+	dst.Status.LatestAMIAvailable = restored.Status.LatestAMIAvailable
+	dst.Status.AMILookupHash = restored.Status.AMILookupHash
+
+	return nil
+}
+
+// restoreAWSLaunchTemplate manually restores the AWSLaunchTemplate fields which do not exist in v1alpha4.
+// Assumes both restored and dst are non-nil.
+func restoreAWSLaunchTemplate(restored, dst *infrav1exp.AWSLaunchTemplate) {
+	infrav1alpha4.RestoreAMIReference(&restored.AMI, &dst.AMI)
+	if restored.RootVolume != nil && dst.RootVolume != nil {
+		dst.RootVolume.SnapshotID = restored.RootVolume.SnapshotID
+		dst.RootVolume.DeleteOnTermination = restored.RootVolume.DeleteOnTermination
+	}
+	dst.CPUOptions = restored.CPUOptions
+	dst.HibernationOptions = restored.HibernationOptions
+	dst.EnclaveOptions = restored.EnclaveOptions
+	dst.BootMode = restored.BootMode
+	dst.NetworkInterfaceSpecs = restored.NetworkInterfaceSpecs
+	dst.PrivateDNSName = restored.PrivateDNSName
+	dst.Tenancy = restored.Tenancy
+	dst.HostID = restored.HostID
+	dst.HostResourceGroupArn = restored.HostResourceGroupArn
+	dst.HostAffinity = restored.HostAffinity
+	dst.DisableAPITermination = restored.DisableAPITermination
+	dst.DisableAPIStop = restored.DisableAPIStop
+	dst.AMIUpdatePolicy = restored.AMIUpdatePolicy
 }
 
 // ConvertFrom converts the v1beta1 AWSMachinePool receiver to v1alpha4 AWSMachinePool.
 func (r *AWSMachinePool) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1exp.AWSMachinePool)
 
-	return Convert_v1beta1_AWSMachinePool_To_v1alpha4_AWSMachinePool(src, r, nil)
+	if err := Convert_v1beta1_AWSMachinePool_To_v1alpha4_AWSMachinePool(src, r, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, r)
 }
 
 // ConvertTo converts the v1alpha4 AWSMachinePoolList receiver to a v1beta1 AWSMachinePoolList.
@@ -147,3 +187,23 @@ func Convert_v1beta1_Instance_To_v1alpha4_Instance(in *infrav1.Instance, out *in
 func Convert_v1alpha4_Instance_To_v1beta1_Instance(in *infrav1alpha4.Instance, out *infrav1.Instance, s apiconversion.Scope) error {
 	return infrav1alpha4.Convert_v1alpha4_Instance_To_v1beta1_Instance(in, out, s)
 }
+
+// Convert_v1beta1_Volume_To_v1alpha4_Volume is a conversion function.
+func Convert_v1beta1_Volume_To_v1alpha4_Volume(in *infrav1.Volume, out *infrav1alpha4.Volume, s apiconversion.Scope) error {
+	return infrav1alpha4.Convert_v1beta1_Volume_To_v1alpha4_Volume(in, out, s)
+}
+
+// Convert_v1alpha4_Volume_To_v1beta1_Volume is a conversion function.
+func Convert_v1alpha4_Volume_To_v1beta1_Volume(in *infrav1alpha4.Volume, out *infrav1.Volume, s apiconversion.Scope) error {
+	return infrav1alpha4.Convert_v1alpha4_Volume_To_v1beta1_Volume(in, out, s)
+}
+
+// Convert_v1beta1_AWSLaunchTemplate_To_v1alpha4_AWSLaunchTemplate is a conversion function.
+func Convert_v1beta1_AWSLaunchTemplate_To_v1alpha4_AWSLaunchTemplate(in *infrav1exp.AWSLaunchTemplate, out *AWSLaunchTemplate, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSLaunchTemplate_To_v1alpha4_AWSLaunchTemplate(in, out, s)
+}
+
+// Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha4_AWSMachinePoolStatus is a conversion function.
+func Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha4_AWSMachinePoolStatus(in *infrav1exp.AWSMachinePoolStatus, out *AWSMachinePoolStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachinePoolStatus_To_v1alpha4_AWSMachinePoolStatus(in, out, s)
+}
@@ -168,6 +168,16 @@ type AWSMachinePoolStatus struct {
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
 	ASGStatus *ASGStatus `json:"asgStatus,omitempty"`
+
+	// LatestAMIAvailable is the most recent AMI found by the lookup of the AMI update policy.
+	// +optional
+	LatestAMIAvailable *infrav1.LatestAMIStatus `json:"latestAMIAvailable,omitempty"`
+
+	// AMILookupHash is a hash of the Kubernetes version and the AMI lookup settings the AMI of the
+	// launch template was found with. An AMI found after these change is rolled out right away,
+	// regardless of the AMI update policy.
+	// +optional
+	AMILookupHash string `json:"amiLookupHash,omitempty"`
 }
 
 // AWSMachinePoolInstanceStatus defines the status of the AWSMachinePoolInstance.
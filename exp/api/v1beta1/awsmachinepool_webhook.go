@@ -98,6 +98,105 @@ func (r *AWSMachinePool) validateSubnets() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateInstanceOptions() field.ErrorList {
+	lt := r.Spec.AWSLaunchTemplate
+
+	spot := false
+	if r.Spec.MixedInstancesPolicy != nil && r.Spec.MixedInstancesPolicy.InstancesDistribution != nil {
+		onDemandPercentage := r.Spec.MixedInstancesPolicy.InstancesDistribution.OnDemandPercentageAboveBaseCapacity
+		spot = onDemandPercentage != nil && *onDemandPercentage < 100
+	}
+
+	allErrs := v1beta1.InstanceOptions{
+		InstanceType:          lt.InstanceType,
+		Spot:                  spot,
+		CPUOptions:            lt.CPUOptions,
+		HibernationOptions:    lt.HibernationOptions,
+		EnclaveOptions:        lt.EnclaveOptions,
+		BootMode:              lt.BootMode,
+		NetworkInterfaceSpecs: lt.NetworkInterfaceSpecs,
+	}.Validate(field.NewPath("spec", "awsLaunchTemplate"))
+
+	for i, spec := range lt.NetworkInterfaceSpecs {
+		if spec.Subnet != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "awsLaunchTemplate", "networkInterfaceSpecs").Index(i).Child("subnet"),
+				"the subnet of the network interfaces is chosen by the Auto Scaling group"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMachineOnlyFields rejects the launch template fields which are only supported by the launch
+// templates of AWSMachines.
+func (r *AWSMachinePool) validateMachineOnlyFields() field.ErrorList {
+	var allErrs field.ErrorList
+
+	lt := r.Spec.AWSLaunchTemplate
+	fldPath := field.NewPath("spec", "awsLaunchTemplate")
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"tenancy", lt.Tenancy != ""},
+		{"hostID", lt.HostID != nil},
+		{"hostResourceGroupArn", lt.HostResourceGroupArn != nil},
+		{"hostAffinity", lt.HostAffinity != ""},
+		{"disableApiTermination", lt.DisableAPITermination != nil},
+		{"disableApiStop", lt.DisableAPIStop != nil},
+	} {
+		if f.set {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(f.name), "is only supported by the launch templates of AWSMachines"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateArchitecture checks that the instance types of the launch template and the mixed instances
+// policy overrides share one architecture, since the launch template has a single AMI.
+func (r *AWSMachinePool) validateArchitecture() field.ErrorList {
+	var allErrs field.ErrorList
+
+	lt := r.Spec.AWSLaunchTemplate
+	arch, archSource := v1beta1.InstanceTypeArchitecture(lt.InstanceType), lt.InstanceType
+
+	if r.Spec.MixedInstancesPolicy != nil {
+		for i, override := range r.Spec.MixedInstancesPolicy.Overrides {
+			overrideArch := v1beta1.InstanceTypeArchitecture(override.InstanceType)
+			switch {
+			case overrideArch == "":
+			case arch == "":
+				arch, archSource = overrideArch, override.InstanceType
+			case overrideArch != arch:
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "mixedInstancesPolicy", "overrides").Index(i).Child("instanceType"), override.InstanceType,
+					"architecture "+string(overrideArch)+" differs from the "+string(arch)+" architecture of instance type "+archSource))
+			}
+		}
+	}
+
+	allErrs = append(allErrs, lt.AMI.ValidateArchitecture(field.NewPath("spec", "awsLaunchTemplate", "ami"), archSource)...)
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateAMIUpdatePolicy() field.ErrorList {
+	var allErrs field.ErrorList
+
+	lt := r.Spec.AWSLaunchTemplate
+	if lt.AMIUpdatePolicy == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "awsLaunchTemplate", "amiUpdatePolicy")
+	if lt.AMI.ID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set if spec.awsLaunchTemplate.ami.id is set"))
+	}
+	allErrs = append(allErrs, lt.AMIUpdatePolicy.Validate(fldPath)...)
+
+	return allErrs
+}
+
 // ValidateCreate will do any extra validation when creating a AWSMachinePool.
 func (r *AWSMachinePool) ValidateCreate() error {
 	log.Info("AWSMachinePool validate create", "name", r.Name)
@@ -108,6 +207,11 @@ func (r *AWSMachinePool) ValidateCreate() error {
 	allErrs = append(allErrs, r.validateRootVolume()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
+	allErrs = append(allErrs, r.validateInstanceOptions()...)
+	allErrs = append(allErrs, r.validateMachineOnlyFields()...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.AMI.Validate(field.NewPath("spec", "awsLaunchTemplate", "ami"))...)
+	allErrs = append(allErrs, r.validateArchitecture()...)
+	allErrs = append(allErrs, r.validateAMIUpdatePolicy()...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -127,6 +231,11 @@ func (r *AWSMachinePool) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.validateDefaultCoolDown()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
+	allErrs = append(allErrs, r.validateInstanceOptions()...)
+	allErrs = append(allErrs, r.validateMachineOnlyFields()...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.AMI.Validate(field.NewPath("spec", "awsLaunchTemplate", "ami"))...)
+	allErrs = append(allErrs, r.validateArchitecture()...)
+	allErrs = append(allErrs, r.validateAMIUpdatePolicy()...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -106,6 +107,117 @@ func TestAWSMachinePool_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Should pass if network interface specs are set without a subnet",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						InstanceType: "p4d.24xlarge",
+						NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+							{DeviceIndex: 0, InterfaceType: infrav1.NetworkInterfaceTypeEFA},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail if the subnet of a network interface is set",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						InstanceType: "p4d.24xlarge",
+						NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+							{DeviceIndex: 0, Subnet: &infrav1.AWSResourceReference{ID: pointer.StringPtr("subnet-id")}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should pass if the mixed instances policy overrides share one architecture",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{InstanceType: "m6g.large"},
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{{InstanceType: "c6g.large"}, {InstanceType: "t4g.large"}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail if the mixed instances policy overrides mix architectures",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{InstanceType: "m6g.large"},
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{{InstanceType: "c6g.large"}, {InstanceType: "m5.large"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if the AMI architecture does not match the instance types",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						AMI: infrav1.AMIReference{
+							Owners:  []string{"amazon"},
+							Filters: []infrav1.Filter{{Name: "architecture", Values: []string{"x86_64"}}},
+						},
+					},
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{{InstanceType: "m6g.large"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should pass if the AMI update policy rolls out looked up AMIs within a maintenance window",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						AMIUpdatePolicy: &infrav1.AMIUpdatePolicy{
+							Mode: infrav1.AMIUpdateModeAuto,
+							MaintenanceWindow: &infrav1.MaintenanceWindow{
+								Days:      []string{"Saturday"},
+								StartTime: "02:00",
+								Duration:  metav1.Duration{Duration: 4 * time.Hour},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail if placement or protection settings of machine launch templates are set",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						Tenancy:               "host",
+						DisableAPITermination: pointer.Bool(true),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if the AMI update policy is set with an AMI ID",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						AMI:             infrav1.AMIReference{ID: pointer.String("ami-1234")},
+						AMIUpdatePolicy: &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -89,6 +89,13 @@ type AWSLaunchTemplate struct {
 	// image lookup the AMI is not set.
 	ImageLookupBaseOS string `json:"imageLookupBaseOS,omitempty"`
 
+	// AMIUpdatePolicy periodically re-runs the AMI lookup and reports the most recent AMI in the status.
+	// In auto mode, the most recent AMI is rolled out with a new launch template version and an
+	// instance refresh. Without a policy, a different AMI found by the lookup is rolled out on the next
+	// reconciliation.
+	// +optional
+	AMIUpdatePolicy *infrav1.AMIUpdatePolicy `json:"amiUpdatePolicy,omitempty"`
+
 	// InstanceType is the type of instance to create. Example: m4.xlarge
 	InstanceType string `json:"instanceType,omitempty"`
 
@@ -113,6 +120,71 @@ type AWSLaunchTemplate struct {
 	// at the cluster level or in the actuator.
 	// +optional
 	AdditionalSecurityGroups []infrav1.AWSResourceReference `json:"additionalSecurityGroups,omitempty"`
+
+	// CPUOptions sets the number of CPU cores and threads per core of the instances.
+	// If not set, the defaults of the instance type are used.
+	// +optional
+	CPUOptions *infrav1.CPUOptions `json:"cpuOptions,omitempty"`
+
+	// HibernationOptions enables the instances for hibernation.
+	// Hibernation cannot be combined with spot instances or Nitro Enclaves.
+	// +optional
+	HibernationOptions *infrav1.HibernationOptions `json:"hibernationOptions,omitempty"`
+
+	// EnclaveOptions enables the instances for AWS Nitro Enclaves.
+	// +optional
+	EnclaveOptions *infrav1.EnclaveOptions `json:"enclaveOptions,omitempty"`
+
+	// BootMode is the boot mode required for the instances. The boot mode is a property of the AMI,
+	// so when set the AMI and the instance type are checked to support it before the launch template is created.
+	// Use uefi for UEFI Secure Boot and NitroTPM.
+	// +optional
+	// +kubebuilder:validation:Enum:=legacy-bios;uefi
+	BootMode infrav1.BootMode `json:"bootMode,omitempty"`
+
+	// NetworkInterfaceSpecs is a list of network interfaces to create and attach to the instances
+	// at launch, such as Elastic Fabric Adapters. If set, it must contain the primary interface with
+	// device index 0. The interfaces are created in the subnet chosen by the Auto Scaling group,
+	// so their subnet cannot be set.
+	// +optional
+	NetworkInterfaceSpecs []infrav1.NetworkInterfaceSpec `json:"networkInterfaceSpecs,omitempty"`
+
+	// PrivateDNSName is the hostname type of the instances and the DNS records of their
+	// resource-based hostnames. Defaults to the options of the subnets the instances are launched in.
+	// +optional
+	PrivateDNSName *infrav1.PrivateDNSName `json:"privateDnsName,omitempty"`
+
+	// Tenancy indicates if the instances run on shared or single-tenant hardware.
+	// Only supported by the launch templates of AWSMachines.
+	// +optional
+	// +kubebuilder:validation:Enum:=default;dedicated;host
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// HostID is the ID of the dedicated host to launch the instances on.
+	// Only supported by the launch templates of AWSMachines.
+	// +optional
+	HostID *string `json:"hostID,omitempty"`
+
+	// HostResourceGroupArn is the ARN of the host resource group to launch the instances in.
+	// Only supported by the launch templates of AWSMachines.
+	// +optional
+	HostResourceGroupArn *string `json:"hostResourceGroupArn,omitempty"`
+
+	// HostAffinity indicates whether a stopped instance restarts on the same dedicated host.
+	// Only supported by the launch templates of AWSMachines.
+	// +optional
+	// +kubebuilder:validation:Enum:=default;host
+	HostAffinity string `json:"hostAffinity,omitempty"`
+
+	// DisableAPITermination enables termination protection of the instances.
+	// Only supported by the launch templates of AWSMachines.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
+	// DisableAPIStop enables stop protection of the instances.
+	// Only supported by the launch templates of AWSMachines.
+	// +optional
+	DisableAPIStop *bool `json:"disableApiStop,omitempty"`
 }
 
 // Overrides are used to override the instance type specified by the launch template with multiple
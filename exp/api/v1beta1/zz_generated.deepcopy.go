@@ -91,6 +91,11 @@ func (in *AWSFargateProfileList) DeepCopyObject() runtime.Object {
 func (in *AWSLaunchTemplate) DeepCopyInto(out *AWSLaunchTemplate) {
 	*out = *in
 	in.AMI.DeepCopyInto(&out.AMI)
+	if in.AMIUpdatePolicy != nil {
+		in, out := &in.AMIUpdatePolicy, &out.AMIUpdatePolicy
+		*out = new(apiv1beta1.AMIUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RootVolume != nil {
 		in, out := &in.RootVolume, &out.RootVolume
 		*out = new(apiv1beta1.Volume)
@@ -113,6 +118,53 @@ func (in *AWSLaunchTemplate) DeepCopyInto(out *AWSLaunchTemplate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(apiv1beta1.CPUOptions)
+		**out = **in
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(apiv1beta1.HibernationOptions)
+		**out = **in
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(apiv1beta1.EnclaveOptions)
+		**out = **in
+	}
+	if in.NetworkInterfaceSpecs != nil {
+		in, out := &in.NetworkInterfaceSpecs, &out.NetworkInterfaceSpecs
+		*out = make([]apiv1beta1.NetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(apiv1beta1.PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupArn != nil {
+		in, out := &in.HostResourceGroupArn, &out.HostResourceGroupArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableAPIStop != nil {
+		in, out := &in.DisableAPIStop, &out.DisableAPIStop
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLaunchTemplate.
@@ -287,6 +339,11 @@ func (in *AWSMachinePoolStatus) DeepCopyInto(out *AWSMachinePoolStatus) {
 		*out = new(ASGStatus)
 		**out = **in
 	}
+	if in.LatestAMIAvailable != nil {
+		in, out := &in.LatestAMIAvailable, &out.LatestAMIAvailable
+		*out = new(apiv1beta1.LatestAMIStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachinePoolStatus.
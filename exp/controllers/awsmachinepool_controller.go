@@ -18,7 +18,9 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
@@ -40,19 +42,29 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/controllers"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	asg "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+const (
+	// InstanceIDIndex defines the AWSMachinePool controller's index of the instance IDs of the pool's ProviderIDList.
+	InstanceIDIndex = ".spec.providerIDList.instanceID"
+	// AutoScalingGroupNameIndex defines the AWSMachinePool controller's index of the name of the pool's Auto Scaling group.
+	AutoScalingGroupNameIndex = ".autoScalingGroupName"
+)
+
 // AWSMachinePoolReconciler reconciles a AWSMachinePool object.
 type AWSMachinePoolReconciler struct {
 	client.Client
@@ -178,6 +190,20 @@ func (r *AWSMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 }
 
 func (r *AWSMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	// Add indexes to AWSMachinePool to find it from the events of its instances and Auto Scaling group
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &expinfrav1.AWSMachinePool{},
+		InstanceIDIndex,
+		indexAWSMachinePoolByInstanceID,
+	); err != nil {
+		return errors.Wrap(err, "error setting index fields")
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &expinfrav1.AWSMachinePool{},
+		AutoScalingGroupNameIndex,
+		indexAWSMachinePoolByAutoScalingGroupName,
+	); err != nil {
+		return errors.Wrap(err, "error setting index fields")
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&expinfrav1.AWSMachinePool{}).
@@ -268,6 +294,25 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		providerIDList[i] = fmt.Sprintf("aws:///%s/%s", ec2.AvailabilityZone, ec2.ID)
 	}
 
+	// The EventBridge rules are only set up for AWSClusters.
+	if _, ok := clusterScope.(*scope.ClusterScope); ok && feature.Gates.Enabled(feature.EventBridgeInstanceState) {
+		instancestateSvc := instancestate.NewService(ec2Scope)
+		instanceIDs := instanceIDsFromProviderIDs(providerIDList)
+		if err := instancestateSvc.AddMachinePoolToEventPattern(asg.Name, instanceIDs); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "failed to add AWSMachinePool to Event Bridge rules")
+		}
+		// stop tracking the instances which left the pool
+		removed := []string{}
+		for _, id := range instanceIDsFromProviderIDs(machinePoolScope.AWSMachinePool.Spec.ProviderIDList) {
+			if !containsString(instanceIDs, id) {
+				removed = append(removed, id)
+			}
+		}
+		if len(removed) > 0 {
+			instancestateSvc.RemoveInstancesFromEventPattern(removed)
+		}
+	}
+
 	machinePoolScope.SetAnnotation("cluster-api-provider-aws", "true")
 
 	machinePoolScope.AWSMachinePool.Spec.ProviderIDList = providerIDList
@@ -280,12 +325,22 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		machinePoolScope.Info("Failed updating instances", "instances", asg.Instances)
 	}
 
+	// Re-run the AMI lookup of the AMI update policy periodically, as a newer AMI does not trigger a reconciliation.
+	if policy := machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate.AMIUpdatePolicy; policy != nil {
+		return ctrl.Result{RequeueAfter: amiUpdateRequeueAfter(policy, time.Now())}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
 func (r *AWSMachinePoolReconciler) reconcileDelete(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) (ctrl.Result, error) {
 	clusterScope.Info("Handling deleted AWSMachinePool")
 
+	if _, ok := clusterScope.(*scope.ClusterScope); ok && feature.Gates.Enabled(feature.EventBridgeInstanceState) {
+		instancestate.NewService(ec2Scope).RemoveMachinePoolFromEventPattern(machinePoolScope.Name(),
+			instanceIDsFromProviderIDs(machinePoolScope.AWSMachinePool.Spec.ProviderIDList))
+	}
+
 	ec2Svc := r.getEC2Service(ec2Scope)
 	asgSvc := r.getASGService(clusterScope)
 
@@ -402,7 +457,14 @@ func (r *AWSMachinePoolReconciler) reconcileLaunchTemplate(machinePoolScope *sco
 		return err
 	}
 
+	lookupHash, err := amiLookupHash(machinePoolScope)
+	if err != nil {
+		return err
+	}
+
 	if launchTemplate == nil {
+		r.reportLatestAMI(machinePoolScope, imageID, nil)
+
 		machinePoolScope.Info("no existing launch template found, creating")
 		launchTemplateID, err := ec2svc.CreateLaunchTemplate(machinePoolScope, imageID, bootstrapData)
 		if err != nil {
@@ -411,6 +473,7 @@ func (r *AWSMachinePoolReconciler) reconcileLaunchTemplate(machinePoolScope *sco
 		}
 
 		machinePoolScope.SetLaunchTemplateIDStatus(launchTemplateID)
+		machinePoolScope.AWSMachinePool.Status.AMILookupHash = lookupHash
 		return machinePoolScope.PatchObject()
 	}
 
@@ -426,6 +489,10 @@ func (r *AWSMachinePoolReconciler) reconcileLaunchTemplate(machinePoolScope *sco
 		return machinePoolScope.PatchObject()
 	}
 
+	r.reportLatestAMI(machinePoolScope, imageID, launchTemplate.AMI.ID)
+	latestImageID := imageID
+	imageID = amiToRollOut(machinePoolScope, imageID, launchTemplate.AMI.ID, lookupHash)
+
 	annotation, err := r.machinePoolAnnotationJSON(machinePoolScope.AWSMachinePool, TagsLastAppliedAnnotation)
 	if err != nil {
 		return err
@@ -487,9 +554,100 @@ func (r *AWSMachinePoolReconciler) reconcileLaunchTemplate(machinePoolScope *sco
 		conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.InstanceRefreshStartedCondition)
 	}
 
+	// Only record the lookup settings once the launch template uses the AMI found with them.
+	if *imageID == *latestImageID {
+		machinePoolScope.AWSMachinePool.Status.AMILookupHash = lookupHash
+	}
+
 	return nil
 }
 
+// reportLatestAMI records the most recent AMI found by the lookup in the status if the AMI update policy is set.
+// The status is only updated when the AMI changes or the check interval has elapsed, so that it does not change
+// on every reconciliation.
+func (r *AWSMachinePoolReconciler) reportLatestAMI(machinePoolScope *scope.MachinePoolScope, latestID, currentID *string) {
+	policy := machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate.AMIUpdatePolicy
+	if policy == nil || latestID == nil {
+		return
+	}
+
+	latest := machinePoolScope.AWSMachinePool.Status.LatestAMIAvailable
+	if latest != nil && latest.ID == *latestID && time.Since(latest.CheckTime.Time) < policy.GetCheckInterval() {
+		return
+	}
+
+	if currentID != nil && *currentID != *latestID && (latest == nil || latest.ID != *latestID) {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeNormal, "NewerAMIAvailable", "AMI %s is available, the launch template uses AMI %s", *latestID, *currentID)
+	}
+
+	machinePoolScope.AWSMachinePool.Status.LatestAMIAvailable = &infrav1.LatestAMIStatus{
+		ID:        *latestID,
+		CheckTime: metav1.Now(),
+	}
+}
+
+// amiToRollOut returns the AMI to use in the launch template. Without an AMI update policy, the most recent AMI
+// found by the lookup is always rolled out. With a policy, an AMI found with the same Kubernetes version and
+// lookup settings as the current AMI is only rolled out in auto mode within the maintenance window, and the
+// current AMI of the launch template is kept otherwise. An AMI found with changed settings is always rolled out.
+func amiToRollOut(machinePoolScope *scope.MachinePoolScope, latestID, currentID *string, lookupHash string) *string {
+	policy := machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate.AMIUpdatePolicy
+	if policy == nil || currentID == nil || *latestID == *currentID {
+		return latestID
+	}
+
+	// Without a recorded hash, e.g. for launch templates created by an older version of the controller,
+	// the settings are assumed to be unchanged.
+	if recorded := machinePoolScope.AWSMachinePool.Status.AMILookupHash; recorded != "" && recorded != lookupHash {
+		machinePoolScope.Info("rolling out AMI for changed lookup settings", "current", *currentID, "latest", *latestID)
+		return latestID
+	}
+
+	if policy.Mode == infrav1.AMIUpdateModeAuto && policy.MaintenanceWindow.Contains(time.Now()) {
+		machinePoolScope.Info("rolling out newer AMI", "current", *currentID, "latest", *latestID)
+		return latestID
+	}
+
+	return currentID
+}
+
+// amiLookupHash returns a hash of the Kubernetes version and the AMI lookup settings of the machine pool.
+func amiLookupHash(machinePoolScope *scope.MachinePoolScope) (string, error) {
+	lt := machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate
+	data, err := json.Marshal(struct {
+		Version           *string
+		AMI               infrav1.AMIReference
+		ImageLookupFormat string
+		ImageLookupOrg    string
+		ImageLookupBaseOS string
+	}{
+		Version:           machinePoolScope.MachinePool.Spec.Template.Spec.Version,
+		AMI:               lt.AMI,
+		ImageLookupFormat: lt.ImageLookupFormat,
+		ImageLookupOrg:    lt.ImageLookupOrg,
+		ImageLookupBaseOS: lt.ImageLookupBaseOS,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal AMI lookup settings")
+	}
+	return userdata.ComputeHash(data), nil
+}
+
+// amiUpdateRequeueAfter returns when to re-run the AMI lookup of the policy. While the maintenance window of
+// the auto mode is closed, this is the next opening of the window if it comes before the check interval elapsed,
+// so that an AMI held back until then is rolled out when the window opens.
+func amiUpdateRequeueAfter(policy *infrav1.AMIUpdatePolicy, now time.Time) time.Duration {
+	interval := policy.GetCheckInterval()
+	if policy.Mode != infrav1.AMIUpdateModeAuto || policy.MaintenanceWindow.Contains(now) {
+		return interval
+	}
+
+	if next := policy.MaintenanceWindow.NextOpening(now); !next.IsZero() && next.Sub(now) < interval {
+		return next.Sub(now)
+	}
+	return interval
+}
+
 func (r *AWSMachinePoolReconciler) reconcileTags(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) error {
 	ec2Svc := r.getEC2Service(ec2Scope)
 	asgSvc := r.getASGService(clusterScope)
@@ -649,3 +807,45 @@ func (r *AWSMachinePoolReconciler) getInfraCluster(ctx context.Context, log logr
 
 	return clusterScope, nil
 }
+
+func indexAWSMachinePoolByInstanceID(o client.Object) []string {
+	awsMachinePool, ok := o.(*expinfrav1.AWSMachinePool)
+	if !ok {
+		return nil
+	}
+
+	return instanceIDsFromProviderIDs(awsMachinePool.Spec.ProviderIDList)
+}
+
+// indexAWSMachinePoolByAutoScalingGroupName indexes AWSMachinePools by the name of their Auto Scaling group,
+// which is the name of the AWSMachinePool.
+func indexAWSMachinePoolByAutoScalingGroupName(o client.Object) []string {
+	if _, ok := o.(*expinfrav1.AWSMachinePool); !ok {
+		return nil
+	}
+
+	return []string{o.GetName()}
+}
+
+// instanceIDsFromProviderIDs returns the instance IDs of the valid provider IDs.
+func instanceIDsFromProviderIDs(providerIDs []string) []string {
+	instanceIDs := make([]string, 0, len(providerIDs))
+	for _, providerID := range providerIDs {
+		pid, err := noderefutil.NewProviderID(providerID)
+		if err != nil {
+			continue
+		}
+		instanceIDs = append(instanceIDs, pid.ID())
+	}
+
+	return instanceIDs
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -22,6 +22,7 @@ import (
 	"flag"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
@@ -575,3 +576,201 @@ func Test_asgNeedsUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestAMIToRollOut(t *testing.T) {
+	now := time.Now().UTC()
+	openWindow := &infrav1.MaintenanceWindow{
+		StartTime: now.Add(-time.Hour).Format("15:04"),
+		Duration:  metav1.Duration{Duration: 2 * time.Hour},
+	}
+	closedWindow := &infrav1.MaintenanceWindow{
+		StartTime: now.Add(2 * time.Hour).Format("15:04"),
+		Duration:  metav1.Duration{Duration: time.Hour},
+	}
+
+	tests := []struct {
+		name         string
+		policy       *infrav1.AMIUpdatePolicy
+		recordedHash string
+		want         string
+	}{
+		{
+			name:   "without a policy the latest AMI is rolled out",
+			policy: nil,
+			want:   "ami-latest",
+		},
+		{
+			name:   "report mode keeps the current AMI",
+			policy: &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport},
+			want:   "ami-current",
+		},
+		{
+			name:   "auto mode without a maintenance window rolls out the latest AMI",
+			policy: &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeAuto},
+			want:   "ami-latest",
+		},
+		{
+			name:   "auto mode within the maintenance window rolls out the latest AMI",
+			policy: &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeAuto, MaintenanceWindow: openWindow},
+			want:   "ami-latest",
+		},
+		{
+			name:   "auto mode outside the maintenance window keeps the current AMI",
+			policy: &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeAuto, MaintenanceWindow: closedWindow},
+			want:   "ami-current",
+		},
+		{
+			name:         "report mode keeps the current AMI if the lookup settings are unchanged",
+			policy:       &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport},
+			recordedHash: "lookup-hash",
+			want:         "ami-current",
+		},
+		{
+			name:         "report mode rolls out the AMI found with changed lookup settings",
+			policy:       &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport},
+			recordedHash: "previous-lookup-hash",
+			want:         "ami-latest",
+		},
+		{
+			name:         "auto mode outside the maintenance window rolls out the AMI found with changed lookup settings",
+			policy:       &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeAuto, MaintenanceWindow: closedWindow},
+			recordedHash: "previous-lookup-hash",
+			want:         "ami-latest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machinePoolScope := &scope.MachinePoolScope{
+				Logger: logr.Discard(),
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{AMIUpdatePolicy: tt.policy},
+					},
+					Status: expinfrav1.AWSMachinePoolStatus{AMILookupHash: tt.recordedHash},
+				},
+			}
+			g.Expect(*amiToRollOut(machinePoolScope, pointer.String("ami-latest"), pointer.String("ami-current"), "lookup-hash")).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestAMILookupHash(t *testing.T) {
+	g := NewWithT(t)
+	machinePoolScope := &scope.MachinePoolScope{
+		AWSMachinePool: &expinfrav1.AWSMachinePool{
+			Spec: expinfrav1.AWSMachinePoolSpec{
+				AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{ImageLookupOrg: "258751437250"},
+			},
+		},
+		MachinePool: &expclusterv1.MachinePool{
+			Spec: expclusterv1.MachinePoolSpec{
+				Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{Version: pointer.String("v1.23.3")}},
+			},
+		},
+	}
+
+	hash, err := amiLookupHash(machinePoolScope)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	machinePoolScope.MachinePool.Spec.Template.Spec.Version = pointer.String("v1.24.0")
+	upgradedHash, err := amiLookupHash(machinePoolScope)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(upgradedHash).NotTo(Equal(hash))
+
+	machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate.AMIUpdatePolicy = &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeAuto}
+	g.Expect(amiLookupHash(machinePoolScope)).To(Equal(upgradedHash))
+}
+
+func TestAMIUpdateRequeueAfter(t *testing.T) {
+	// Saturday.
+	now := time.Date(2022, time.April, 2, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		policy *infrav1.AMIUpdatePolicy
+		want   time.Duration
+	}{
+		{
+			name:   "report mode requeues after the check interval",
+			policy: &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport},
+			want:   infrav1.DefaultAMIUpdateCheckInterval,
+		},
+		{
+			name: "auto mode within the maintenance window requeues after the check interval",
+			policy: &infrav1.AMIUpdatePolicy{
+				Mode:              infrav1.AMIUpdateModeAuto,
+				MaintenanceWindow: &infrav1.MaintenanceWindow{StartTime: "22:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			},
+			want: infrav1.DefaultAMIUpdateCheckInterval,
+		},
+		{
+			name: "auto mode requeues when the maintenance window opens before the check interval elapsed",
+			policy: &infrav1.AMIUpdatePolicy{
+				Mode:              infrav1.AMIUpdateModeAuto,
+				MaintenanceWindow: &infrav1.MaintenanceWindow{StartTime: "23:20", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			want: 20 * time.Minute,
+		},
+		{
+			name: "auto mode requeues after the check interval when the maintenance window opens later",
+			policy: &infrav1.AMIUpdatePolicy{
+				Mode:              infrav1.AMIUpdateModeAuto,
+				MaintenanceWindow: &infrav1.MaintenanceWindow{Days: []string{"Monday"}, StartTime: "02:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			want: infrav1.DefaultAMIUpdateCheckInterval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(amiUpdateRequeueAfter(tt.policy, now)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReportLatestAMI(t *testing.T) {
+	policy := &infrav1.AMIUpdatePolicy{Mode: infrav1.AMIUpdateModeReport}
+
+	t.Run("should record a newer AMI and emit an event", func(t *testing.T) {
+		g := NewWithT(t)
+		recorder := record.NewFakeRecorder(1)
+		reconciler := AWSMachinePoolReconciler{Recorder: recorder}
+		machinePoolScope := &scope.MachinePoolScope{
+			Logger: logr.Discard(),
+			AWSMachinePool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{AMIUpdatePolicy: policy},
+				},
+			},
+		}
+
+		reconciler.reportLatestAMI(machinePoolScope, pointer.String("ami-latest"), pointer.String("ami-current"))
+
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LatestAMIAvailable).ToNot(BeNil())
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LatestAMIAvailable.ID).To(Equal("ami-latest"))
+		g.Expect(recorder.Events).To(Receive(ContainSubstring("NewerAMIAvailable")))
+	})
+	t.Run("should not update the status before the check interval elapsed", func(t *testing.T) {
+		g := NewWithT(t)
+		recorder := record.NewFakeRecorder(1)
+		reconciler := AWSMachinePoolReconciler{Recorder: recorder}
+		checkTime := metav1.NewTime(time.Now().Add(-time.Minute))
+		machinePoolScope := &scope.MachinePoolScope{
+			Logger: logr.Discard(),
+			AWSMachinePool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{AMIUpdatePolicy: policy},
+				},
+				Status: expinfrav1.AWSMachinePoolStatus{
+					LatestAMIAvailable: &infrav1.LatestAMIStatus{ID: "ami-latest", CheckTime: checkTime},
+				},
+			},
+		}
+
+		reconciler.reportLatestAMI(machinePoolScope, pointer.String("ami-latest"), pointer.String("ami-current"))
+
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LatestAMIAvailable.CheckTime).To(Equal(checkTime))
+		g.Expect(recorder.Events).ToNot(Receive())
+	})
+}
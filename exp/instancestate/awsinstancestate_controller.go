@@ -19,6 +19,8 @@ package instancestate
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,14 +38,45 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/controllers"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	expcontrollers "sigs.k8s.io/cluster-api-provider-aws/exp/controllers"
+	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
-// Ec2InstanceStateLabelKey defines an ec2 instance state label.
-const Ec2InstanceStateLabelKey = "ec2-instance-state"
+const (
+	// Ec2InstanceStateLabelKey defines an ec2 instance state label.
+	Ec2InstanceStateLabelKey = "ec2-instance-state"
+
+	// SpotInterruptionAnnotation is set on AWSMachines whose spot instance received an interruption warning.
+	// The value is the time of the warning; the instance is interrupted two minutes later.
+	SpotInterruptionAnnotation = "sigs.k8s.io/cluster-api-provider-aws-spot-interruption"
+
+	// RebalanceRecommendationAnnotation is set on AWSMachines whose spot instance received a rebalance recommendation.
+	// The value is the time of the recommendation.
+	RebalanceRecommendationAnnotation = "sigs.k8s.io/cluster-api-provider-aws-rebalance-recommendation"
+
+	// MachinePoolInstanceEventAnnotation is set on AWSMachinePools to trigger a reconcile when one of their instances
+	// was launched, terminated or changed state. The value is the time, type and instance ID of the latest event.
+	MachinePoolInstanceEventAnnotation = "sigs.k8s.io/cluster-api-provider-aws-instance-event"
+)
+
+const (
+	// receiveWaitTimeSeconds is the long polling duration of a receive from a queue.
+	receiveWaitTimeSeconds = 20
+	// receiveMaxMessages is the maximum number of messages received, and deleted or retried, at once.
+	receiveMaxMessages = 10
+	// retryBaseVisibilityTimeout is the delay before a message which failed processing is received again,
+	// doubled on every receive. Once received too often, SQS moves the message to the dead-letter queue.
+	retryBaseVisibilityTimeout = 10 * time.Second
+	// retryMaxVisibilityTimeout caps the delay before a message which failed processing is received again.
+	retryMaxVisibilityTimeout = 15 * time.Minute
+)
 
 // AwsInstanceStateReconciler reconciles a AwsInstanceState object.
 type AwsInstanceStateReconciler struct {
@@ -52,10 +86,18 @@ type AwsInstanceStateReconciler struct {
 	queueURLs         sync.Map
 	Endpoints         []scope.ServiceEndpoint
 	WatchFilterValue  string
+
+	// Workers is the number of messages processed concurrently across all queues.
+	Workers int
+	// receiving holds the clusters whose queue is being received from, so each queue is received from once at a time.
+	receiving sync.Map
+	// messages is unbuffered, so queues are only received from again once their messages have been processed.
+	messages chan *queueMessage
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools,verbs=get;list;watch;update;patch
 
 func (r *AwsInstanceStateReconciler) getSQSService(region string) (sqsiface.SQSAPI, error) {
 	if r.sqsServiceFactory != nil {
@@ -82,6 +124,7 @@ func (r *AwsInstanceStateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if apierrors.IsNotFound(err) {
 			r.Log.Info("cluster not found, removing queue URL", "cluster", req.Name)
 			r.queueURLs.Delete(req.Name)
+			deleteClusterMetrics(req.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
@@ -90,6 +133,7 @@ func (r *AwsInstanceStateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	// Handle deleted clusters
 	if !awsCluster.DeletionTimestamp.IsZero() {
 		r.queueURLs.Delete(req.Name)
+		deleteClusterMetrics(req.Name)
 		return reconcile.Result{}, nil
 	}
 
@@ -109,6 +153,14 @@ func (r *AwsInstanceStateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 }
 
 func (r *AwsInstanceStateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	workers := r.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	r.messages = make(chan *queueMessage)
+	for i := 0; i < workers; i++ {
+		go r.processMessages()
+	}
 	go func() {
 		r.watchQueuesForInstanceEvents()
 	}()
@@ -130,40 +182,15 @@ func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
 		}
 	}
 	for range time.Tick(1 * time.Second) {
-		// go through each cluster and check for messages on its queue
+		// go through each cluster and check for messages on its queue, unless a receive is still in progress
 		r.queueURLs.Range(func(key, val interface{}) bool {
+			cluster := key.(string)
+			if _, receiving := r.receiving.LoadOrStore(cluster, true); receiving {
+				return true
+			}
 			go func() {
-				qp := val.(queueParams)
-				sqsSvs, err := r.getSQSService(qp.region)
-				if err != nil {
-					r.Log.Error(err, "unable to create SQS client")
-					return
-				}
-				resp, err := sqsSvs.ReceiveMessage(&sqs.ReceiveMessageInput{QueueUrl: aws.String(qp.URL)})
-				if err != nil {
-					r.Log.Error(err, "failed to receive messages")
-					return
-				}
-				for _, msg := range resp.Messages {
-					m := message{}
-					err := json.Unmarshal([]byte(*msg.Body), &m)
-
-					if err != nil {
-						r.Log.Error(err, "unable to marshall")
-						return
-					}
-					// TODO: handle errors during process message. We currently deletes the message regardless.
-					r.processMessage(ctx, m)
-
-					_, err = sqsSvs.DeleteMessage(&sqs.DeleteMessageInput{
-						QueueUrl:      aws.String(qp.URL),
-						ReceiptHandle: msg.ReceiptHandle,
-					})
-
-					if err != nil {
-						r.Log.Error(err, "error deleting message", "queueURL", qp.URL, "messageReceiptHandle", msg.ReceiptHandle)
-					}
-				}
+				defer r.receiving.Delete(cluster)
+				r.receiveMessages(ctx, cluster, val.(queueParams))
 			}()
 
 			return true
@@ -171,43 +198,278 @@ func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
 	}
 }
 
-// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed.
-func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg message) {
-	if msg.Source != "aws.ec2" || msg.DetailType != instancestate.Ec2StateChangeNotification || msg.MessageDetail == nil {
+// receiveMessages receives a batch of messages from the queue of a cluster and hands them to the workers.
+// Once all messages are processed, the processed messages are deleted and the failed ones are made visible
+// again after a backoff, so they are retried until they are moved to the dead-letter queue.
+func (r *AwsInstanceStateReconciler) receiveMessages(ctx context.Context, cluster string, qp queueParams) {
+	sqsSvs, err := r.getSQSService(qp.region)
+	if err != nil {
+		r.Log.Error(err, "unable to create SQS client")
 		return
 	}
 
+	attrs, err := sqsSvs.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(qp.URL),
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameApproximateNumberOfMessages}),
+	})
+	if err != nil {
+		r.Log.Error(err, "failed to get queue attributes", "queueURL", qp.URL)
+	} else if depth, err := strconv.Atoi(aws.StringValue(attrs.Attributes[sqs.QueueAttributeNameApproximateNumberOfMessages])); err == nil {
+		queueDepth.WithLabelValues(cluster).Set(float64(depth))
+	}
+
+	resp, err := sqsSvs.ReceiveMessage(newReceiveMessageInput(qp.URL))
+	if err != nil {
+		r.Log.Error(err, "failed to receive messages")
+		return
+	}
+	if len(resp.Messages) == 0 {
+		return
+	}
+
+	wg := &sync.WaitGroup{}
+	msgs := make([]*queueMessage, 0, len(resp.Messages))
+	for _, msg := range resp.Messages {
+		m := &queueMessage{cluster: cluster, message: msg, wg: wg}
+		wg.Add(1)
+		r.messages <- m
+		msgs = append(msgs, m)
+	}
+	wg.Wait()
+
+	deleteEntries := []*sqs.DeleteMessageBatchRequestEntry{}
+	retryEntries := []*sqs.ChangeMessageVisibilityBatchRequestEntry{}
+	for _, m := range msgs {
+		if m.err == nil {
+			deleteEntries = append(deleteEntries, &sqs.DeleteMessageBatchRequestEntry{
+				Id:            m.message.MessageId,
+				ReceiptHandle: m.message.ReceiptHandle,
+			})
+			continue
+		}
+		r.Log.Error(m.err, "failed to process message, retrying", "queueURL", qp.URL, "messageID", aws.StringValue(m.message.MessageId))
+		retryEntries = append(retryEntries, &sqs.ChangeMessageVisibilityBatchRequestEntry{
+			Id:                m.message.MessageId,
+			ReceiptHandle:     m.message.ReceiptHandle,
+			VisibilityTimeout: aws.Int64(int64(retryVisibilityTimeout(m.message).Seconds())),
+		})
+	}
+
+	if len(deleteEntries) > 0 {
+		out, err := sqsSvs.DeleteMessageBatch(&sqs.DeleteMessageBatchInput{
+			QueueUrl: aws.String(qp.URL),
+			Entries:  deleteEntries,
+		})
+		if err != nil {
+			r.Log.Error(err, "error deleting messages", "queueURL", qp.URL)
+		} else {
+			for _, failed := range out.Failed {
+				r.Log.Info("error deleting message", "queueURL", qp.URL, "messageID", aws.StringValue(failed.Id), "code", aws.StringValue(failed.Code))
+			}
+		}
+	}
+
+	if len(retryEntries) > 0 {
+		out, err := sqsSvs.ChangeMessageVisibilityBatch(&sqs.ChangeMessageVisibilityBatchInput{
+			QueueUrl: aws.String(qp.URL),
+			Entries:  retryEntries,
+		})
+		if err != nil {
+			r.Log.Error(err, "error changing visibility of messages", "queueURL", qp.URL)
+		} else {
+			for _, failed := range out.Failed {
+				r.Log.Info("error changing visibility of message", "queueURL", qp.URL, "messageID", aws.StringValue(failed.Id), "code", aws.StringValue(failed.Code))
+			}
+		}
+	}
+}
+
+// processMessages processes the received messages until the messages channel is closed.
+func (r *AwsInstanceStateReconciler) processMessages() {
+	ctx := context.TODO()
+	for m := range r.messages {
+		start := time.Now()
+		m.err = r.handleMessage(ctx, m.message)
+
+		result := resultSuccess
+		if m.err != nil {
+			result = resultFailure
+		}
+		messageProcessingDurationSeconds.WithLabelValues(m.cluster, result).Observe(time.Since(start).Seconds())
+		if sent, err := strconv.ParseInt(aws.StringValue(m.message.Attributes[sqs.MessageSystemAttributeNameSentTimestamp]), 10, 64); err == nil {
+			messageLatencySeconds.WithLabelValues(m.cluster, result).Observe(time.Since(time.Unix(0, sent*int64(time.Millisecond))).Seconds())
+		}
+		m.wg.Done()
+	}
+}
+
+func (r *AwsInstanceStateReconciler) handleMessage(ctx context.Context, msg *sqs.Message) error {
+	m := message{}
+	if err := json.Unmarshal([]byte(aws.StringValue(msg.Body)), &m); err != nil {
+		return errors.Wrap(err, "unable to unmarshal message")
+	}
+
+	return r.processMessage(ctx, m)
+}
+
+func newReceiveMessageInput(queueURL string) *sqs.ReceiveMessageInput {
+	return &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(queueURL),
+		MaxNumberOfMessages: aws.Int64(receiveMaxMessages),
+		WaitTimeSeconds:     aws.Int64(receiveWaitTimeSeconds),
+		AttributeNames:      aws.StringSlice([]string{sqs.MessageSystemAttributeNameApproximateReceiveCount, sqs.MessageSystemAttributeNameSentTimestamp}),
+	}
+}
+
+// retryVisibilityTimeout returns how long a message which failed processing stays invisible before it is retried.
+func retryVisibilityTimeout(msg *sqs.Message) time.Duration {
+	receiveCount, err := strconv.Atoi(aws.StringValue(msg.Attributes[sqs.MessageSystemAttributeNameApproximateReceiveCount]))
+	if err != nil || receiveCount < 1 {
+		receiveCount = 1
+	}
+
+	timeout := retryBaseVisibilityTimeout
+	for i := 1; i < receiveCount && timeout < retryMaxVisibilityTimeout; i++ {
+		timeout *= 2
+	}
+	if timeout > retryMaxVisibilityTimeout {
+		timeout = retryMaxVisibilityTimeout
+	}
+
+	return timeout
+}
+
+// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed, and marks it
+// if its spot instance is about to be interrupted or is at an elevated risk of interruption. It triggers a
+// reconcile on an AWSMachinePool if one of its instances was launched, terminated or changed state.
+func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg message) error {
+	if msg.MessageDetail == nil {
+		return nil
+	}
+
+	if msg.Source == "aws.autoscaling" {
+		switch msg.DetailType {
+		case instancestate.AutoScalingInstanceLaunchSuccessful, instancestate.AutoScalingInstanceLaunchUnsuccessful,
+			instancestate.AutoScalingInstanceTerminateSuccessful, instancestate.AutoScalingInstanceTerminateUnsuccessful:
+			return r.notifyMachinePools(ctx, msg, client.MatchingFields{expcontrollers.AutoScalingGroupNameIndex: msg.MessageDetail.AutoScalingGroupName})
+		default:
+			return nil
+		}
+	}
+
+	if msg.Source != "aws.ec2" {
+		return nil
+	}
+
+	switch msg.DetailType {
+	case instancestate.Ec2StateChangeNotification, instancestate.Ec2SpotInstanceInterruptionWarning, instancestate.Ec2InstanceRebalanceRecommendation:
+	default:
+		return nil
+	}
+
 	// Fetch the awsMachine instance by InstanceID
 	awsMachines := &infrav1.AWSMachineList{}
 	err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: msg.MessageDetail.InstanceID})
 
 	if err != nil {
-		r.Log.Error(err, "unable to list machines by instance ID", "instanceID", msg.MessageDetail.InstanceID)
+		return errors.Wrapf(err, "unable to list machines by instance ID %s", msg.MessageDetail.InstanceID)
 	}
 
 	if len(awsMachines.Items) > 0 {
 		machine := awsMachines.Items[0]
 		if !machine.ObjectMeta.DeletionTimestamp.IsZero() {
-			return
+			return nil
 		}
 		patchHelper, err := patch.NewHelper(&machine, r.Client)
 		if err != nil {
-			r.Log.Error(err, "unable to create patch helper")
+			return errors.Wrap(err, "unable to create patch helper")
+		}
+
+		switch msg.DetailType {
+		case instancestate.Ec2StateChangeNotification:
+			// Trigger an update on the machine
+			labels := machine.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+
+			labels[Ec2InstanceStateLabelKey] = string(msg.MessageDetail.State)
+			machine.SetLabels(labels)
+		case instancestate.Ec2SpotInstanceInterruptionWarning:
+			r.Log.Info("spot instance interruption warning received", "instanceID", msg.MessageDetail.InstanceID, "awsMachine", machine.Name)
+			setAnnotation(&machine, SpotInterruptionAnnotation, msg.Time)
+			conditions.MarkFalse(&machine, infrav1.NoSpotInterruptionCondition, infrav1.SpotInterruptionWarningReason, clusterv1.ConditionSeverityError,
+				"Spot instance %s will be interrupted (%s) two minutes after %s", msg.MessageDetail.InstanceID, msg.MessageDetail.InstanceAction, msg.Time)
+		case instancestate.Ec2InstanceRebalanceRecommendation:
+			r.Log.Info("instance rebalance recommendation received", "instanceID", msg.MessageDetail.InstanceID, "awsMachine", machine.Name)
+			setAnnotation(&machine, RebalanceRecommendationAnnotation, msg.Time)
+			// an interruption warning takes precedence over a rebalance recommendation
+			if conditions.GetReason(&machine, infrav1.NoSpotInterruptionCondition) != infrav1.SpotInterruptionWarningReason {
+				conditions.MarkFalse(&machine, infrav1.NoSpotInterruptionCondition, infrav1.RebalanceRecommendationReason, clusterv1.ConditionSeverityWarning,
+					"Spot instance %s is at an elevated risk of interruption since %s", msg.MessageDetail.InstanceID, msg.Time)
+			}
 		}
-		// Trigger an update on the machine
-		labels := machine.GetLabels()
-		if labels == nil {
-			labels = make(map[string]string)
+
+		if err := patchHelper.Patch(ctx, &machine); err != nil {
+			return errors.Wrapf(err, "unable to patch AWS machine %s", machine.Name)
 		}
+		return nil
+	}
+
+	// The instance may belong to the Auto Scaling group of an AWSMachinePool instead
+	if msg.DetailType == instancestate.Ec2StateChangeNotification {
+		return r.notifyMachinePools(ctx, msg, client.MatchingFields{expcontrollers.InstanceIDIndex: msg.MessageDetail.InstanceID})
+	}
+
+	return nil
+}
+
+// notifyMachinePools annotates the AWSMachinePools matching the index with the event, so their ProviderIDList,
+// replicas and instance status are updated without waiting for the next resync.
+func (r *AwsInstanceStateReconciler) notifyMachinePools(ctx context.Context, msg message, index client.MatchingFields) error {
+	if !feature.Gates.Enabled(feature.MachinePool) {
+		return nil
+	}
 
-		labels[Ec2InstanceStateLabelKey] = string(msg.MessageDetail.State)
-		machine.SetLabels(labels)
+	awsMachinePools := &expinfrav1.AWSMachinePoolList{}
+	if err := r.List(ctx, awsMachinePools, index); err != nil {
+		return errors.Wrapf(err, "unable to list machine pools by %v", index)
+	}
+
+	instanceID := msg.MessageDetail.InstanceID
+	if instanceID == "" {
+		instanceID = msg.MessageDetail.EC2InstanceID
+	}
 
-		err = patchHelper.Patch(ctx, &machine)
+	for i := range awsMachinePools.Items {
+		machinePool := &awsMachinePools.Items[i]
+		if !machinePool.ObjectMeta.DeletionTimestamp.IsZero() {
+			continue
+		}
+		patchHelper, err := patch.NewHelper(machinePool, r.Client)
 		if err != nil {
-			r.Log.Error(err, "unable to patch AWS machine")
+			return errors.Wrap(err, "unable to create patch helper")
+		}
+
+		r.Log.Info("instance event received", "instanceID", instanceID, "detailType", msg.DetailType, "awsMachinePool", machinePool.Name)
+		setAnnotation(machinePool, MachinePoolInstanceEventAnnotation, fmt.Sprintf("%s %s %s", msg.Time, msg.DetailType, instanceID))
+
+		if err := patchHelper.Patch(ctx, machinePool); err != nil {
+			return errors.Wrapf(err, "unable to patch AWS machine pool %s", machinePool.Name)
 		}
 	}
+
+	return nil
+}
+
+func setAnnotation(obj client.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
 }
 
 // getQueueURL retrieves the SQS queue URL for a given cluster.
@@ -240,13 +502,28 @@ type queueParams struct {
 	URL    string
 }
 
+// queueMessage is a message received from the queue of a cluster, handed to a worker for processing.
+type queueMessage struct {
+	cluster string
+	message *sqs.Message
+	// err is the result of processing the message, set before wg is marked done.
+	err error
+	wg  *sync.WaitGroup
+}
+
 type message struct {
 	Source        string         `json:"source"`
 	DetailType    string         `json:"detail-type,omitempty"`
+	Time          string         `json:"time,omitempty"`
 	MessageDetail *messageDetail `json:"detail,omitempty"`
 }
 
 type messageDetail struct {
-	InstanceID string                `json:"instance-id,omitempty"`
-	State      infrav1.InstanceState `json:"state,omitempty"`
+	InstanceID     string                `json:"instance-id,omitempty"`
+	State          infrav1.InstanceState `json:"state,omitempty"`
+	InstanceAction string                `json:"instance-action,omitempty"`
+
+	// AutoScalingGroupName and EC2InstanceID are set by Auto Scaling instance launch and terminate events.
+	AutoScalingGroupName string `json:"AutoScalingGroupName,omitempty"`
+	EC2InstanceID        string `json:"EC2InstanceId,omitempty"`
 }
@@ -27,16 +27,24 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/controllers"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate/mock_sqsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestAWSInstanceStateController(t *testing.T) {
@@ -64,7 +72,9 @@ func TestAWSInstanceStateController(t *testing.T) {
 			Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("aws-cluster-2-url")}, nil)
 		sqsSvs.EXPECT().GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("aws-cluster-3-queue")}).AnyTimes().
 			Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("aws-cluster-3-url")}, nil)
-		sqsSvs.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-1-url")}).AnyTimes().
+		sqsSvs.EXPECT().GetQueueAttributes(gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).AnyTimes().
+			Return(&sqs.GetQueueAttributesOutput{}, nil)
+		sqsSvs.EXPECT().ReceiveMessage(newReceiveMessageInput("aws-cluster-1-url")).AnyTimes().
 			DoAndReturn(func(arg *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error) {
 				m := &infrav1.AWSMachine{}
 				lookupKey := types.NamespacedName{
@@ -76,6 +86,7 @@ func TestAWSInstanceStateController(t *testing.T) {
 				if err == nil {
 					return &sqs.ReceiveMessageOutput{
 						Messages: []*sqs.Message{{
+							MessageId:     aws.String("message-id"),
 							ReceiptHandle: aws.String("message-receipt-handle"),
 							Body:          aws.String(messageBodyJSON),
 						}},
@@ -85,12 +96,14 @@ func TestAWSInstanceStateController(t *testing.T) {
 				return &sqs.ReceiveMessageOutput{Messages: []*sqs.Message{}}, nil
 			})
 
-		sqsSvs.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-2-url")}).AnyTimes().
+		sqsSvs.EXPECT().ReceiveMessage(newReceiveMessageInput("aws-cluster-2-url")).AnyTimes().
 			Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{}}, nil)
-		sqsSvs.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-3-url")}).AnyTimes().
+		sqsSvs.EXPECT().ReceiveMessage(newReceiveMessageInput("aws-cluster-3-url")).AnyTimes().
 			Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{}}, nil)
-		sqsSvs.EXPECT().DeleteMessage(&sqs.DeleteMessageInput{QueueUrl: aws.String("aws-cluster-1-url"), ReceiptHandle: aws.String("message-receipt-handle")}).AnyTimes().
-			Return(nil, nil)
+		sqsSvs.EXPECT().DeleteMessageBatch(&sqs.DeleteMessageBatchInput{
+			QueueUrl: aws.String("aws-cluster-1-url"),
+			Entries:  []*sqs.DeleteMessageBatchRequestEntry{{Id: aws.String("message-id"), ReceiptHandle: aws.String("message-receipt-handle")}},
+		}).AnyTimes().Return(&sqs.DeleteMessageBatchOutput{}, nil)
 
 		g.Expect(testEnv.Manager.GetFieldIndexer().IndexField(context.Background(), &infrav1.AWSMachine{},
 			controllers.InstanceIDIndex,
@@ -170,6 +183,278 @@ func TestAWSInstanceStateController(t *testing.T) {
 	})
 }
 
+func TestProcessSpotMessage(t *testing.T) {
+	testCases := []struct {
+		name              string
+		existingCondition *clusterv1.Condition
+		msg               message
+		expectAnnotation  string
+		expectReason      string
+		expectSeverity    clusterv1.ConditionSeverity
+	}{
+		{
+			name: "should mark machine on spot interruption warning",
+			msg: message{
+				Source:     "aws.ec2",
+				DetailType: instancestate.Ec2SpotInstanceInterruptionWarning,
+				Time:       "2022-03-01T10:00:00Z",
+				MessageDetail: &messageDetail{
+					InstanceID:     "i-spot-instance-1",
+					InstanceAction: "terminate",
+				},
+			},
+			expectAnnotation: SpotInterruptionAnnotation,
+			expectReason:     infrav1.SpotInterruptionWarningReason,
+			expectSeverity:   clusterv1.ConditionSeverityError,
+		},
+		{
+			name: "should mark machine on rebalance recommendation",
+			msg: message{
+				Source:     "aws.ec2",
+				DetailType: instancestate.Ec2InstanceRebalanceRecommendation,
+				Time:       "2022-03-01T10:00:00Z",
+				MessageDetail: &messageDetail{
+					InstanceID: "i-spot-instance-1",
+				},
+			},
+			expectAnnotation: RebalanceRecommendationAnnotation,
+			expectReason:     infrav1.RebalanceRecommendationReason,
+			expectSeverity:   clusterv1.ConditionSeverityWarning,
+		},
+		{
+			name:              "should not override interruption warning with rebalance recommendation",
+			existingCondition: conditions.FalseCondition(infrav1.NoSpotInterruptionCondition, infrav1.SpotInterruptionWarningReason, clusterv1.ConditionSeverityError, ""),
+			msg: message{
+				Source:     "aws.ec2",
+				DetailType: instancestate.Ec2InstanceRebalanceRecommendation,
+				Time:       "2022-03-01T10:00:00Z",
+				MessageDetail: &messageDetail{
+					InstanceID: "i-spot-instance-1",
+				},
+			},
+			expectAnnotation: RebalanceRecommendationAnnotation,
+			expectReason:     infrav1.SpotInterruptionWarningReason,
+			expectSeverity:   clusterv1.ConditionSeverityError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-spot-machine",
+					Namespace: "default",
+				},
+				Spec: infrav1.AWSMachineSpec{
+					InstanceID:        pointer.StringPtr("i-spot-instance-1"),
+					SpotMarketOptions: &infrav1.SpotMarketOptions{},
+				},
+			}
+			if tc.existingCondition != nil {
+				conditions.Set(machine, tc.existingCondition)
+			}
+
+			r := &AwsInstanceStateReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(machine).Build(),
+				Log:    ctrl.Log.WithName("controllers").WithName("AWSInstanceState"),
+			}
+			g.Expect(r.processMessage(context.TODO(), tc.msg)).To(Succeed())
+
+			m := &infrav1.AWSMachine{}
+			g.Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(machine), m)).To(Succeed())
+			g.Expect(m.GetAnnotations()).To(HaveKeyWithValue(tc.expectAnnotation, tc.msg.Time))
+			g.Expect(conditions.IsFalse(m, infrav1.NoSpotInterruptionCondition)).To(BeTrue())
+			g.Expect(conditions.GetReason(m, infrav1.NoSpotInterruptionCondition)).To(Equal(tc.expectReason))
+			g.Expect(conditions.GetSeverity(m, infrav1.NoSpotInterruptionCondition)).To(Equal(&tc.expectSeverity))
+			g.Expect(m.GetLabels()).NotTo(HaveKey(Ec2InstanceStateLabelKey))
+		})
+	}
+}
+
+func TestProcessMachinePoolMessage(t *testing.T) {
+	testCases := []struct {
+		name             string
+		msg              message
+		expectAnnotation string
+	}{
+		{
+			name: "should annotate machine pool on instance launch",
+			msg: message{
+				Source:     "aws.autoscaling",
+				DetailType: instancestate.AutoScalingInstanceLaunchSuccessful,
+				Time:       "2022-03-01T10:00:00Z",
+				MessageDetail: &messageDetail{
+					AutoScalingGroupName: "aws-machine-pool",
+					EC2InstanceID:        "i-pool-instance-2",
+				},
+			},
+			expectAnnotation: "2022-03-01T10:00:00Z " + instancestate.AutoScalingInstanceLaunchSuccessful + " i-pool-instance-2",
+		},
+		{
+			name: "should annotate machine pool on instance state change",
+			msg: message{
+				Source:     "aws.ec2",
+				DetailType: instancestate.Ec2StateChangeNotification,
+				Time:       "2022-03-01T10:00:00Z",
+				MessageDetail: &messageDetail{
+					InstanceID: "i-pool-instance-1",
+					State:      infrav1.InstanceStateStopping,
+				},
+			},
+			expectAnnotation: "2022-03-01T10:00:00Z " + instancestate.Ec2StateChangeNotification + " i-pool-instance-1",
+		},
+		{
+			name: "should ignore other auto scaling events",
+			msg: message{
+				Source:     "aws.autoscaling",
+				DetailType: "EC2 Instance-launch Lifecycle Action",
+				Time:       "2022-03-01T10:00:00Z",
+				MessageDetail: &messageDetail{
+					AutoScalingGroupName: "aws-machine-pool",
+					EC2InstanceID:        "i-pool-instance-2",
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	g.Expect(feature.MutableGates.Set(fmt.Sprintf("%s=true", feature.MachinePool))).To(Succeed())
+	defer func() {
+		g.Expect(feature.MutableGates.Set(fmt.Sprintf("%s=false", feature.MachinePool))).To(Succeed())
+	}()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machinePool := &expinfrav1.AWSMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-machine-pool",
+					Namespace: "default",
+				},
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					ProviderIDList: []string{"aws:///us-east-1a/i-pool-instance-1"},
+				},
+			}
+
+			r := &AwsInstanceStateReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(machinePool).Build(),
+				Log:    ctrl.Log.WithName("controllers").WithName("AWSInstanceState"),
+			}
+			g.Expect(r.processMessage(context.TODO(), tc.msg)).To(Succeed())
+
+			mp := &expinfrav1.AWSMachinePool{}
+			g.Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(machinePool), mp)).To(Succeed())
+			if tc.expectAnnotation == "" {
+				g.Expect(mp.GetAnnotations()).NotTo(HaveKey(MachinePoolInstanceEventAnnotation))
+				return
+			}
+			g.Expect(mp.GetAnnotations()).To(HaveKeyWithValue(MachinePoolInstanceEventAnnotation, tc.expectAnnotation))
+		})
+	}
+}
+
+func TestReceiveMessages(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+
+	machine := &infrav1.AWSMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "aws-machine",
+			Namespace: "default",
+		},
+		Spec: infrav1.AWSMachineSpec{
+			InstanceID: pointer.StringPtr("i-failing-instance-1"),
+		},
+	}
+	r := &AwsInstanceStateReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(machine).Build(),
+		Log:    ctrl.Log.WithName("controllers").WithName("AWSInstanceState"),
+		sqsServiceFactory: func() sqsiface.SQSAPI {
+			return sqsMock
+		},
+		messages: make(chan *queueMessage),
+	}
+	go r.processMessages()
+	defer close(r.messages)
+
+	sqsMock.EXPECT().GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String("test-cluster-url"),
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameApproximateNumberOfMessages}),
+	}).Return(&sqs.GetQueueAttributesOutput{
+		Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameApproximateNumberOfMessages: "3"}),
+	}, nil)
+	sqsMock.EXPECT().ReceiveMessage(newReceiveMessageInput("test-cluster-url")).Return(&sqs.ReceiveMessageOutput{
+		Messages: []*sqs.Message{
+			{
+				MessageId:     aws.String("valid"),
+				ReceiptHandle: aws.String("valid-receipt-handle"),
+				Body:          aws.String(messageBodyJSON),
+				Attributes: aws.StringMap(map[string]string{
+					sqs.MessageSystemAttributeNameApproximateReceiveCount: "1",
+					sqs.MessageSystemAttributeNameSentTimestamp:           "1646128800000",
+				}),
+			},
+			{
+				MessageId:     aws.String("invalid"),
+				ReceiptHandle: aws.String("invalid-receipt-handle"),
+				Body:          aws.String("not json"),
+				Attributes: aws.StringMap(map[string]string{
+					sqs.MessageSystemAttributeNameApproximateReceiveCount: "3",
+				}),
+			},
+		},
+	}, nil)
+	sqsMock.EXPECT().DeleteMessageBatch(&sqs.DeleteMessageBatchInput{
+		QueueUrl: aws.String("test-cluster-url"),
+		Entries:  []*sqs.DeleteMessageBatchRequestEntry{{Id: aws.String("valid"), ReceiptHandle: aws.String("valid-receipt-handle")}},
+	}).Return(&sqs.DeleteMessageBatchOutput{}, nil)
+	sqsMock.EXPECT().ChangeMessageVisibilityBatch(&sqs.ChangeMessageVisibilityBatchInput{
+		QueueUrl: aws.String("test-cluster-url"),
+		Entries: []*sqs.ChangeMessageVisibilityBatchRequestEntry{{
+			Id:                aws.String("invalid"),
+			ReceiptHandle:     aws.String("invalid-receipt-handle"),
+			VisibilityTimeout: aws.Int64(40),
+		}},
+	}).Return(&sqs.ChangeMessageVisibilityBatchOutput{}, nil)
+
+	r.receiveMessages(context.TODO(), "test-cluster", queueParams{region: "us-east-1", URL: "test-cluster-url"})
+
+	m := &infrav1.AWSMachine{}
+	g.Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(machine), m)).To(Succeed())
+	g.Expect(m.GetLabels()).To(HaveKeyWithValue(Ec2InstanceStateLabelKey, "shutting-down"))
+	g.Expect(testutil.ToFloat64(queueDepth.WithLabelValues("test-cluster"))).To(Equal(float64(3)))
+
+	deleteClusterMetrics("test-cluster")
+}
+
+func TestRetryVisibilityTimeout(t *testing.T) {
+	testCases := []struct {
+		receiveCount string
+		expected     time.Duration
+	}{
+		{receiveCount: "", expected: 10 * time.Second},
+		{receiveCount: "1", expected: 10 * time.Second},
+		{receiveCount: "2", expected: 20 * time.Second},
+		{receiveCount: "4", expected: 80 * time.Second},
+		{receiveCount: "100", expected: 15 * time.Minute},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.receiveCount, func(t *testing.T) {
+			g := NewWithT(t)
+			msg := &sqs.Message{Attributes: aws.StringMap(map[string]string{
+				sqs.MessageSystemAttributeNameApproximateReceiveCount: tc.receiveCount,
+			})}
+			g.Expect(retryVisibilityTimeout(msg)).To(Equal(tc.expected))
+		})
+	}
+}
+
 const messageBodyJSON = `{
 	"source": "aws.ec2",
 	"detail-type": "EC2 Instance State-change Notification",
@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	ec2service "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+const (
+	// maxDescribeInstancesFilterValues is the maximum number of filter values of a DescribeInstances call.
+	maxDescribeInstancesFilterValues = 200
+	// maxDescribeInstanceStatusIDs is the maximum number of instance IDs of a DescribeInstanceStatus call.
+	maxDescribeInstanceStatusIDs = 100
+)
+
+// AwsInstanceStatePoller polls the EC2 instance state of the AWSMachines of each AWSCluster. It is an alternative
+// to AwsInstanceStateReconciler for accounts where EventBridge rules and SQS queues cannot be created.
+type AwsInstanceStatePoller struct {
+	client.Client
+	Log               logr.Logger
+	Interval          time.Duration
+	Endpoints         []scope.ServiceEndpoint
+	WatchFilterValue  string
+	ec2ServiceFactory func() ec2iface.EC2API
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
+
+func (r *AwsInstanceStatePoller) getEC2Service(scope *scope.ClusterScope) ec2iface.EC2API {
+	if r.ec2ServiceFactory != nil {
+		return r.ec2ServiceFactory()
+	}
+
+	return ec2service.NewService(scope).EC2Client
+}
+
+func (r *AwsInstanceStatePoller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Fetch the AWSCluster instance
+	awsCluster := &infrav1.AWSCluster{}
+	err := r.Get(ctx, req.NamespacedName, awsCluster)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Stop polling deleted clusters
+	if !awsCluster.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, awsCluster.ObjectMeta)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("Cluster Controller has not yet set OwnerRef")
+		return reconcile.Result{}, nil
+	}
+
+	if annotations.IsPaused(cluster, awsCluster) {
+		return reconcile.Result{RequeueAfter: r.Interval}, nil
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:         r.Client,
+		Logger:         &log,
+		Cluster:        cluster,
+		AWSCluster:     awsCluster,
+		ControllerName: "awsinstancestate",
+		Endpoints:      r.Endpoints,
+	})
+	if err != nil {
+		return reconcile.Result{}, errors.Errorf("failed to create scope: %+v", err)
+	}
+
+	if err := r.pollInstances(ctx, clusterScope); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Interval}, nil
+}
+
+// pollInstances describes the instances of all AWSMachines of the cluster at once, then labels each AWSMachine
+// with the state of its instance and sets its status conditions.
+func (r *AwsInstanceStatePoller) pollInstances(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	awsMachines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, awsMachines, client.InNamespace(clusterScope.Namespace()), client.MatchingLabels{clusterv1.ClusterLabelName: clusterScope.Name()}); err != nil {
+		return errors.Wrap(err, "failed to list AWSMachines")
+	}
+
+	instanceIDs := make([]string, 0, len(awsMachines.Items))
+	for _, machine := range awsMachines.Items {
+		if machine.Spec.InstanceID != nil && machine.DeletionTimestamp.IsZero() {
+			instanceIDs = append(instanceIDs, *machine.Spec.InstanceID)
+		}
+	}
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	ec2Client := r.getEC2Service(clusterScope)
+
+	states := map[string]infrav1.InstanceState{}
+	runningIDs := []string{}
+	for _, batch := range batchIDs(instanceIDs, maxDescribeInstancesFilterValues) {
+		// Instances are filtered instead of looked up by ID, as the lookup fails if any of the instances is gone.
+		input := &ec2.DescribeInstancesInput{
+			Filters: []*ec2.Filter{{Name: aws.String("instance-id"), Values: aws.StringSlice(batch)}},
+		}
+		if err := ec2Client.DescribeInstancesPagesWithContext(ctx, input, func(out *ec2.DescribeInstancesOutput, _ bool) bool {
+			for _, reservation := range out.Reservations {
+				for _, instance := range reservation.Instances {
+					if instance.State == nil {
+						continue
+					}
+					state := infrav1.InstanceState(aws.StringValue(instance.State.Name))
+					states[aws.StringValue(instance.InstanceId)] = state
+					if state == infrav1.InstanceStateRunning {
+						runningIDs = append(runningIDs, aws.StringValue(instance.InstanceId))
+					}
+				}
+			}
+			return true
+		}); err != nil {
+			return errors.Wrapf(err, "failed to describe instances of cluster %q", clusterScope.Name())
+		}
+	}
+
+	statuses := map[string]*ec2.InstanceStatus{}
+	for _, batch := range batchIDs(runningIDs, maxDescribeInstanceStatusIDs) {
+		input := &ec2.DescribeInstanceStatusInput{InstanceIds: aws.StringSlice(batch)}
+		if err := ec2Client.DescribeInstanceStatusPagesWithContext(ctx, input, func(out *ec2.DescribeInstanceStatusOutput, _ bool) bool {
+			for _, status := range out.InstanceStatuses {
+				statuses[aws.StringValue(status.InstanceId)] = status
+			}
+			return true
+		}); err != nil {
+			return errors.Wrapf(err, "failed to describe instance status of cluster %q", clusterScope.Name())
+		}
+	}
+
+	for i := range awsMachines.Items {
+		machine := &awsMachines.Items[i]
+		if machine.Spec.InstanceID == nil || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		state, ok := states[*machine.Spec.InstanceID]
+		if !ok {
+			continue
+		}
+
+		patchHelper, err := patch.NewHelper(machine, r.Client)
+		if err != nil {
+			r.Log.Error(err, "unable to create patch helper")
+			continue
+		}
+
+		// Trigger an update on the machine when the state changed
+		labels := machine.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[Ec2InstanceStateLabelKey] = string(state)
+		machine.SetLabels(labels)
+
+		if status, ok := statuses[*machine.Spec.InstanceID]; ok {
+			ec2service.SetInstanceStatusConditions(machine, status)
+		}
+
+		if err := patchHelper.Patch(ctx, machine); err != nil {
+			r.Log.Error(err, "unable to patch AWS machine", "awsMachine", machine.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsInstanceStatePoller) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.AWSCluster{}).
+		WithOptions(options).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		Complete(r)
+}
+
+// batchIDs splits the IDs into batches of at most size IDs.
+func batchIDs(ids []string, size int) [][]string {
+	batches := [][]string{}
+	for len(ids) > size {
+		batches = append(batches, ids[:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		batches = append(batches, ids)
+	}
+	return batches
+}
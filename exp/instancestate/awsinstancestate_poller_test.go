@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestAWSInstanceStatePoller(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+	}
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Cluster",
+				Name:       "test-cluster",
+			}},
+		},
+		Spec: infrav1.AWSClusterSpec{Region: "us-east-1"},
+	}
+	newMachine := func(name string, instanceID *string) *infrav1.AWSMachine {
+		return &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "test-cluster"},
+			},
+			Spec: infrav1.AWSMachineSpec{InstanceID: instanceID},
+		}
+	}
+	runningMachine := newMachine("running", pointer.StringPtr("i-running"))
+	stoppedMachine := newMachine("stopped", pointer.StringPtr("i-stopped"))
+	goneMachine := newMachine("gone", pointer.StringPtr("i-gone"))
+	pendingMachine := newMachine("pending", nil)
+	otherClusterMachine := newMachine("other-cluster", pointer.StringPtr("i-other"))
+	otherClusterMachine.Labels[clusterv1.ClusterLabelName] = "other-cluster"
+
+	ec2Mock.EXPECT().DescribeInstancesPagesWithContext(context.TODO(), &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{{Name: aws.String("instance-id"), Values: aws.StringSlice([]string{"i-gone", "i-running", "i-stopped"})}},
+	}, gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool, _ ...interface{}) error {
+		fn(&ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{{
+				Instances: []*ec2.Instance{
+					{InstanceId: aws.String("i-running"), State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)}},
+					{InstanceId: aws.String("i-stopped"), State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameStopped)}},
+				},
+			}},
+		}, true)
+		return nil
+	})
+	ec2Mock.EXPECT().DescribeInstanceStatusPagesWithContext(context.TODO(), &ec2.DescribeInstanceStatusInput{
+		InstanceIds: aws.StringSlice([]string{"i-running"}),
+	}, gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.DescribeInstanceStatusInput, fn func(*ec2.DescribeInstanceStatusOutput, bool) bool, _ ...interface{}) error {
+		fn(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []*ec2.InstanceStatus{{
+				InstanceId:     aws.String("i-running"),
+				SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String(ec2.SummaryStatusOk)},
+				InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String(ec2.SummaryStatusOk)},
+			}},
+		}, true)
+		return nil
+	})
+
+	r := &AwsInstanceStatePoller{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(cluster, awsCluster, runningMachine, stoppedMachine, goneMachine, pendingMachine, otherClusterMachine).Build(),
+		Log:      ctrl.Log.WithName("controllers").WithName("AWSInstanceStatePoller"),
+		Interval: time.Minute,
+		ec2ServiceFactory: func() ec2iface.EC2API {
+			return ec2Mock
+		},
+	}
+
+	result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(awsCluster)})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+	m := &infrav1.AWSMachine{}
+	g.Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(runningMachine), m)).To(Succeed())
+	g.Expect(m.GetLabels()).To(HaveKeyWithValue(Ec2InstanceStateLabelKey, "running"))
+	g.Expect(conditions.IsTrue(m, infrav1.InstanceReachableCondition)).To(BeTrue())
+	g.Expect(conditions.IsTrue(m, infrav1.NoScheduledEventsCondition)).To(BeTrue())
+
+	g.Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(stoppedMachine), m)).To(Succeed())
+	g.Expect(m.GetLabels()).To(HaveKeyWithValue(Ec2InstanceStateLabelKey, "stopped"))
+	g.Expect(conditions.Has(m, infrav1.InstanceReachableCondition)).To(BeFalse())
+
+	for _, machine := range []*infrav1.AWSMachine{goneMachine, pendingMachine, otherClusterMachine} {
+		g.Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(machine), m)).To(Succeed())
+		g.Expect(m.GetLabels()).NotTo(HaveKey(Ec2InstanceStateLabelKey))
+	}
+}
+
+func TestBatchIDs(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(batchIDs(nil, 2)).To(BeEmpty())
+	g.Expect(batchIDs([]string{"a", "b"}, 2)).To(Equal([][]string{{"a", "b"}}))
+	g.Expect(batchIDs([]string{"a", "b", "c", "d", "e"}, 2)).To(Equal([][]string{{"a", "b"}, {"c", "d"}, {"e"}}))
+}
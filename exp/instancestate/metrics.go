@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricInstanceStateSubsystem = "instance_state"
+	metricQueueDepthKey          = "queue_depth"
+	metricProcessingDurationKey  = "message_processing_duration_seconds"
+	metricMessageLatencyKey      = "message_latency_seconds"
+	metricClusterLabel           = "cluster"
+	metricResultLabel            = "result"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var (
+	queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricInstanceStateSubsystem,
+		Name:      metricQueueDepthKey,
+		Help:      "Approximate number of messages available in the instance state queue of a cluster",
+	}, []string{metricClusterLabel})
+	messageProcessingDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricInstanceStateSubsystem,
+		Name:      metricProcessingDurationKey,
+		Help:      "Time taken to process an instance state message",
+	}, []string{metricClusterLabel, metricResultLabel})
+	messageLatencySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricInstanceStateSubsystem,
+		Name:      metricMessageLatencyKey,
+		Help:      "Time between an instance state message being sent to the queue and being processed",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{metricClusterLabel, metricResultLabel})
+)
+
+func init() {
+	metrics.Registry.MustRegister(queueDepth)
+	metrics.Registry.MustRegister(messageProcessingDurationSeconds)
+	metrics.Registry.MustRegister(messageLatencySeconds)
+}
+
+// deleteClusterMetrics stops reporting the metrics of a cluster which is no longer watched.
+func deleteClusterMetrics(cluster string) {
+	queueDepth.DeleteLabelValues(cluster)
+	for _, result := range []string{resultSuccess, resultFailure} {
+		messageProcessingDurationSeconds.DeleteLabelValues(cluster, result)
+		messageLatencySeconds.DeleteLabelValues(cluster, result)
+	}
+}
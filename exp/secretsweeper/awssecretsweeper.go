@@ -0,0 +1,508 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretsweeper deletes bootstrap data secrets and objects which are no longer referenced by any AWSMachine.
+package secretsweeper
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/secretsmanager"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+const (
+	// secretsManagerPrefix is the name prefix of the bootstrap data secrets in AWS Secrets Manager.
+	secretsManagerPrefix = "aws.cluster.x-k8s.io/"
+	// ssmPath is the path of the bootstrap data parameters in AWS Systems Manager Parameter Store.
+	ssmPath = "/cluster.x-k8s.io"
+
+	// backendS3 is the metrics and events name of the bootstrap data objects in the S3 bucket of a cluster,
+	// which are either Ignition configs or userdata of the s3 secure secrets backend.
+	backendS3 = "s3"
+)
+
+var (
+	// secretChunkSuffixRe matches the chunk index appended to the secret prefix of a Secrets Manager secret.
+	secretChunkSuffixRe = regexp.MustCompile(`-\d+$`)
+
+	// s3BootstrapDataPrefixes are the key prefixes of the bootstrap data objects in the S3 bucket of a cluster.
+	s3BootstrapDataPrefixes = []string{"control-plane/", "node/", "secure/"}
+)
+
+// AwsSecretSweeper periodically deletes the bootstrap data of each AWSCluster which was not deleted together
+// with its AWSMachine, e.g. because the instance failed to boot or the AWSMachine was force deleted.
+// Secrets Manager secrets and SSM parameters tagged with the cluster, and the bootstrap data objects in the
+// S3 bucket of the cluster, are deleted when no AWSMachine references them and they are older than the grace period.
+type AwsSecretSweeper struct {
+	client.Client
+	Log              logr.Logger
+	Recorder         record.EventRecorder
+	Interval         time.Duration
+	GracePeriod      time.Duration
+	DryRun           bool
+	Endpoints        []scope.ServiceEndpoint
+	WatchFilterValue string
+
+	secretsManagerServiceFactory func() secretsmanageriface.SecretsManagerAPI
+	ssmServiceFactory            func() ssmiface.SSMAPI
+	s3ServiceFactory             func() s3iface.S3API
+	now                          func() time.Time
+
+	// clusterNames are the names of the Clusters of the swept AWSClusters, which label the metrics of the sweeps.
+	clusterNames     map[types.NamespacedName]string
+	clusterNamesLock sync.Mutex
+}
+
+// bootstrapDataEntry is a single secret, parameter or object holding bootstrap data.
+type bootstrapDataEntry struct {
+	name    string
+	created time.Time
+	delete  func(ctx context.Context) error
+}
+
+// backendLister lists the orphaned bootstrap data of a backend.
+type backendLister struct {
+	name string
+	list func(context.Context, *scope.ClusterScope, references) ([]bootstrapDataEntry, error)
+}
+
+// references holds the bootstrap data referenced by the AWSMachines of a cluster.
+type references struct {
+	secretPrefixes map[string]bool
+	machineNames   map[string]bool
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+func (r *AwsSecretSweeper) getSecretsManagerService(scope *scope.ClusterScope) secretsmanageriface.SecretsManagerAPI {
+	if r.secretsManagerServiceFactory != nil {
+		return r.secretsManagerServiceFactory()
+	}
+
+	return secretsmanager.NewService(scope).SecretsManagerClient
+}
+
+func (r *AwsSecretSweeper) getSSMService(scope *scope.ClusterScope) ssmiface.SSMAPI {
+	if r.ssmServiceFactory != nil {
+		return r.ssmServiceFactory()
+	}
+
+	return ssm.NewService(scope).SSMClient
+}
+
+func (r *AwsSecretSweeper) getS3Service(scope *scope.ClusterScope) s3iface.S3API {
+	if r.s3ServiceFactory != nil {
+		return r.s3ServiceFactory()
+	}
+
+	return s3.NewService(scope).S3Client
+}
+
+func (r *AwsSecretSweeper) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Fetch the AWSCluster instance
+	awsCluster := &infrav1.AWSCluster{}
+	err := r.Get(ctx, req.NamespacedName, awsCluster)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forgetCluster(req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Stop sweeping deleted clusters, the AWSMachines delete their own bootstrap data
+	if !awsCluster.DeletionTimestamp.IsZero() {
+		r.forgetCluster(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, awsCluster.ObjectMeta)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("Cluster Controller has not yet set OwnerRef")
+		return reconcile.Result{}, nil
+	}
+
+	if annotations.IsPaused(cluster, awsCluster) {
+		return reconcile.Result{RequeueAfter: r.Interval}, nil
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:         r.Client,
+		Logger:         &log,
+		Cluster:        cluster,
+		AWSCluster:     awsCluster,
+		ControllerName: "awssecretsweeper",
+		Endpoints:      r.Endpoints,
+	})
+	if err != nil {
+		return reconcile.Result{}, errors.Errorf("failed to create scope: %+v", err)
+	}
+
+	r.rememberCluster(req.NamespacedName, clusterScope.Name())
+
+	if err := r.sweep(ctx, clusterScope); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Interval}, nil
+}
+
+// sweep deletes the orphaned bootstrap data of all backends of the cluster, continuing with the
+// next backend if one fails.
+func (r *AwsSecretSweeper) sweep(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	refs, err := r.references(ctx, clusterScope)
+	if err != nil {
+		return err
+	}
+
+	backends := []backendLister{
+		{name: string(infrav1.SecretBackendSecretsManager), list: r.orphanedSecrets},
+		{name: string(infrav1.SecretBackendSSMParameterStore), list: r.orphanedParameters},
+	}
+	if clusterScope.Bucket() != nil {
+		backends = append(backends, backendLister{name: backendS3, list: r.orphanedObjects})
+	}
+
+	var errs []error
+	for _, backend := range backends {
+		orphans, err := backend.list(ctx, clusterScope, refs)
+		if err != nil {
+			sweepErrorsTotal.WithLabelValues(clusterScope.Name(), backend.name).Inc()
+			errs = append(errs, errors.Wrapf(err, "failed to list %s bootstrap data of cluster %q", backend.name, clusterScope.Name()))
+			continue
+		}
+		if err := r.deleteOrphans(ctx, clusterScope, backend.name, orphans); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// references collects the secret prefixes and names of all AWSMachines of the cluster, including the ones being deleted,
+// which still delete their own bootstrap data.
+func (r *AwsSecretSweeper) references(ctx context.Context, clusterScope *scope.ClusterScope) (references, error) {
+	awsMachines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, awsMachines, client.InNamespace(clusterScope.Namespace()), client.MatchingLabels{clusterv1.ClusterLabelName: clusterScope.Name()}); err != nil {
+		return references{}, errors.Wrap(err, "failed to list AWSMachines")
+	}
+
+	refs := references{
+		secretPrefixes: map[string]bool{},
+		machineNames:   map[string]bool{},
+	}
+	for _, machine := range awsMachines.Items {
+		refs.machineNames[machine.Name] = true
+		if machine.Spec.CloudInit.SecretPrefix != "" {
+			refs.secretPrefixes[machine.Spec.CloudInit.SecretPrefix] = true
+		}
+	}
+
+	return refs, nil
+}
+
+// deleteOrphans deletes the orphans older than the grace period, or only reports them in dry-run mode.
+func (r *AwsSecretSweeper) deleteOrphans(ctx context.Context, clusterScope *scope.ClusterScope, backend string, orphans []bootstrapDataEntry) error {
+	cutoff := r.currentTime().Add(-r.GracePeriod)
+
+	expired := []bootstrapDataEntry{}
+	for _, orphan := range orphans {
+		if orphan.created.Before(cutoff) {
+			expired = append(expired, orphan)
+		}
+	}
+	orphanedBootstrapData.WithLabelValues(clusterScope.Name(), backend).Set(float64(len(expired)))
+	if len(expired) == 0 {
+		return nil
+	}
+
+	if r.DryRun {
+		for _, orphan := range expired {
+			clusterScope.Info("Found orphaned bootstrap data, not deleting in dry-run mode", "backend", backend, "name", orphan.name)
+		}
+		r.Recorder.Eventf(clusterScope.InfraCluster(), corev1.EventTypeNormal, "FoundOrphanedBootstrapData",
+			"Found %d orphaned %s bootstrap data entries older than %s, not deleting in dry-run mode", len(expired), backend, r.GracePeriod)
+		return nil
+	}
+
+	deleted := 0
+	var errs []error
+	for _, orphan := range expired {
+		clusterScope.Info("Deleting orphaned bootstrap data", "backend", backend, "name", orphan.name)
+		if err := orphan.delete(ctx); err != nil {
+			sweepErrorsTotal.WithLabelValues(clusterScope.Name(), backend).Inc()
+			errs = append(errs, errors.Wrapf(err, "failed to delete %s bootstrap data %q", backend, orphan.name))
+			continue
+		}
+		deletedBootstrapDataTotal.WithLabelValues(clusterScope.Name(), backend).Inc()
+		deleted++
+	}
+
+	if deleted > 0 {
+		r.Recorder.Eventf(clusterScope.InfraCluster(), corev1.EventTypeNormal, "DeletedOrphanedBootstrapData",
+			"Deleted %d orphaned %s bootstrap data entries older than %s", deleted, backend, r.GracePeriod)
+	}
+	if len(errs) > 0 {
+		r.Recorder.Eventf(clusterScope.InfraCluster(), corev1.EventTypeWarning, "FailedDeleteOrphanedBootstrapData",
+			"Failed to delete %d orphaned %s bootstrap data entries", len(errs), backend)
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// orphanedSecrets lists the Secrets Manager secrets of the cluster whose secret prefix is not referenced by any AWSMachine.
+func (r *AwsSecretSweeper) orphanedSecrets(ctx context.Context, clusterScope *scope.ClusterScope, refs references) ([]bootstrapDataEntry, error) {
+	secretsManagerClient := r.getSecretsManagerService(clusterScope)
+
+	input := &awssecretsmanager.ListSecretsInput{
+		Filters: []*awssecretsmanager.Filter{
+			{Key: aws.String(awssecretsmanager.FilterNameStringTypeTagKey), Values: aws.StringSlice([]string{infrav1.ClusterTagKey(clusterScope.Name())})},
+			{Key: aws.String(awssecretsmanager.FilterNameStringTypeName), Values: aws.StringSlice([]string{secretsManagerPrefix})},
+		},
+	}
+
+	orphans := []bootstrapDataEntry{}
+	err := secretsManagerClient.ListSecretsPagesWithContext(ctx, input, func(out *awssecretsmanager.ListSecretsOutput, _ bool) bool {
+		for _, secret := range out.SecretList {
+			name := aws.StringValue(secret.Name)
+			// The name filter matches the prefix of any word of the name, so check the prefix again.
+			if !strings.HasPrefix(name, secretsManagerPrefix) || refs.secretPrefixes[secretChunkSuffixRe.ReplaceAllString(name, "")] {
+				continue
+			}
+			orphans = append(orphans, bootstrapDataEntry{
+				name:    name,
+				created: aws.TimeValue(secret.CreatedDate),
+				delete: func(ctx context.Context) error {
+					_, err := secretsManagerClient.DeleteSecretWithContext(ctx, &awssecretsmanager.DeleteSecretInput{
+						SecretId:                   aws.String(name),
+						ForceDeleteWithoutRecovery: aws.Bool(true),
+					})
+					if code, ok := awserrors.Code(err); ok && code == awssecretsmanager.ErrCodeResourceNotFoundException {
+						return nil
+					}
+					return err
+				},
+			})
+		}
+		return true
+	})
+
+	return orphans, err
+}
+
+// orphanedParameters lists the SSM parameters of the cluster whose secret prefix is not referenced by any AWSMachine.
+func (r *AwsSecretSweeper) orphanedParameters(ctx context.Context, clusterScope *scope.ClusterScope, refs references) ([]bootstrapDataEntry, error) {
+	ssmClient := r.getSSMService(clusterScope)
+
+	input := &awsssm.DescribeParametersInput{
+		ParameterFilters: []*awsssm.ParameterStringFilter{
+			{Key: aws.String("Path"), Option: aws.String("Recursive"), Values: aws.StringSlice([]string{ssmPath})},
+			{Key: aws.String(fmt.Sprintf("tag:%s", infrav1.ClusterTagKey(clusterScope.Name()))), Values: aws.StringSlice([]string{string(infrav1.ResourceLifecycleOwned)})},
+		},
+	}
+
+	orphans := []bootstrapDataEntry{}
+	err := ssmClient.DescribeParametersPagesWithContext(ctx, input, func(out *awsssm.DescribeParametersOutput, _ bool) bool {
+		for _, parameter := range out.Parameters {
+			name := aws.StringValue(parameter.Name)
+			if refs.secretPrefixes[path.Dir(name)] {
+				continue
+			}
+			orphans = append(orphans, bootstrapDataEntry{
+				name:    name,
+				created: aws.TimeValue(parameter.LastModifiedDate),
+				delete: func(ctx context.Context) error {
+					_, err := ssmClient.DeleteParameterWithContext(ctx, &awsssm.DeleteParameterInput{
+						Name: aws.String(name),
+					})
+					if awserrors.IsNotFound(err) {
+						return nil
+					}
+					return err
+				},
+			})
+		}
+		return true
+	})
+
+	return orphans, err
+}
+
+// orphanedObjects lists the bootstrap data objects in the S3 bucket of the cluster which are not referenced by any
+// AWSMachine. Ignition configs are stored by machine name, userdata of the s3 secure secrets backend by secret prefix.
+// As the bucket may be shared with other clusters, only the objects tagged with the cluster are listed.
+func (r *AwsSecretSweeper) orphanedObjects(ctx context.Context, clusterScope *scope.ClusterScope, refs references) ([]bootstrapDataEntry, error) {
+	s3Client := r.getS3Service(clusterScope)
+	bucket := clusterScope.Bucket().Name
+
+	orphans := []bootstrapDataEntry{}
+	for _, prefix := range s3BootstrapDataPrefixes {
+		input := &awss3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(prefix),
+		}
+		unreferenced := []*awss3.Object{}
+		err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(out *awss3.ListObjectsV2Output, _ bool) bool {
+			for _, object := range out.Contents {
+				if !objectReferenced(bucket, aws.StringValue(object.Key), refs) {
+					unreferenced = append(unreferenced, object)
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range unreferenced {
+			key := aws.StringValue(object.Key)
+			owned, err := objectOwned(ctx, s3Client, bucket, key, clusterScope.Name())
+			if err != nil {
+				return nil, err
+			}
+			if !owned {
+				continue
+			}
+			orphans = append(orphans, bootstrapDataEntry{
+				name:    key,
+				created: aws.TimeValue(object.LastModified),
+				delete: func(ctx context.Context) error {
+					_, err := s3Client.DeleteObjectWithContext(ctx, &awss3.DeleteObjectInput{
+						Bucket: aws.String(bucket),
+						Key:    aws.String(key),
+					})
+					return err
+				},
+			})
+		}
+	}
+
+	return orphans, nil
+}
+
+// objectReferenced returns true if an AWSMachine references the object with the given key.
+func objectReferenced(bucket, key string, refs references) bool {
+	if strings.HasPrefix(key, "secure/") {
+		objectURL := &url.URL{Scheme: "s3", Host: bucket, Path: key}
+		return refs.secretPrefixes[objectURL.String()]
+	}
+
+	return refs.machineNames[path.Base(key)]
+}
+
+// objectOwned returns true if the object with the given key is tagged as owned by the cluster. Objects of other
+// clusters sharing the bucket, and objects stored before bootstrap data objects were tagged, are not owned.
+func objectOwned(ctx context.Context, s3Client s3iface.S3API, bucket, key, clusterName string) (bool, error) {
+	out, err := s3Client.GetObjectTaggingWithContext(ctx, &awss3.GetObjectTaggingInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awss3.ErrCodeNoSuchKey {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get tags of object %q", key)
+	}
+
+	for _, tag := range out.TagSet {
+		if aws.StringValue(tag.Key) == infrav1.ClusterTagKey(clusterName) && aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// rememberCluster records the name of the Cluster of a swept AWSCluster.
+func (r *AwsSecretSweeper) rememberCluster(awsCluster types.NamespacedName, clusterName string) {
+	r.clusterNamesLock.Lock()
+	defer r.clusterNamesLock.Unlock()
+
+	if r.clusterNames == nil {
+		r.clusterNames = map[types.NamespacedName]string{}
+	}
+	r.clusterNames[awsCluster] = clusterName
+}
+
+// forgetCluster stops reporting the metrics of the Cluster of an AWSCluster which is no longer swept.
+func (r *AwsSecretSweeper) forgetCluster(awsCluster types.NamespacedName) {
+	r.clusterNamesLock.Lock()
+	defer r.clusterNamesLock.Unlock()
+
+	if clusterName, ok := r.clusterNames[awsCluster]; ok {
+		deleteClusterMetrics(clusterName)
+		delete(r.clusterNames, awsCluster)
+	}
+}
+
+func (r *AwsSecretSweeper) currentTime() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+
+	return time.Now()
+}
+
+func (r *AwsSecretSweeper) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.AWSCluster{}).
+		Named("awssecretsweeper").
+		WithOptions(options).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		Complete(r)
+}
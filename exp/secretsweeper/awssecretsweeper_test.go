@@ -0,0 +1,243 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsweeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3/mock_s3iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/secretsmanager/mock_secretsmanageriface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestAWSSecretSweeper(t *testing.T) {
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-time.Hour)
+
+	setup := func(t *testing.T, g *WithT) (*AwsSecretSweeper, *mock_secretsmanageriface.MockSecretsManagerAPI, *mock_ssmiface.MockSSMAPI, *mock_s3iface.MockS3API, *record.FakeRecorder) {
+		t.Helper()
+		mockCtrl := gomock.NewController(t)
+		t.Cleanup(mockCtrl.Finish)
+		secretsManagerMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+		ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+		s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+
+		scheme := runtime.NewScheme()
+		g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+		g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+		cluster := &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		}
+		awsCluster := &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-awscluster",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       "test-cluster",
+				}},
+			},
+			Spec: infrav1.AWSClusterSpec{
+				Region:   "us-east-1",
+				S3Bucket: &infrav1.S3Bucket{Name: "test-bucket"},
+			},
+		}
+		newMachine := func(name, secretPrefix string) *infrav1.AWSMachine {
+			return &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterLabelName: "test-cluster"},
+				},
+				Spec: infrav1.AWSMachineSpec{CloudInit: infrav1.CloudInit{SecretPrefix: secretPrefix}},
+			}
+		}
+
+		recorder := record.NewFakeRecorder(10)
+		r := &AwsSecretSweeper{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				cluster,
+				awsCluster,
+				newMachine("sm-machine", "aws.cluster.x-k8s.io/live"),
+				newMachine("ssm-machine", "/cluster.x-k8s.io/live"),
+				newMachine("s3-machine", "s3://test-bucket/secure/nodes/s3-machine/live"),
+				newMachine("ignition-machine", ""),
+			).Build(),
+			Log:         ctrl.Log.WithName("controllers").WithName("AWSSecretSweeper"),
+			Recorder:    recorder,
+			Interval:    time.Hour,
+			GracePeriod: 24 * time.Hour,
+			secretsManagerServiceFactory: func() secretsmanageriface.SecretsManagerAPI {
+				return secretsManagerMock
+			},
+			ssmServiceFactory: func() ssmiface.SSMAPI {
+				return ssmMock
+			},
+			s3ServiceFactory: func() s3iface.S3API {
+				return s3Mock
+			},
+			now: func() time.Time {
+				return now
+			},
+		}
+
+		secretsManagerMock.EXPECT().ListSecretsPagesWithContext(context.TODO(), &awssecretsmanager.ListSecretsInput{
+			Filters: []*awssecretsmanager.Filter{
+				{Key: aws.String(awssecretsmanager.FilterNameStringTypeTagKey), Values: aws.StringSlice([]string{infrav1.ClusterTagKey("test-cluster")})},
+				{Key: aws.String(awssecretsmanager.FilterNameStringTypeName), Values: aws.StringSlice([]string{secretsManagerPrefix})},
+			},
+		}, gomock.Any()).DoAndReturn(func(_ context.Context, _ *awssecretsmanager.ListSecretsInput, fn func(*awssecretsmanager.ListSecretsOutput, bool) bool, _ ...interface{}) error {
+			fn(&awssecretsmanager.ListSecretsOutput{
+				SecretList: []*awssecretsmanager.SecretListEntry{
+					{Name: aws.String("aws.cluster.x-k8s.io/live-0"), CreatedDate: aws.Time(old)},
+					{Name: aws.String("aws.cluster.x-k8s.io/live-1"), CreatedDate: aws.Time(old)},
+					{Name: aws.String("aws.cluster.x-k8s.io/orphan-0"), CreatedDate: aws.Time(old)},
+					{Name: aws.String("aws.cluster.x-k8s.io/recent-0"), CreatedDate: aws.Time(recent)},
+					{Name: aws.String("other/aws.cluster.x-k8s.io/unrelated-0"), CreatedDate: aws.Time(old)},
+				},
+			}, true)
+			return nil
+		})
+		ssmMock.EXPECT().DescribeParametersPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *awsssm.DescribeParametersInput, fn func(*awsssm.DescribeParametersOutput, bool) bool, _ ...interface{}) error {
+				fn(&awsssm.DescribeParametersOutput{
+					Parameters: []*awsssm.ParameterMetadata{
+						{Name: aws.String("/cluster.x-k8s.io/live/0"), LastModifiedDate: aws.Time(old)},
+						{Name: aws.String("/cluster.x-k8s.io/orphan/0"), LastModifiedDate: aws.Time(old)},
+					},
+				}, true)
+				return nil
+			})
+		s3Mock.EXPECT().ListObjectsV2PagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *awss3.ListObjectsV2Input, fn func(*awss3.ListObjectsV2Output, bool) bool, _ ...interface{}) error {
+				g.Expect(aws.StringValue(input.Bucket)).To(Equal("test-bucket"))
+				objects := map[string][]*awss3.Object{
+					"node/": {
+						{Key: aws.String("node/ignition-machine"), LastModified: aws.Time(old)},
+						{Key: aws.String("node/orphan-machine"), LastModified: aws.Time(old)},
+						{Key: aws.String("node/other-cluster-machine"), LastModified: aws.Time(old)},
+						{Key: aws.String("node/untagged-machine"), LastModified: aws.Time(old)},
+					},
+					"secure/": {
+						{Key: aws.String("secure/nodes/s3-machine/live"), LastModified: aws.Time(old)},
+						{Key: aws.String("secure/nodes/s3-machine/previous"), LastModified: aws.Time(old)},
+					},
+				}
+				fn(&awss3.ListObjectsV2Output{Contents: objects[aws.StringValue(input.Prefix)]}, true)
+				return nil
+			}).Times(len(s3BootstrapDataPrefixes))
+		s3Mock.EXPECT().GetObjectTaggingWithContext(context.TODO(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *awss3.GetObjectTaggingInput, _ ...interface{}) (*awss3.GetObjectTaggingOutput, error) {
+				g.Expect(aws.StringValue(input.Bucket)).To(Equal("test-bucket"))
+				tags := map[string][]*awss3.Tag{
+					"node/orphan-machine": {
+						{Key: aws.String(infrav1.ClusterTagKey("test-cluster")), Value: aws.String("owned")},
+					},
+					"node/other-cluster-machine": {
+						{Key: aws.String(infrav1.ClusterTagKey("other-cluster")), Value: aws.String("owned")},
+					},
+					"secure/nodes/s3-machine/previous": {
+						{Key: aws.String(infrav1.ClusterTagKey("test-cluster")), Value: aws.String("owned")},
+					},
+				}
+				return &awss3.GetObjectTaggingOutput{TagSet: tags[aws.StringValue(input.Key)]}, nil
+			}).Times(4)
+
+		return r, secretsManagerMock, ssmMock, s3Mock, recorder
+	}
+
+	t.Run("deletes orphaned bootstrap data older than the grace period", func(t *testing.T) {
+		g := NewWithT(t)
+		r, secretsManagerMock, ssmMock, s3Mock, recorder := setup(t, g)
+
+		secretsManagerMock.EXPECT().DeleteSecretWithContext(context.TODO(), &awssecretsmanager.DeleteSecretInput{
+			SecretId:                   aws.String("aws.cluster.x-k8s.io/orphan-0"),
+			ForceDeleteWithoutRecovery: aws.Bool(true),
+		}).Return(&awssecretsmanager.DeleteSecretOutput{}, nil)
+		ssmMock.EXPECT().DeleteParameterWithContext(context.TODO(), &awsssm.DeleteParameterInput{
+			Name: aws.String("/cluster.x-k8s.io/orphan/0"),
+		}).Return(&awsssm.DeleteParameterOutput{}, nil)
+		s3Mock.EXPECT().DeleteObjectWithContext(context.TODO(), &awss3.DeleteObjectInput{
+			Bucket: aws.String("test-bucket"),
+			Key:    aws.String("node/orphan-machine"),
+		}).Return(&awss3.DeleteObjectOutput{}, nil)
+		s3Mock.EXPECT().DeleteObjectWithContext(context.TODO(), &awss3.DeleteObjectInput{
+			Bucket: aws.String("test-bucket"),
+			Key:    aws.String("secure/nodes/s3-machine/previous"),
+		}).Return(&awss3.DeleteObjectOutput{}, nil)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "test-awscluster"}})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(time.Hour))
+		g.Expect(recorder.Events).To(HaveLen(3))
+		g.Expect(<-recorder.Events).To(ContainSubstring("DeletedOrphanedBootstrapData"))
+	})
+
+	t.Run("only reports orphaned bootstrap data in dry-run mode", func(t *testing.T) {
+		g := NewWithT(t)
+		r, _, _, _, recorder := setup(t, g)
+		r.DryRun = true
+
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "test-awscluster"}})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(recorder.Events).To(HaveLen(3))
+		g.Expect(<-recorder.Events).To(ContainSubstring("FoundOrphanedBootstrapData"))
+	})
+
+	t.Run("stops reporting the metrics of the cluster once its AWSCluster is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		r, _, _, _, _ := setup(t, g)
+		r.DryRun = true
+		request := ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "test-awscluster"}}
+
+		_, err := r.Reconcile(context.TODO(), request)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(testutil.ToFloat64(orphanedBootstrapData.WithLabelValues("test-cluster", backendS3))).To(Equal(float64(2)))
+
+		awsCluster := &infrav1.AWSCluster{}
+		g.Expect(r.Get(context.TODO(), request.NamespacedName, awsCluster)).To(Succeed())
+		g.Expect(r.Delete(context.TODO(), awsCluster)).To(Succeed())
+
+		_, err = r.Reconcile(context.TODO(), request)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(testutil.CollectAndCount(orphanedBootstrapData)).To(Equal(0))
+	})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsweeper
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+)
+
+const (
+	metricSecretSweeperSubsystem = "bootstrap_data_sweeper"
+	metricOrphansKey             = "orphans"
+	metricDeletedTotalKey        = "deleted_total"
+	metricErrorsTotalKey         = "errors_total"
+	metricClusterLabel           = "cluster"
+	metricBackendLabel           = "backend"
+)
+
+var (
+	orphanedBootstrapData = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSecretSweeperSubsystem,
+		Name:      metricOrphansKey,
+		Help:      "Number of orphaned bootstrap data entries older than the grace period found by the last sweep of a cluster",
+	}, []string{metricClusterLabel, metricBackendLabel})
+	deletedBootstrapDataTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSecretSweeperSubsystem,
+		Name:      metricDeletedTotalKey,
+		Help:      "Total number of orphaned bootstrap data entries deleted",
+	}, []string{metricClusterLabel, metricBackendLabel})
+	sweepErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSecretSweeperSubsystem,
+		Name:      metricErrorsTotalKey,
+		Help:      "Total number of errors listing or deleting orphaned bootstrap data",
+	}, []string{metricClusterLabel, metricBackendLabel})
+)
+
+func init() {
+	metrics.Registry.MustRegister(orphanedBootstrapData)
+	metrics.Registry.MustRegister(deletedBootstrapDataTotal)
+	metrics.Registry.MustRegister(sweepErrorsTotal)
+}
+
+// deleteClusterMetrics stops reporting the metrics of a cluster which is no longer swept.
+func deleteClusterMetrics(cluster string) {
+	for _, backend := range []string{string(infrav1.SecretBackendSecretsManager), string(infrav1.SecretBackendSSMParameterStore), backendS3} {
+		orphanedBootstrapData.DeleteLabelValues(cluster, backend)
+		deletedBootstrapDataTotal.DeleteLabelValues(cluster, backend)
+		sweepErrorsTotal.DeleteLabelValues(cluster, backend)
+	}
+}
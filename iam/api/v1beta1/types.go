@@ -75,6 +75,9 @@ const (
 	// StringNotLike is an AWS IAM policy condition operator.
 	StringNotLike ConditionOperator = "StringNotLike"
 
+	// Bool is an AWS IAM policy condition operator.
+	Bool ConditionOperator = "Bool"
+
 	// DefaultNameSuffix is the default suffix appended to all AWS IAM roles created by clusterawsadm.
 	DefaultNameSuffix = ".cluster-api-provider-aws.sigs.k8s.io"
 )
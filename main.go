@@ -56,6 +56,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/exp/controlleridentitycreator"
 	expcontrollers "sigs.k8s.io/cluster-api-provider-aws/exp/controllers"
 	"sigs.k8s.io/cluster-api-provider-aws/exp/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/exp/secretsweeper"
 	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/endpoints"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
@@ -98,6 +99,10 @@ var (
 	profilerAddress          string
 	awsClusterConcurrency    int
 	instanceStateConcurrency int
+	instanceStatePoll        time.Duration
+	bootstrapDataSweep       time.Duration
+	bootstrapDataGracePeriod time.Duration
+	bootstrapDataSweepDryRun bool
 	awsMachineConcurrency    int
 	syncPeriod               time.Duration
 	webhookPort              int
@@ -111,6 +116,7 @@ var (
 	maxEKSSyncPeriod         = time.Minute * 10
 	errMaxSyncPeriodExceeded = errors.New("sync period greater than maximum allowed")
 	errEKSInvalidFlags       = errors.New("invalid EKS flag combination")
+	errInstanceStateFlags    = errors.New("invalid instance state flag combination")
 )
 
 func main() {
@@ -194,6 +200,15 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AWSCluster")
 		os.Exit(1)
 	}
+	if err = (&controllers.AWSMachineTemplateReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("awsmachinetemplate-controller"),
+		Endpoints:        AWSServiceEndpoints,
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency, RecoverPanic: true}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AWSMachineTemplate")
+		os.Exit(1)
+	}
 	enableGates(ctx, mgr, AWSServiceEndpoints)
 
 	if err = (&infrav1.AWSMachineTemplate{}).SetupWebhookWithManager(mgr); err != nil {
@@ -323,6 +338,7 @@ func enableGates(ctx context.Context, mgr ctrl.Manager, awsServiceEndpoints []sc
 		setupLog.V(2).Info("enabling EKS bootstrap controller")
 		if err := (&eksbootstrapcontrollers.EKSConfigReconciler{
 			Client:           mgr.GetClient(),
+			Endpoints:        awsServiceEndpoints,
 			WatchFilterValue: watchFilterValue,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: true}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "EKSConfig")
@@ -368,6 +384,23 @@ func enableGates(ctx context.Context, mgr ctrl.Manager, awsServiceEndpoints []sc
 			os.Exit(1)
 		}
 	}
+	if instanceStatePoll > 0 {
+		if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
+			setupLog.Error(errInstanceStateFlags, "cannot use instance-state-poll-interval flag with EventBridgeInstanceState")
+			os.Exit(1)
+		}
+		setupLog.Info("Instance state polling enabled. enabling AWSInstanceStatePoller", "interval", instanceStatePoll)
+		if err := (&instancestate.AwsInstanceStatePoller{
+			Client:           mgr.GetClient(),
+			Log:              ctrl.Log.WithName("controllers").WithName("AWSInstanceStatePoller"),
+			Interval:         instanceStatePoll,
+			Endpoints:        awsServiceEndpoints,
+			WatchFilterValue: watchFilterValue,
+		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: true}); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AWSInstanceStatePoller")
+			os.Exit(1)
+		}
+	}
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		setupLog.Info("EventBridge notifications enabled. enabling AWSInstanceStateController")
 		if err := (&instancestate.AwsInstanceStateReconciler{
@@ -375,11 +408,28 @@ func enableGates(ctx context.Context, mgr ctrl.Manager, awsServiceEndpoints []sc
 			Log:              ctrl.Log.WithName("controllers").WithName("AWSInstanceStateController"),
 			Endpoints:        awsServiceEndpoints,
 			WatchFilterValue: watchFilterValue,
+			Workers:          instanceStateConcurrency,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: true}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSInstanceStateController")
 			os.Exit(1)
 		}
 	}
+	if bootstrapDataSweep > 0 {
+		setupLog.Info("Bootstrap data sweeping enabled. enabling AWSSecretSweeper", "interval", bootstrapDataSweep, "gracePeriod", bootstrapDataGracePeriod, "dryRun", bootstrapDataSweepDryRun)
+		if err := (&secretsweeper.AwsSecretSweeper{
+			Client:           mgr.GetClient(),
+			Log:              ctrl.Log.WithName("controllers").WithName("AWSSecretSweeper"),
+			Recorder:         mgr.GetEventRecorderFor("awssecretsweeper"),
+			Interval:         bootstrapDataSweep,
+			GracePeriod:      bootstrapDataGracePeriod,
+			DryRun:           bootstrapDataSweepDryRun,
+			Endpoints:        awsServiceEndpoints,
+			WatchFilterValue: watchFilterValue,
+		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: true}); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AWSSecretSweeper")
+			os.Exit(1)
+		}
+	}
 	if feature.Gates.Enabled(feature.AutoControllerIdentityCreator) {
 		setupLog.Info("AutoControllerIdentityCreator enabled")
 		if err := (&controlleridentitycreator.AWSControllerIdentityReconciler{
@@ -442,7 +492,33 @@ func initFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&instanceStateConcurrency,
 		"instance-state-concurrency",
 		5,
-		"Number of concurrent watches for instance state changes",
+		"Number of concurrent watches for instance state changes, and of instance state messages processed concurrently",
+	)
+
+	fs.DurationVar(&instanceStatePoll,
+		"instance-state-poll-interval",
+		0,
+		"Interval at which the EC2 instance state of the AWSMachines of each cluster is polled, with one batched DescribeInstances and DescribeInstanceStatus call per cluster. "+
+			"An alternative to the EventBridgeInstanceState feature for accounts where EventBridge rules and SQS queues cannot be created. Polling is disabled if 0.",
+	)
+
+	fs.DurationVar(&bootstrapDataSweep,
+		"bootstrap-data-sweep-interval",
+		0,
+		"Interval at which the Secrets Manager secrets, SSM parameters and S3 objects holding the bootstrap data of each cluster are swept, "+
+			"deleting the ones no longer referenced by any AWSMachine. Sweeping is disabled if 0.",
+	)
+
+	fs.DurationVar(&bootstrapDataGracePeriod,
+		"bootstrap-data-sweep-grace-period",
+		24*time.Hour,
+		"Minimum age of unreferenced bootstrap data before it is deleted by the sweeper",
+	)
+
+	fs.BoolVar(&bootstrapDataSweepDryRun,
+		"bootstrap-data-sweep-dry-run",
+		false,
+		"Only report the unreferenced bootstrap data found by the sweeper as events and metrics, without deleting it",
 	)
 
 	fs.IntVar(&awsMachineConcurrency,
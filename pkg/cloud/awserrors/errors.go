@@ -37,18 +37,22 @@ const (
 	InvalidClientTokenID       = "InvalidClientTokenId"
 	InvalidInstanceID          = "InvalidInstanceID.NotFound"
 	InvalidSubnet              = "InvalidSubnet"
+	LaunchTemplateIDNotFound   = "InvalidLaunchTemplateId.NotFound"
 	LaunchTemplateNameNotFound = "InvalidLaunchTemplateName.NotFoundException"
 	LoadBalancerNotFound       = "LoadBalancerNotFound"
 	NATGatewayNotFound         = "InvalidNatGatewayID.NotFound"
+	NetworkInterfaceNotFound   = "InvalidNetworkInterfaceID.NotFound"
 	// nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
 	NoSuchKey                               = "NoSuchKey"
+	OperationNotPermitted                   = "OperationNotPermitted"
 	PermissionNotFound                      = "InvalidPermission.NotFound"
 	ResourceExists                          = "ResourceExistsException"
 	ResourceNotFound                        = "InvalidResourceID.NotFound"
 	RouteTableNotFound                      = "InvalidRouteTableID.NotFound"
 	SubnetNotFound                          = "InvalidSubnetID.NotFound"
 	UnrecognizedClientException             = "UnrecognizedClientException"
+	VolumeNotFound                          = "InvalidVolume.NotFound"
 	VPCNotFound                             = "InvalidVpcID.NotFound"
 	ErrCodeRepositoryAlreadyExistsException = "RepositoryAlreadyExistsException"
 )
@@ -122,6 +126,14 @@ func IsRepositoryExists(err error) bool {
 	return false
 }
 
+// IsOperationNotPermitted checks if the operation is not permitted on the resource, e.g. because of termination protection.
+func IsOperationNotPermitted(err error) bool {
+	if code, ok := Code(err); ok {
+		return code == OperationNotPermitted
+	}
+	return false
+}
+
 // NewFailedDependency returns an error which indicates that a dependency failure status.
 func NewFailedDependency(msg string) error {
 	return &EC2Error{
@@ -164,8 +176,14 @@ func IsInvalidNotFoundError(err error) bool {
 			return true
 		case ssm.ErrCodeParameterNotFound:
 			return true
+		case LaunchTemplateIDNotFound:
+			return true
 		case LaunchTemplateNameNotFound:
 			return true
+		case NetworkInterfaceNotFound:
+			return true
+		case VolumeNotFound:
+			return true
 		}
 	}
 
@@ -66,6 +66,7 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		Cluster:        params.Cluster,
 		AWSCluster:     params.AWSCluster,
 		controllerName: params.ControllerName,
+		endpoints:      params.Endpoints,
 	}
 
 	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, clusterScope, params.AWSCluster.Spec.Region, params.Endpoints, *params.Logger)
@@ -97,6 +98,7 @@ type ClusterScope struct {
 	session         awsclient.ConfigProvider
 	serviceLimiters throttle.ServiceLimiters
 	controllerName  string
+	endpoints       []ServiceEndpoint
 }
 
 // Network returns the cluster network object.
@@ -236,6 +238,10 @@ func (s *ClusterScope) PatchObject() error {
 		}
 	}
 
+	if len(s.AWSCluster.Spec.DedicatedHosts) > 0 {
+		applicableConditions = append(applicableConditions, infrav1.DedicatedHostsReadyCondition)
+	}
+
 	conditions.SetSummary(s.AWSCluster,
 		conditions.WithConditions(applicableConditions...),
 		conditions.WithStepCounterIf(s.AWSCluster.ObjectMeta.DeletionTimestamp.IsZero()),
@@ -254,6 +260,7 @@ func (s *ClusterScope) PatchObject() error {
 			infrav1.RouteTablesReadyCondition,
 			infrav1.ClusterSecurityGroupsReadyCondition,
 			infrav1.BastionHostReadyCondition,
+			infrav1.DedicatedHostsReadyCondition,
 			infrav1.LoadBalancerReadyCondition,
 			infrav1.PrincipalUsageAllowedCondition,
 		}})
@@ -322,6 +329,41 @@ func (s *ClusterScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.AWSCluster.Status.Bastion = instance
 }
 
+// DedicatedHosts returns the dedicated hosts to allocate for the cluster.
+func (s *ClusterScope) DedicatedHosts() []infrav1.DedicatedHostSpec {
+	return s.AWSCluster.Spec.DedicatedHosts
+}
+
+// ControlPlaneDisableAPITermination returns whether control plane instances have termination protection by default.
+func (s *ClusterScope) ControlPlaneDisableAPITermination() bool {
+	return s.AWSCluster.Spec.ControlPlaneDisableAPITermination
+}
+
+// ControlPlaneDisableAPIStop returns whether control plane instances have stop protection by default.
+func (s *ClusterScope) ControlPlaneDisableAPIStop() bool {
+	return s.AWSCluster.Spec.ControlPlaneDisableAPIStop
+}
+
+// BootstrapSecretsKMSKeyARN returns the ARN of the KMS key encrypting the bootstrap data secrets by default.
+func (s *ClusterScope) BootstrapSecretsKMSKeyARN() string {
+	return s.AWSCluster.Spec.BootstrapSecretsKMSKeyARN
+}
+
+// Proxy returns the HTTP proxy of the instances of the cluster.
+func (s *ClusterScope) Proxy() *infrav1.ProxyConfig {
+	return s.AWSCluster.Spec.Proxy
+}
+
+// AdditionalTrustBundle returns the PEM encoded CA certificates the instances of the cluster trust additionally.
+func (s *ClusterScope) AdditionalTrustBundle() string {
+	return s.AWSCluster.Spec.AdditionalTrustBundle
+}
+
+// NoProxy returns the CIDR blocks and endpoints of the cluster which the instances reach without the HTTP proxy.
+func (s *ClusterScope) NoProxy() []string {
+	return NoProxy(s.VPC(), nil, s.AWSCluster.Spec.ControlPlaneEndpoint, s.endpoints)
+}
+
 // SSHKeyName returns the SSH key name to use for instances.
 func (s *ClusterScope) SSHKeyName() *string {
 	return s.AWSCluster.Spec.SSHKeyName
@@ -43,6 +43,27 @@ type EC2Scope interface {
 	// SetBastionInstance sets the bastion instance in the status of the cluster.
 	SetBastionInstance(instance *infrav1.Instance)
 
+	// DedicatedHosts returns the dedicated hosts to allocate for the cluster.
+	DedicatedHosts() []infrav1.DedicatedHostSpec
+
+	// ControlPlaneDisableAPITermination returns whether control plane instances have termination protection by default.
+	ControlPlaneDisableAPITermination() bool
+
+	// ControlPlaneDisableAPIStop returns whether control plane instances have stop protection by default.
+	ControlPlaneDisableAPIStop() bool
+
+	// BootstrapSecretsKMSKeyARN returns the ARN of the KMS key encrypting the bootstrap data secrets by default.
+	BootstrapSecretsKMSKeyARN() string
+
+	// Proxy returns the HTTP proxy of the instances of the cluster.
+	Proxy() *infrav1.ProxyConfig
+
+	// AdditionalTrustBundle returns the PEM encoded CA certificates the instances of the cluster trust additionally.
+	AdditionalTrustBundle() string
+
+	// NoProxy returns the CIDR blocks and endpoints of the cluster which the instances reach without the HTTP proxy.
+	NoProxy() []string
+
 	// SSHKeyName returns the SSH key name to use for instances.
 	SSHKeyName() *string
 
@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+)
+
+// LaunchTemplateScope is the interface for the scope of an object owning an EC2 launch template,
+// which is either an AWSMachinePool or an AWSMachine.
+type LaunchTemplateScope interface {
+	// Name returns the name of the owner.
+	Name() string
+
+	// LaunchTemplateName returns the name of the launch template.
+	LaunchTemplateName() string
+
+	// AdditionalTags returns the tags to add to the launch template and the resources created from it.
+	AdditionalTags() infrav1.Tags
+
+	// IsEKSManaged returns true if the owner belongs to an EKS cluster.
+	IsEKSManaged() bool
+
+	// GetLaunchTemplate returns the desired launch template.
+	GetLaunchTemplate() *expinfrav1.AWSLaunchTemplate
+
+	// GetLaunchTemplateIDStatus returns the ID of the launch template from the status.
+	GetLaunchTemplateIDStatus() string
+
+	// SetLaunchTemplateIDStatus sets the ID of the launch template in the status.
+	SetLaunchTemplateIDStatus(id string)
+}
@@ -18,18 +18,22 @@ package scope
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2/klogr"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/mime"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -116,6 +120,25 @@ func (m *MachineScope) IsControlPlane() bool {
 	return util.IsControlPlaneMachine(m.Machine)
 }
 
+// DisableAPITermination returns whether termination protection should be enabled on the instance.
+// Control plane machines which do not set it inherit the default of the cluster.
+func (m *MachineScope) DisableAPITermination() bool {
+	if m.AWSMachine.Spec.DisableAPITermination != nil {
+		return *m.AWSMachine.Spec.DisableAPITermination
+	}
+	return m.IsControlPlane() && m.InfraCluster.ControlPlaneDisableAPITermination()
+}
+
+// DisableAPIStop returns whether stop protection should be enabled on the instance. Control plane
+// machines which do not set it inherit the default of the cluster, unless they are spot instances,
+// which do not support stop protection.
+func (m *MachineScope) DisableAPIStop() bool {
+	if m.AWSMachine.Spec.DisableAPIStop != nil {
+		return *m.AWSMachine.Spec.DisableAPIStop
+	}
+	return m.IsControlPlane() && m.AWSMachine.Spec.SpotMarketOptions == nil && m.InfraCluster.ControlPlaneDisableAPIStop()
+}
+
 // Role returns the machine role from the labels.
 func (m *MachineScope) Role() string {
 	if util.IsControlPlaneMachine(m.Machine) {
@@ -152,6 +175,12 @@ func (m *MachineScope) SetInstanceID(instanceID string) {
 	m.AWSMachine.Spec.InstanceID = pointer.StringPtr(instanceID)
 }
 
+// IsAdoptingInstance returns true if the AWSMachine references an existing instance through
+// Spec.InstanceID which has not been adopted yet, i.e. Spec.ProviderID is not set.
+func (m *MachineScope) IsAdoptingInstance() bool {
+	return m.AWSMachine.Spec.InstanceID != nil && *m.AWSMachine.Spec.InstanceID != "" && m.GetProviderID() == ""
+}
+
 // GetInstanceState returns the AWSMachine instance state from the status.
 func (m *MachineScope) GetInstanceState() *infrav1.InstanceState {
 	return m.AWSMachine.Status.InstanceState
@@ -162,6 +191,78 @@ func (m *MachineScope) SetInstanceState(v infrav1.InstanceState) {
 	m.AWSMachine.Status.InstanceState = &v
 }
 
+// maxLaunchTemplateNameLength is the maximum length of the name of an EC2 launch template.
+const maxLaunchTemplateNameLength = 128
+
+// LaunchTemplateName returns the name of the launch template of the AWSMachine. Launch template names are
+// unique per account and region, so the name is qualified with the namespace and the cluster, and shortened
+// with a hash if it gets too long.
+func (m *MachineScope) LaunchTemplateName() string {
+	name := fmt.Sprintf("%s/%s/%s", m.Namespace(), m.Cluster.Name, m.Name())
+	if len(name) <= maxLaunchTemplateNameLength {
+		return name
+	}
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(name)))[:16]
+	return name[:maxLaunchTemplateNameLength-len(hash)-1] + "-" + hash
+}
+
+// GetLaunchTemplate returns the launch template the AWSMachine instance is created from,
+// built from the AWSMachine spec.
+// Additional security groups are not part of it as they are resolved and passed together with
+// the other settings specific to the instance when it is created.
+func (m *MachineScope) GetLaunchTemplate() *expinfrav1.AWSLaunchTemplate {
+	spec := m.AWSMachine.Spec
+	return &expinfrav1.AWSLaunchTemplate{
+		Name:                  m.Name(),
+		IamInstanceProfile:    spec.IAMInstanceProfile,
+		AMI:                   spec.AMI,
+		InstanceType:          spec.InstanceType,
+		RootVolume:            spec.RootVolume.DeepCopy(),
+		SSHKeyName:            spec.SSHKeyName,
+		CPUOptions:            spec.CPUOptions,
+		HibernationOptions:    spec.HibernationOptions,
+		EnclaveOptions:        spec.EnclaveOptions,
+		BootMode:              spec.BootMode,
+		NetworkInterfaceSpecs: spec.NetworkInterfaceSpecs,
+		PrivateDNSName:        spec.PrivateDNSName,
+		Tenancy:               spec.Tenancy,
+		HostID:                spec.HostID,
+		HostResourceGroupArn:  spec.HostResourceGroupArn,
+		HostAffinity:          spec.HostAffinity,
+		DisableAPITermination: pointer.Bool(m.DisableAPITermination()),
+		DisableAPIStop:        pointer.Bool(m.DisableAPIStop()),
+	}
+}
+
+// GetLaunchTemplateIDStatus returns the AWSMachine LaunchTemplateID status.
+func (m *MachineScope) GetLaunchTemplateIDStatus() string {
+	return m.AWSMachine.Status.LaunchTemplateID
+}
+
+// SetLaunchTemplateIDStatus sets the AWSMachine LaunchTemplateID status.
+func (m *MachineScope) SetLaunchTemplateIDStatus(id string) {
+	m.AWSMachine.Status.LaunchTemplateID = id
+}
+
+// SetLaunchTemplateVersionStatus sets the AWSMachine LaunchTemplateVersion status.
+func (m *MachineScope) SetLaunchTemplateVersionStatus(version string) {
+	m.AWSMachine.Status.LaunchTemplateVersion = version
+}
+
+// GetRetainedVolumeIDStatus returns the recorded ID of the retained volume with the given name.
+func (m *MachineScope) GetRetainedVolumeIDStatus(name string) string {
+	return m.AWSMachine.Status.RetainedVolumeIDs[name]
+}
+
+// SetRetainedVolumeIDStatus records the ID of the retained volume with the given name.
+func (m *MachineScope) SetRetainedVolumeIDStatus(name, id string) {
+	if m.AWSMachine.Status.RetainedVolumeIDs == nil {
+		m.AWSMachine.Status.RetainedVolumeIDs = map[string]string{}
+	}
+	m.AWSMachine.Status.RetainedVolumeIDs[name] = id
+}
+
 // SetReady sets the AWSMachine Ready Status.
 func (m *MachineScope) SetReady() {
 	m.AWSMachine.Status.Ready = true
@@ -197,7 +298,13 @@ func (m *MachineScope) UseSecretsManager(userDataFormat string) bool {
 }
 
 func (m *MachineScope) UseIgnition(userDataFormat string) bool {
-	return userDataFormat == "ignition" || (m.AWSMachine.Spec.Ignition != nil)
+	return userDataFormat == string(infrav1.IgnitionBootstrapDataFormat) || (m.AWSMachine.Spec.Ignition != nil)
+}
+
+// UseWindows returns true if the bootstrap data is a PowerShell script
+// to be run by EC2Launch v2 on a Windows instance.
+func (m *MachineScope) UseWindows(userDataFormat string) bool {
+	return userDataFormat == string(infrav1.PowerShellBootstrapDataFormat)
 }
 
 // SecureSecretsBackend returns the chosen secret backend.
@@ -208,13 +315,23 @@ func (m *MachineScope) SecureSecretsBackend() infrav1.SecretBackend {
 // CompressUserData returns the computed value of whether or not
 // userdata should be compressed using gzip.
 func (m *MachineScope) CompressUserData(userDataFormat string) bool {
-	if m.UseIgnition(userDataFormat) {
+	// Neither Ignition nor EC2Launch decompress userdata.
+	if m.UseIgnition(userDataFormat) || m.UseWindows(userDataFormat) {
 		return false
 	}
 
 	return m.AWSMachine.Spec.UncompressedUserData != nil && !*m.AWSMachine.Spec.UncompressedUserData
 }
 
+// SecretsKMSKeyARN returns the ARN of the KMS key encrypting the secrets belonging to the AWSMachine,
+// or an empty string to use the AWS managed key of the secret backend.
+func (m *MachineScope) SecretsKMSKeyARN() string {
+	if m.AWSMachine.Spec.CloudInit.KMSKeyARN != "" {
+		return m.AWSMachine.Spec.CloudInit.KMSKeyARN
+	}
+	return m.InfraCluster.BootstrapSecretsKMSKeyARN()
+}
+
 // GetSecretPrefix returns the prefix for the secrets belonging
 // to the AWSMachine in AWS Secrets Manager.
 func (m *MachineScope) GetSecretPrefix() string {
@@ -245,6 +362,38 @@ func (m *MachineScope) SetSecretCount(i int32) {
 	m.AWSMachine.Spec.CloudInit.SecretCount = i
 }
 
+// PrivateDNSName returns the private DNS name options of the AWSMachine instance in the given subnet.
+// Options which are not set in the AWSMachine spec default to the options of the subnet, if it is managed.
+// EKS nodes use IP-based hostnames in subnets defaulting to resource-based hostnames, as this is the node
+// name expected by EKS. Resource-based hostnames set in the AWSMachine spec of EKS nodes are rejected when
+// the instance is created.
+func (m *MachineScope) PrivateDNSName(subnetID string) *infrav1.PrivateDNSName {
+	var options *infrav1.PrivateDNSName
+	if subnet := m.InfraCluster.Subnets().FindByID(subnetID); subnet != nil && subnet.PrivateDNSNameOptionsOnLaunch != nil {
+		options = subnet.PrivateDNSNameOptionsOnLaunch.DeepCopy()
+		if m.IsEKSManaged() && options.HostnameType == infrav1.HostnameTypeResourceName {
+			options.HostnameType = infrav1.HostnameTypeIPName
+		}
+	}
+
+	if spec := m.AWSMachine.Spec.PrivateDNSName; spec != nil {
+		if options == nil {
+			options = &infrav1.PrivateDNSName{}
+		}
+		if spec.HostnameType != "" {
+			options.HostnameType = spec.HostnameType
+		}
+		if spec.EnableResourceNameDNSARecord != nil {
+			options.EnableResourceNameDNSARecord = spec.EnableResourceNameDNSARecord
+		}
+		if spec.EnableResourceNameDNSAAAARecord != nil {
+			options.EnableResourceNameDNSAAAARecord = spec.EnableResourceNameDNSAAAARecord
+		}
+	}
+
+	return options
+}
+
 // SetAddresses sets the AWSMachine address status.
 func (m *MachineScope) SetAddresses(addrs []clusterv1.MachineAddress) {
 	m.AWSMachine.Status.Addresses = addrs
@@ -285,6 +434,118 @@ func (m *MachineScope) GetRawBootstrapDataWithFormat() ([]byte, string, error) {
 	return value, string(secret.Data["format"]), nil
 }
 
+// GetIgnitionSnippets returns the Ignition snippets of the AWSMachine in order, skipping optional
+// snippets whose Secret, ConfigMap or key does not exist.
+func (m *MachineScope) GetIgnitionSnippets() ([][]byte, error) {
+	if m.AWSMachine.Spec.Ignition == nil {
+		return nil, nil
+	}
+
+	snippets := make([][]byte, 0, len(m.AWSMachine.Spec.Ignition.Snippets))
+	for _, snippet := range m.AWSMachine.Spec.Ignition.Snippets {
+		var (
+			data     []byte
+			found    bool
+			optional *bool
+			source   string
+			err      error
+		)
+
+		switch {
+		case snippet.Secret != nil:
+			optional = snippet.Secret.Optional
+			source = fmt.Sprintf("key %q of secret %s/%s", snippet.Secret.Key, m.Namespace(), snippet.Secret.Name)
+			data, found, err = m.getSecretKey(snippet.Secret)
+		case snippet.ConfigMap != nil:
+			optional = snippet.ConfigMap.Optional
+			source = fmt.Sprintf("key %q of config map %s/%s", snippet.ConfigMap.Key, m.Namespace(), snippet.ConfigMap.Name)
+			data, found, err = m.getIgnitionSnippetFromConfigMap(snippet.ConfigMap)
+		default:
+			return nil, errors.New("error retrieving Ignition snippet: neither secret nor configMap is set")
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			if optional != nil && *optional {
+				continue
+			}
+			return nil, errors.Errorf("error retrieving Ignition snippet: %s not found", source)
+		}
+
+		snippets = append(snippets, data)
+	}
+
+	return snippets, nil
+}
+
+// GetCloudInitAdditionalParts returns the additional cloud-init userdata parts of the AWSMachine in order,
+// skipping optional parts whose Secret or key does not exist.
+func (m *MachineScope) GetCloudInitAdditionalParts() ([]mime.Part, error) {
+	parts := make([]mime.Part, 0, len(m.AWSMachine.Spec.CloudInit.AdditionalParts))
+	for _, part := range m.AWSMachine.Spec.CloudInit.AdditionalParts {
+		content := []byte(part.Data)
+
+		if part.Secret != nil {
+			data, found, err := m.getSecretKey(part.Secret)
+			if err != nil {
+				return nil, err
+			}
+
+			if !found {
+				if part.Secret.Optional != nil && *part.Secret.Optional {
+					continue
+				}
+				return nil, errors.Errorf("error retrieving cloud-init part %s: key %q of secret %s/%s not found",
+					part.Name, part.Secret.Key, m.Namespace(), part.Secret.Name)
+			}
+
+			content = data
+		}
+
+		parts = append(parts, mime.Part{
+			Filename:    part.Name,
+			ContentType: string(part.ContentType),
+			Content:     content,
+		})
+	}
+
+	return parts, nil
+}
+
+func (m *MachineScope) getSecretKey(selector *corev1.SecretKeySelector) ([]byte, bool, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: m.Namespace(), Name: selector.Name}
+	if err := m.client.Get(context.TODO(), key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrapf(err, "failed to retrieve secret %s/%s", key.Namespace, key.Name)
+	}
+
+	value, ok := secret.Data[selector.Key]
+	return value, ok, nil
+}
+
+func (m *MachineScope) getIgnitionSnippetFromConfigMap(selector *corev1.ConfigMapKeySelector) ([]byte, bool, error) {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: m.Namespace(), Name: selector.Name}
+	if err := m.client.Get(context.TODO(), key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrapf(err, "failed to retrieve Ignition snippet config map %s/%s", key.Namespace, key.Name)
+	}
+
+	if value, ok := configMap.Data[selector.Key]; ok {
+		return []byte(value), true, nil
+	}
+	value, ok := configMap.BinaryData[selector.Key]
+	return value, ok, nil
+}
+
 // PatchObject persists the machine spec and status.
 func (m *MachineScope) PatchObject() error {
 	// Always update the readyCondition by summarizing the state of other conditions.
@@ -310,6 +571,9 @@ func (m *MachineScope) PatchObject() error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			clusterv1.ReadyCondition,
 			infrav1.InstanceReadyCondition,
+			infrav1.InstanceReachableCondition,
+			infrav1.NoScheduledEventsCondition,
+			infrav1.InstanceAdoptedCondition,
 			infrav1.SecurityGroupsReadyCondition,
 			infrav1.ELBAttachedCondition,
 		}})
@@ -18,6 +18,7 @@ package scope
 
 import (
 	"encoding/base64"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -284,6 +285,30 @@ func Test_UseIgnition(t *testing.T) {
 	})
 }
 
+func Test_UseWindows(t *testing.T) {
+	t.Run("returns_true_when_given_bootstrap_data_format_is_powershell", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !scope.UseWindows("powershell") {
+			t.Fatalf("UseWindows should be true")
+		}
+	})
+
+	t.Run("returns_false_when_given_bootstrap_data_format_is_cloud_config", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if scope.UseWindows("cloud-config") {
+			t.Fatalf("UseWindows should be false")
+		}
+	})
+}
+
 func Test_CompressUserData(t *testing.T) {
 	// Ignition does not support compressed data in S3.
 	t.Run("returns_false_when_bootstrap_data_is_in_ignition_format", func(t *testing.T) {
@@ -296,6 +321,19 @@ func Test_CompressUserData(t *testing.T) {
 			t.Fatalf("User data would be compressed despite Ignition format")
 		}
 	})
+
+	// EC2Launch does not decompress user data.
+	t.Run("returns_false_when_bootstrap_data_is_in_powershell_format", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		scope.AWSMachine.Spec.UncompressedUserData = pointer.BoolPtr(false)
+		if scope.CompressUserData("powershell") {
+			t.Fatalf("User data would be compressed despite PowerShell format")
+		}
+	})
 }
 
 func TestGetSecretARNDefaultIsNil(t *testing.T) {
@@ -342,3 +380,66 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatalf("Expected providerID %s, got %s", expectedProviderID, providerID)
 	}
 }
+
+func TestLaunchTemplateName(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name := scope.LaunchTemplateName(); name != "default/my-cluster/my-machine-0" {
+		t.Fatalf("Expected the launch template name to be qualified with the namespace and cluster, got %s", name)
+	}
+
+	scope.AWSMachine.Name = strings.Repeat("a", 200)
+	name := scope.LaunchTemplateName()
+	if len(name) != maxLaunchTemplateNameLength {
+		t.Fatalf("Expected the launch template name to be shortened to %d characters, got %d", maxLaunchTemplateNameLength, len(name))
+	}
+
+	scope.AWSMachine.Name = strings.Repeat("a", 199) + "b"
+	if other := scope.LaunchTemplateName(); other == name {
+		t.Fatalf("Expected shortened launch template names to differ, got %s twice", name)
+	}
+}
+
+func TestPrivateDNSName(t *testing.T) {
+	t.Run("returns_nil_when_no_options_are_set", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if options := scope.PrivateDNSName("subnet-1"); options != nil {
+			t.Fatalf("Expected no private DNS name options, got %+v", options)
+		}
+	})
+
+	t.Run("defaults_to_the_subnet_options", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		scope.InfraCluster.(*ClusterScope).AWSCluster.Spec.NetworkSpec.Subnets = infrav1.Subnets{
+			{
+				ID: "subnet-1",
+				PrivateDNSNameOptionsOnLaunch: &infrav1.PrivateDNSName{
+					HostnameType:                 infrav1.HostnameTypeResourceName,
+					EnableResourceNameDNSARecord: pointer.BoolPtr(true),
+				},
+			},
+		}
+		scope.AWSMachine.Spec.PrivateDNSName = &infrav1.PrivateDNSName{
+			EnableResourceNameDNSARecord: pointer.BoolPtr(false),
+		}
+
+		options := scope.PrivateDNSName("subnet-1")
+		if options == nil || options.HostnameType != infrav1.HostnameTypeResourceName || pointer.BoolDeref(options.EnableResourceNameDNSARecord, true) {
+			t.Fatalf("Expected resource-based hostnames without A record, got %+v", options)
+		}
+		if options := scope.PrivateDNSName("subnet-2"); options == nil || options.HostnameType != "" {
+			t.Fatalf("Expected only the AWSMachine options for another subnet, got %+v", options)
+		}
+	})
+}
@@ -220,6 +220,21 @@ func (m *MachinePoolScope) SetASGStatus(v expinfrav1.ASGStatus) {
 	m.AWSMachinePool.Status.ASGStatus = &v
 }
 
+// LaunchTemplateName returns the name of the launch template, which is the name of the AWSMachinePool.
+func (m *MachinePoolScope) LaunchTemplateName() string {
+	return m.Name()
+}
+
+// GetLaunchTemplate returns the AWSMachinePool launch template.
+func (m *MachinePoolScope) GetLaunchTemplate() *expinfrav1.AWSLaunchTemplate {
+	return &m.AWSMachinePool.Spec.AWSLaunchTemplate
+}
+
+// GetLaunchTemplateIDStatus returns the AWSMachinePool LaunchTemplateID status.
+func (m *MachinePoolScope) GetLaunchTemplateIDStatus() string {
+	return m.AWSMachinePool.Status.LaunchTemplateID
+}
+
 // SetLaunchTemplateIDStatus sets the AWSMachinePool LaunchTemplateID status.
 func (m *MachinePoolScope) SetLaunchTemplateIDStatus(id string) {
 	m.AWSMachinePool.Status.LaunchTemplateID = id
@@ -89,6 +89,7 @@ func NewManagedControlPlaneScope(params ManagedControlPlaneScopeParams) (*Manage
 		controllerName:       params.ControllerName,
 		allowAdditionalRoles: params.AllowAdditionalRoles,
 		enableIAM:            params.EnableIAM,
+		endpoints:            params.Endpoints,
 	}
 	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, managedScope, params.ControlPlane.Spec.Region, params.Endpoints, *params.Logger)
 	if err != nil {
@@ -122,6 +123,7 @@ type ManagedControlPlaneScope struct {
 
 	enableIAM            bool
 	allowAdditionalRoles bool
+	endpoints            []ServiceEndpoint
 }
 
 // RemoteClient returns the Kubernetes client for connecting to the workload cluster.
@@ -346,6 +348,45 @@ func (s *ManagedControlPlaneScope) ImageLookupBaseOS() string {
 	return s.ControlPlane.Spec.ImageLookupBaseOS
 }
 
+// DedicatedHosts returns the dedicated hosts to allocate for the cluster. Allocating
+// dedicated hosts is not supported for managed control planes.
+func (s *ManagedControlPlaneScope) DedicatedHosts() []infrav1.DedicatedHostSpec {
+	return nil
+}
+
+// ControlPlaneDisableAPITermination returns whether control plane instances have termination protection by default.
+// Managed control planes do not run on instances of the cluster.
+func (s *ManagedControlPlaneScope) ControlPlaneDisableAPITermination() bool {
+	return false
+}
+
+// ControlPlaneDisableAPIStop returns whether control plane instances have stop protection by default.
+// Managed control planes do not run on instances of the cluster.
+func (s *ManagedControlPlaneScope) ControlPlaneDisableAPIStop() bool {
+	return false
+}
+
+// BootstrapSecretsKMSKeyARN returns the ARN of the KMS key encrypting the bootstrap data secrets by default.
+// Managed control planes do not set a default, so the AWS managed key of the secret backend is used.
+func (s *ManagedControlPlaneScope) BootstrapSecretsKMSKeyARN() string {
+	return ""
+}
+
+// Proxy returns the HTTP proxy of the instances of the cluster.
+func (s *ManagedControlPlaneScope) Proxy() *infrav1.ProxyConfig {
+	return s.ControlPlane.Spec.Proxy
+}
+
+// AdditionalTrustBundle returns the PEM encoded CA certificates the instances of the cluster trust additionally.
+func (s *ManagedControlPlaneScope) AdditionalTrustBundle() string {
+	return s.ControlPlane.Spec.AdditionalTrustBundle
+}
+
+// NoProxy returns the CIDR blocks and endpoints of the cluster which the instances reach without the HTTP proxy.
+func (s *ManagedControlPlaneScope) NoProxy() []string {
+	return NoProxy(s.VPC(), s.SecondaryCidrBlock(), s.ControlPlane.Spec.ControlPlaneEndpoint, s.endpoints)
+}
+
 // IAMAuthConfig returns the IAM authenticator config. The returned value will never be nil.
 func (s *ManagedControlPlaneScope) IAMAuthConfig() *ekscontrolplanev1.IAMAuthenticatorConfig {
 	if s.ControlPlane.Spec.IAMAuthenticatorConfig == nil {
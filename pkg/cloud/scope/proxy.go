@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// NoProxy returns the CIDR blocks of the VPC, the API server endpoint and the custom AWS service
+// endpoints, which the instances of a cluster reach without the HTTP proxy.
+func NoProxy(vpc *infrav1.VPCSpec, secondaryCidrBlock *string, controlPlaneEndpoint clusterv1.APIEndpoint, endpoints []ServiceEndpoint) []string {
+	var hosts []string
+
+	if vpc != nil && vpc.CidrBlock != "" {
+		hosts = append(hosts, vpc.CidrBlock)
+	}
+
+	if secondaryCidrBlock != nil && *secondaryCidrBlock != "" {
+		hosts = append(hosts, *secondaryCidrBlock)
+	}
+
+	if controlPlaneEndpoint.Host != "" {
+		hosts = append(hosts, controlPlaneEndpoint.Host)
+	}
+
+	for _, endpoint := range endpoints {
+		hosts = append(hosts, endpoint.URL)
+	}
+
+	return hosts
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+)
+
+func TestNoProxy(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		vpc                  *infrav1.VPCSpec
+		secondaryCidrBlock   *string
+		controlPlaneEndpoint clusterv1.APIEndpoint
+		endpoints            []ServiceEndpoint
+		expected             []string
+	}{
+		{
+			name:     "nothing known yet",
+			vpc:      &infrav1.VPCSpec{},
+			expected: nil,
+		},
+		{
+			name:                 "vpc, api server and service endpoints",
+			vpc:                  &infrav1.VPCSpec{CidrBlock: "10.0.0.0/16"},
+			secondaryCidrBlock:   pointer.String("100.64.0.0/16"),
+			controlPlaneEndpoint: clusterv1.APIEndpoint{Host: "api.example.com", Port: 6443},
+			endpoints: []ServiceEndpoint{
+				{ServiceID: "secretsmanager", URL: "https://secretsmanager.example.com", SigningRegion: "us-east-1"},
+				{ServiceID: "ssm", URL: "https://ssm.example.com:8443/", SigningRegion: "us-east-1"},
+			},
+			expected: []string{"10.0.0.0/16", "100.64.0.0/16", "api.example.com", "https://secretsmanager.example.com", "https://ssm.example.com:8443/"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(NoProxy(tc.vpc, tc.secondaryCidrBlock, tc.controlPlaneEndpoint, tc.endpoints)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// AdoptInstance adopts the existing instance referenced by Spec.InstanceID of the AWSMachine.
+// The instance is validated against the AWSMachine spec and, if it matches, tagged as owned by the cluster.
+// The instance itself is never modified otherwise. If the instance does not match the spec, the
+// InstanceAdopted condition lists the mismatches and a nil instance is returned.
+func (s *Service) AdoptInstance(scope *scope.MachineScope) (*infrav1.Instance, error) {
+	instanceID := aws.StringValue(scope.AWSMachine.Spec.InstanceID)
+	s.scope.V(2).Info("Adopting existing instance", "instance-id", instanceID)
+
+	instance, err := s.InstanceIfExists(aws.String(instanceID))
+	if err != nil {
+		conditions.MarkFalse(scope.AWSMachine, infrav1.InstanceAdoptedCondition, infrav1.InstanceAdoptionFailedReason, clusterv1.ConditionSeverityError,
+			"failed to describe instance %q: %v", instanceID, err)
+		return nil, errors.Wrapf(err, "failed to describe instance %q to adopt", instanceID)
+	}
+
+	mismatches, err := s.adoptionMismatches(scope, instance)
+	if err != nil {
+		conditions.MarkFalse(scope.AWSMachine, infrav1.InstanceAdoptedCondition, infrav1.InstanceAdoptionFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return nil, err
+	}
+	if len(mismatches) > 0 {
+		message := strings.Join(mismatches, "; ")
+		if conditions.GetMessage(scope.AWSMachine, infrav1.InstanceAdoptedCondition) != message {
+			record.Warnf(scope.AWSMachine, infrav1.InstanceAdoptionMismatchReason, "Cannot adopt instance %q: %s", instanceID, message)
+		}
+		conditions.MarkFalse(scope.AWSMachine, infrav1.InstanceAdoptedCondition, infrav1.InstanceAdoptionMismatchReason, clusterv1.ConditionSeverityError, "%s", message)
+		return nil, nil
+	}
+
+	// Apply the tags CAPA sets on the instances it launches, so the instance is found and cleaned up like any other.
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.KubernetesClusterName(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(scope.Name()),
+		Role:        aws.String(scope.Role()),
+		Additional:  scope.AdditionalTags(),
+	}.WithCloudProvider(s.scope.KubernetesClusterName()).WithMachineName(scope.Machine))
+	create := map[string]string{}
+	for key, value := range tags {
+		if instance.Tags[key] != value {
+			create[key] = value
+		}
+	}
+	if err := s.UpdateResourceTags(aws.String(instance.ID), create, nil); err != nil {
+		conditions.MarkFalse(scope.AWSMachine, infrav1.InstanceAdoptedCondition, infrav1.InstanceAdoptionFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return nil, errors.Wrapf(err, "failed to tag adopted instance %q", instance.ID)
+	}
+	if instance.Tags == nil {
+		instance.Tags = infrav1.Tags{}
+	}
+	for key, value := range create {
+		instance.Tags[key] = value
+	}
+
+	record.Eventf(scope.AWSMachine, "SuccessfulAdoptInstance", "Adopted instance %q", instance.ID)
+	conditions.MarkTrue(scope.AWSMachine, infrav1.InstanceAdoptedCondition)
+
+	return instance, nil
+}
+
+// adoptionMismatches returns the differences between the instance to adopt and the AWSMachine spec.
+func (s *Service) adoptionMismatches(scope *scope.MachineScope, instance *infrav1.Instance) ([]string, error) {
+	var mismatches []string
+
+	switch instance.State {
+	case infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated:
+		mismatches = append(mismatches, fmt.Sprintf("instance is %s", instance.State))
+	}
+
+	for key, value := range instance.Tags {
+		if strings.HasPrefix(key, infrav1.NameAWSProviderOwned) && key != infrav1.ClusterTagKey(s.scope.KubernetesClusterName()) {
+			mismatches = append(mismatches, fmt.Sprintf("instance is %s by cluster %q", value, strings.TrimPrefix(key, infrav1.NameAWSProviderOwned)))
+		}
+	}
+
+	if instance.Type != scope.AWSMachine.Spec.InstanceType {
+		mismatches = append(mismatches, fmt.Sprintf("instance type is %q, expected %q", instance.Type, scope.AWSMachine.Spec.InstanceType))
+	}
+
+	// AMIs resolved through a lookup may have changed since the instance was launched, only explicit IDs are compared.
+	if id := scope.AWSMachine.Spec.AMI.ID; id != nil && instance.ImageID != *id {
+		mismatches = append(mismatches, fmt.Sprintf("image is %q, expected %q", instance.ImageID, *id))
+	}
+
+	subnetMismatch, err := s.adoptionSubnetMismatch(scope, instance)
+	if err != nil {
+		return nil, err
+	}
+	if subnetMismatch != "" {
+		mismatches = append(mismatches, subnetMismatch)
+	}
+
+	// The core security groups are attached by the regular reconciliation once the instance is adopted.
+	for _, sg := range scope.AWSMachine.Spec.AdditionalSecurityGroups {
+		id := aws.StringValue(sg.ID)
+		if sg.Filters != nil {
+			id, err = s.GetFilteredSecurityGroupID(sg)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to resolve additional security group")
+			}
+		}
+		if id != "" && !containsGroup(instance.SecurityGroupIDs, id) {
+			mismatches = append(mismatches, fmt.Sprintf("additional security group %q is not attached", id))
+		}
+	}
+
+	return mismatches, nil
+}
+
+// adoptionSubnetMismatch checks that the instance runs in the subnet the AWSMachine would have been launched in.
+func (s *Service) adoptionSubnetMismatch(scope *scope.MachineScope, instance *infrav1.Instance) (string, error) {
+	failureDomain := scope.Machine.Spec.FailureDomain
+	if failureDomain == nil {
+		failureDomain = scope.AWSMachine.Spec.FailureDomain
+	}
+	if failureDomain != nil && instance.AvailabilityZone != *failureDomain {
+		return fmt.Sprintf("availability zone is %q, expected failure domain %q", instance.AvailabilityZone, *failureDomain), nil
+	}
+
+	subnet := scope.AWSMachine.Spec.Subnet
+	switch {
+	case subnet != nil && subnet.ID != nil:
+		if instance.SubnetID != *subnet.ID {
+			return fmt.Sprintf("subnet is %q, expected %q", instance.SubnetID, *subnet.ID), nil
+		}
+	case subnet != nil && subnet.Filters != nil:
+		criteria := []*ec2.Filter{
+			{Name: aws.String("subnet-id"), Values: aws.StringSlice([]string{instance.SubnetID})},
+		}
+		for _, f := range subnet.Filters {
+			criteria = append(criteria, &ec2.Filter{Name: aws.String(f.Name), Values: aws.StringSlice(f.Values)})
+		}
+		subnets, err := s.getFilteredSubnets(criteria...)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to filter subnets for criteria %q", criteria)
+		}
+		if len(subnets) == 0 {
+			return fmt.Sprintf("subnet %q does not match the subnet filters", instance.SubnetID), nil
+		}
+	case !scope.IsExternallyManaged():
+		if s.scope.Subnets().FindByID(instance.SubnetID) == nil {
+			return fmt.Sprintf("subnet %q is not part of the cluster network", instance.SubnetID), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestAdoptInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instance := func(modify func(*ec2.Instance)) *ec2.Instance {
+		i := &ec2.Instance{
+			InstanceId:     aws.String("i-adopted"),
+			InstanceType:   aws.String("m5.large"),
+			ImageId:        aws.String("ami-1"),
+			SubnetId:       aws.String("subnet-1"),
+			State:          &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
+			Placement:      &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+			SecurityGroups: []*ec2.GroupIdentifier{{GroupId: aws.String("sg-1")}},
+			Tags:           []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("hand-built")}},
+		}
+		if modify != nil {
+			modify(i)
+		}
+		return i
+	}
+
+	testCases := []struct {
+		name          string
+		spec          infrav1.AWSMachineSpec
+		instance      *ec2.Instance
+		expectAdopted bool
+		expectMessage string
+	}{
+		{
+			name: "adopts and tags a matching instance",
+			spec: infrav1.AWSMachineSpec{
+				InstanceType:             "m5.large",
+				AMI:                      infrav1.AMIReference{ID: aws.String("ami-1")},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-1")}},
+			},
+			instance:      instance(nil),
+			expectAdopted: true,
+		},
+		{
+			name: "adopts an instance in the configured subnet",
+			spec: infrav1.AWSMachineSpec{
+				InstanceType: "m5.large",
+				Subnet:       &infrav1.AWSResourceReference{ID: aws.String("subnet-1")},
+			},
+			instance:      instance(nil),
+			expectAdopted: true,
+		},
+		{
+			name: "reports an instance which does not match the spec",
+			spec: infrav1.AWSMachineSpec{
+				InstanceType:             "m5.xlarge",
+				AMI:                      infrav1.AMIReference{ID: aws.String("ami-2")},
+				Subnet:                   &infrav1.AWSResourceReference{ID: aws.String("subnet-2")},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-2")}},
+			},
+			instance: instance(nil),
+			expectMessage: `instance type is "m5.large", expected "m5.xlarge"; image is "ami-1", expected "ami-2"; ` +
+				`subnet is "subnet-1", expected "subnet-2"; additional security group "sg-2" is not attached`,
+		},
+		{
+			name: "reports an instance outside of the cluster network",
+			spec: infrav1.AWSMachineSpec{InstanceType: "m5.large"},
+			instance: instance(func(i *ec2.Instance) {
+				i.SubnetId = aws.String("subnet-other")
+			}),
+			expectMessage: `subnet "subnet-other" is not part of the cluster network`,
+		},
+		{
+			name: "adopts an instance already owned by the cluster",
+			spec: infrav1.AWSMachineSpec{InstanceType: "m5.large"},
+			instance: instance(func(i *ec2.Instance) {
+				i.Tags = append(i.Tags, &ec2.Tag{Key: aws.String(infrav1.ClusterTagKey("cluster-name")), Value: aws.String("owned")})
+			}),
+			expectAdopted: true,
+		},
+		{
+			name: "reports an instance owned by another cluster",
+			spec: infrav1.AWSMachineSpec{InstanceType: "m5.large"},
+			instance: instance(func(i *ec2.Instance) {
+				i.Tags = append(i.Tags, &ec2.Tag{Key: aws.String(infrav1.ClusterTagKey("other")), Value: aws.String("owned")})
+			}),
+			expectMessage: `instance is owned by cluster "other"`,
+		},
+		{
+			name: "reports a terminated instance",
+			spec: infrav1.AWSMachineSpec{InstanceType: "m5.large"},
+			instance: instance(func(i *ec2.Instance) {
+				i.State.Name = aws.String(ec2.InstanceStateNameTerminated)
+			}),
+			expectMessage: "instance is terminated",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			clusterScope.AWSCluster.Spec.NetworkSpec.Subnets = infrav1.Subnets{{ID: "subnet-1", AvailabilityZone: "us-east-1a"}}
+
+			tc.spec.InstanceID = aws.String("i-adopted")
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      newCluster(),
+				Machine:      &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "default"}},
+				InfraCluster: clusterScope,
+				AWSMachine:   &infrav1.AWSMachine{ObjectMeta: metav1.ObjectMeta{Name: "aws-test1"}, Spec: tc.spec},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ec2Mock.EXPECT().DescribeInstances(gomock.Eq(&ec2.DescribeInstancesInput{InstanceIds: aws.StringSlice([]string{"i-adopted"})})).
+				Return(&ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{tc.instance}}}}, nil)
+			if tc.expectAdopted {
+				ec2Mock.EXPECT().CreateTags(gomock.Any()).
+					DoAndReturn(func(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+						tags := map[string]string{}
+						for _, tag := range append(tc.instance.Tags, input.Tags...) {
+							tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+						}
+						g.Expect(tags).To(HaveKeyWithValue(infrav1.ClusterTagKey("cluster-name"), string(infrav1.ResourceLifecycleOwned)))
+						g.Expect(tags).To(HaveKeyWithValue(infrav1.ClusterAWSCloudProviderTagKey("cluster-name"), string(infrav1.ResourceLifecycleOwned)))
+						g.Expect(tags).To(HaveKeyWithValue(infrav1.MachineNameTagKey, "default/machine-1"))
+						g.Expect(tags).To(HaveKeyWithValue("Name", "aws-test1"))
+						return &ec2.CreateTagsOutput{}, nil
+					})
+			}
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			adopted, err := s.AdoptInstance(machineScope)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			condition := conditions.Get(machineScope.AWSMachine, infrav1.InstanceAdoptedCondition)
+			g.Expect(condition).NotTo(BeNil())
+			if tc.expectAdopted {
+				g.Expect(adopted).NotTo(BeNil())
+				g.Expect(adopted.ID).To(Equal("i-adopted"))
+				g.Expect(condition.Status).To(BeEquivalentTo(metav1.ConditionTrue))
+				return
+			}
+			g.Expect(adopted).To(BeNil())
+			g.Expect(condition.Reason).To(Equal(infrav1.InstanceAdoptionMismatchReason))
+			g.Expect(condition.Message).To(Equal(tc.expectMessage))
+		})
+	}
+}
@@ -67,6 +67,12 @@ const (
 
 	// EKS GPU AMI ID SSM Parameter name.
 	eksGPUAmiSSMParameterFormat = "/aws/service/eks/optimized-ami/%s/amazon-linux-2-gpu/recommended/image_id"
+
+	// EKS arm64 AMI ID SSM Parameter name.
+	eksArm64AmiSSMParameterFormat = "/aws/service/eks/optimized-ami/%s/amazon-linux-2-arm64/recommended/image_id"
+
+	// EKS Windows AMI ID SSM Parameter name, for the Windows Server version, edition (Core or Full) and Kubernetes version.
+	eksWindowsAmiSSMParameterFormat = "/aws/service/ami-windows-latest/Windows_Server-%s-English-%s-EKS_Optimized-%s/image_id"
 )
 
 // AMILookup contains the parameters used to template AMI names used for lookup.
@@ -94,8 +100,8 @@ func GenerateAmiName(amiNameFormat, baseOS, kubernetesVersion string) (string, e
 	return templateBytes.String(), nil
 }
 
-// DefaultAMILookup will do a default AMI lookup.
-func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVersion, amiNameFormat string) (*ec2.Image, error) {
+// DefaultAMILookup will do a default AMI lookup. The architecture defaults to x86_64.
+func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, architecture, kubernetesVersion, amiNameFormat string) (*ec2.Image, error) {
 	if amiNameFormat == "" {
 		amiNameFormat = DefaultAmiNameFormat
 	}
@@ -105,6 +111,9 @@ func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVers
 	if baseOS == "" {
 		baseOS = defaultMachineAMILookupBaseOS
 	}
+	if architecture == "" {
+		architecture = string(infrav1.ArchitectureX86_64)
+	}
 
 	amiName, err := GenerateAmiName(amiNameFormat, baseOS, kubernetesVersion)
 	if err != nil {
@@ -122,7 +131,7 @@ func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVers
 			},
 			{
 				Name:   aws.String("architecture"),
-				Values: []*string{aws.String("x86_64")},
+				Values: []*string{aws.String(architecture)},
 			},
 			{
 				Name:   aws.String("state"),
@@ -140,7 +149,7 @@ func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVers
 		return nil, errors.Wrapf(err, "failed to find ami: %q", amiName)
 	}
 	if len(out.Images) == 0 {
-		return nil, errors.Errorf("found no AMIs with the name: %q", amiName)
+		return nil, errors.Errorf("found no %s AMIs with the name: %q", architecture, amiName)
 	}
 	latestImage, err := GetLatestImage(out.Images)
 	if err != nil {
@@ -151,10 +160,10 @@ func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVers
 }
 
 // defaultAMIIDLookup returns the default AMI based on region.
-func (s *Service) defaultAMIIDLookup(amiNameFormat, ownerID, baseOS, kubernetesVersion string) (string, error) {
-	latestImage, err := DefaultAMILookup(s.EC2Client, ownerID, baseOS, kubernetesVersion, amiNameFormat)
+func (s *Service) defaultAMIIDLookup(amiNameFormat, ownerID, baseOS string, architecture infrav1.Architecture, kubernetesVersion string) (string, error) {
+	latestImage, err := DefaultAMILookup(s.EC2Client, ownerID, baseOS, string(architecture), kubernetesVersion, amiNameFormat)
 	if err != nil {
-		record.Eventf(s.scope.InfraCluster(), "FailedDescribeImages", "Failed to find ami for OS=%s and Kubernetes-version=%s: %v", baseOS, kubernetesVersion, err)
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeImages", "Failed to find ami for OS=%s, architecture=%s and Kubernetes-version=%s: %v", baseOS, architecture, kubernetesVersion, err)
 		return "", errors.Wrapf(err, "failed to find ami")
 	}
 
@@ -234,7 +243,7 @@ func (s *Service) defaultBastionAMILookup() (string, error) {
 	return *latestImage.ImageId, nil
 }
 
-func (s *Service) eksAMILookup(kubernetesVersion string, amiType *infrav1.EKSAMILookupType) (string, error) {
+func (s *Service) eksAMILookup(kubernetesVersion string, architecture infrav1.Architecture, amiType *infrav1.EKSAMILookupType) (string, error) {
 	// format ssm parameter path properly
 	formattedVersion, err := formatVersionForEKS(kubernetesVersion)
 	if err != nil {
@@ -247,9 +256,17 @@ func (s *Service) eksAMILookup(kubernetesVersion string, amiType *infrav1.EKSAMI
 		amiType = new(infrav1.EKSAMILookupType)
 	}
 
-	switch *amiType {
-	case infrav1.AmazonLinuxGPU:
+	switch {
+	case *amiType == infrav1.AmazonLinuxGPU && architecture == infrav1.ArchitectureArm64:
+		return "", errors.Errorf("no EKS optimized GPU AMI is published for the %s architecture", architecture)
+	case amiType.IsWindows() && architecture == infrav1.ArchitectureArm64:
+		return "", errors.Errorf("no EKS optimized Windows AMI is published for the %s architecture", architecture)
+	case amiType.IsWindows():
+		paramName = eksWindowsAMIParameterName(*amiType, formattedVersion)
+	case *amiType == infrav1.AmazonLinuxGPU:
 		paramName = fmt.Sprintf(eksGPUAmiSSMParameterFormat, formattedVersion)
+	case architecture == infrav1.ArchitectureArm64:
+		paramName = fmt.Sprintf(eksArm64AmiSSMParameterFormat, formattedVersion)
 	default:
 		paramName = fmt.Sprintf(eksAmiSSMParameterFormat, formattedVersion)
 	}
@@ -275,6 +292,165 @@ func (s *Service) eksAMILookup(kubernetesVersion string, amiType *infrav1.EKSAMI
 	return id, nil
 }
 
+// eksWindowsAMIParameterName returns the SSM parameter holding the EKS optimized Windows AMI of the lookup type.
+func eksWindowsAMIParameterName(amiType infrav1.EKSAMILookupType, formattedVersion string) string {
+	switch amiType {
+	case infrav1.WindowsFull2019:
+		return fmt.Sprintf(eksWindowsAmiSSMParameterFormat, "2019", "Full", formattedVersion)
+	case infrav1.WindowsCore2022:
+		return fmt.Sprintf(eksWindowsAmiSSMParameterFormat, "2022", "Core", formattedVersion)
+	case infrav1.WindowsFull2022:
+		return fmt.Sprintf(eksWindowsAmiSSMParameterFormat, "2022", "Full", formattedVersion)
+	default:
+		return fmt.Sprintf(eksWindowsAmiSSMParameterFormat, "2019", "Core", formattedVersion)
+	}
+}
+
+// instanceTypeArchitecture returns the architecture of the instance type. Instance types supporting
+// arm64 resolve to arm64, all other instance types, including an empty one, to x86_64.
+func (s *Service) instanceTypeArchitecture(instanceType string) (infrav1.Architecture, error) {
+	if instanceType == "" {
+		return infrav1.ArchitectureX86_64, nil
+	}
+
+	info, err := s.describeInstanceType(instanceType)
+	if err != nil {
+		return "", err
+	}
+
+	if info.ProcessorInfo != nil && containsString(aws.StringValueSlice(info.ProcessorInfo.SupportedArchitectures), ec2.ArchitectureTypeArm64) {
+		return infrav1.ArchitectureArm64, nil
+	}
+	return infrav1.ArchitectureX86_64, nil
+}
+
+// DiscoverMachineAMI resolves the AMI of an AWSMachine spec for the given Kubernetes version,
+// the same way the AMI is picked when an instance is created.
+func (s *Service) DiscoverMachineAMI(spec *infrav1.AWSMachineSpec, kubernetesVersion string) (string, error) {
+	switch {
+	case spec.AMI.ID != nil:
+		return *spec.AMI.ID, nil
+	case usesAMILookup(spec.AMI):
+		return s.amiReferenceLookup(spec.AMI, kubernetesVersion)
+	case kubernetesVersion == "":
+		return "", errors.New("either spec.ami.id or a Kubernetes version must be defined to find an AMI")
+	}
+
+	imageLookupFormat := spec.ImageLookupFormat
+	if imageLookupFormat == "" {
+		imageLookupFormat = s.scope.ImageLookupFormat()
+	}
+
+	imageLookupOrg := spec.ImageLookupOrg
+	if imageLookupOrg == "" {
+		imageLookupOrg = s.scope.ImageLookupOrg()
+	}
+
+	imageLookupBaseOS := spec.ImageLookupBaseOS
+	if imageLookupBaseOS == "" {
+		imageLookupBaseOS = s.scope.ImageLookupBaseOS()
+	}
+
+	arch, err := s.instanceTypeArchitecture(spec.InstanceType)
+	if err != nil {
+		return "", err
+	}
+
+	eksManaged := s.scope.InfraCluster().GetObjectKind().GroupVersionKind().Kind == "AWSManagedControlPlane"
+	if eksManaged && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "" {
+		return s.eksAMILookup(kubernetesVersion, arch, spec.AMI.EKSOptimizedLookupType)
+	}
+	return s.defaultAMIIDLookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, arch, kubernetesVersion)
+}
+
+// usesAMILookup returns true if the AMI is looked up through an SSM parameter or owners and filters.
+func usesAMILookup(ref infrav1.AMIReference) bool {
+	return ref.SSMParameter != nil || len(ref.Owners) > 0 || len(ref.Filters) > 0
+}
+
+// amiReferenceLookup resolves the AMI through the SSM parameter, or the owners and filters, of the AMIReference.
+func (s *Service) amiReferenceLookup(ref infrav1.AMIReference, kubernetesVersion string) (string, error) {
+	if ref.SSMParameter != nil {
+		return s.ssmParameterAMILookup(*ref.SSMParameter, kubernetesVersion)
+	}
+	return s.filteredAMILookup(ref.Owners, ref.Filters)
+}
+
+// ssmParameterAMILookup returns the AMI ID stored in the SSM parameter. The name of the parameter
+// may reference the major and minor Kubernetes version as {{.K8sVersion}}.
+func (s *Service) ssmParameterAMILookup(paramFormat, kubernetesVersion string) (string, error) {
+	params := AMILookup{}
+	if kubernetesVersion != "" {
+		formattedVersion, err := formatVersionForEKS(kubernetesVersion)
+		if err != nil {
+			return "", err
+		}
+		params.K8sVersion = formattedVersion
+	}
+
+	tmpl, err := template.New("ssmParameter").Option("missingkey=error").Parse(paramFormat)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed create template from string: %q", paramFormat)
+	}
+	var paramName bytes.Buffer
+	if err := tmpl.Execute(&paramName, params); err != nil {
+		return "", errors.Wrapf(err, "failed to substitute string: %q", paramFormat)
+	}
+	if strings.Contains(paramFormat, "K8sVersion") && params.K8sVersion == "" {
+		return "", errors.Errorf("SSM parameter %q requires a Kubernetes version", paramFormat)
+	}
+
+	out, err := s.SSMClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(paramName.String()),
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedGetParameter", "Failed to get ami SSM parameter %q: %v", paramName.String(), err)
+		return "", errors.Wrapf(err, "failed to get ami SSM parameter: %q", paramName.String())
+	}
+
+	if out.Parameter == nil || out.Parameter.Value == nil {
+		return "", errors.Errorf("SSM parameter returned with nil value: %q", paramName.String())
+	}
+
+	id := aws.StringValue(out.Parameter.Value)
+	s.scope.V(2).Info("Found AMI in SSM parameter", "ami-id", id, "parameter", paramName.String())
+	return id, nil
+}
+
+// filteredAMILookup returns the most recent available AMI of the owners matching all filters.
+func (s *Service) filteredAMILookup(owners []string, filters []infrav1.Filter) (string, error) {
+	input := &ec2.DescribeImagesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String("available")},
+			},
+		},
+	}
+	if len(owners) > 0 {
+		input.Owners = aws.StringSlice(owners)
+	}
+	for _, f := range filters {
+		input.Filters = append(input.Filters, &ec2.Filter{Name: aws.String(f.Name), Values: aws.StringSlice(f.Values)})
+	}
+
+	out, err := s.EC2Client.DescribeImages(input)
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeImages", "Failed to find ami for owners %v and filters %v: %v", owners, filters, err)
+		return "", errors.Wrapf(err, "failed to describe images for owners %v and filters %v", owners, filters)
+	}
+	if len(out.Images) == 0 {
+		return "", errors.Errorf("found no AMIs for owners %v and filters %v", owners, filters)
+	}
+	latestImage, err := GetLatestImage(out.Images)
+	if err != nil {
+		return "", err
+	}
+
+	s.scope.V(2).Info("Found and using an existing AMI", "ami-id", aws.StringValue(latestImage.ImageId))
+	return aws.StringValue(latestImage.ImageId), nil
+}
+
 func formatVersionForEKS(version string) (string, error) {
 	parsed, err := semver.ParseTolerant(version)
 	if err != nil {
@@ -39,6 +39,7 @@ func Test_DefaultAMILookup(t *testing.T) {
 	type args struct {
 		ownerID           string
 		baseOS            string
+		architecture      string
 		kubernetesVersion string
 		amiNameFormat     string
 	}
@@ -81,6 +82,53 @@ func Test_DefaultAMILookup(t *testing.T) {
 				g.Expect(*img.ImageId).Should(ContainSubstring("latest"))
 			},
 		},
+		{
+			name: "Should look up AMIs of the requested architecture",
+			args: args{
+				ownerID:           "ownerID",
+				baseOS:            "baseOS",
+				architecture:      "arm64",
+				kubernetesVersion: "v1.0.0",
+				amiNameFormat:     "ami-name",
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.Eq(&ec2.DescribeImagesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("owner-id"),
+							Values: []*string{aws.String("ownerID")},
+						},
+						{
+							Name:   aws.String("name"),
+							Values: []*string{aws.String("ami-name")},
+						},
+						{
+							Name:   aws.String("architecture"),
+							Values: []*string{aws.String("arm64")},
+						},
+						{
+							Name:   aws.String("state"),
+							Values: []*string{aws.String("available")},
+						},
+						{
+							Name:   aws.String("virtualization-type"),
+							Values: []*string{aws.String("hvm")},
+						},
+					},
+				})).Return(&ec2.DescribeImagesOutput{
+					Images: []*ec2.Image{
+						{
+							ImageId:      aws.String("graviton"),
+							CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+						},
+					},
+				}, nil)
+			},
+			check: func(g *WithT, img *ec2.Image, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(*img.ImageId).Should(Equal("graviton"))
+			},
+		},
 		{
 			name: "Should return with error if AWS DescribeImages call failed with some error",
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
@@ -112,7 +160,7 @@ func Test_DefaultAMILookup(t *testing.T) {
 			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
 			tc.expect(ec2Mock.EXPECT())
 
-			img, err := DefaultAMILookup(ec2Mock, tc.args.ownerID, tc.args.baseOS, tc.args.kubernetesVersion, tc.args.amiNameFormat)
+			img, err := DefaultAMILookup(ec2Mock, tc.args.ownerID, tc.args.baseOS, tc.args.architecture, tc.args.kubernetesVersion, tc.args.amiNameFormat)
 			tc.check(g, img, err)
 		})
 	}
@@ -198,7 +246,7 @@ func TestAMIs(t *testing.T) {
 			s := NewService(clusterScope)
 			s.EC2Client = ec2Mock
 
-			id, err := s.defaultAMIIDLookup("", "", "base os-baseos version", "v1.11.1")
+			id, err := s.defaultAMIIDLookup("", "", "base os-baseos version", infrav1.ArchitectureX86_64, "v1.11.1")
 			tc.check(g, id, err)
 		})
 	}
@@ -381,9 +429,11 @@ func TestEKSAMILookUp(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	gpuAMI := infrav1.AmazonLinuxGPU
+	windowsAMI := infrav1.WindowsFull2022
 	tests := []struct {
 		name       string
 		k8sVersion string
+		arch       infrav1.Architecture
 		amiType    *infrav1.EKSAMILookupType
 		expect     func(m *mock_ssmiface.MockSSMAPIMockRecorder)
 		want       string
@@ -420,6 +470,52 @@ func TestEKSAMILookUp(t *testing.T) {
 			want:    "id",
 			wantErr: false,
 		},
+		{
+			name:       "Should return an id corresponding to arm64 if the architecture is arm64",
+			k8sVersion: "v1.23.3",
+			arch:       infrav1.ArchitectureArm64,
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/eks/optimized-ami/1.23/amazon-linux-2-arm64/recommended/image_id"),
+				})).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("id"),
+					},
+				}, nil)
+			},
+			want:    "id",
+			wantErr: false,
+		},
+		{
+			name:       "Should return an error if a GPU based AMI type is passed for arm64",
+			k8sVersion: "v1.23.3",
+			arch:       infrav1.ArchitectureArm64,
+			amiType:    &gpuAMI,
+			wantErr:    true,
+		},
+		{
+			name:       "Should return an id corresponding to Windows if a Windows AMI type is passed",
+			k8sVersion: "v1.23.3",
+			amiType:    &windowsAMI,
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/ami-windows-latest/Windows_Server-2022-English-Full-EKS_Optimized-1.23/image_id"),
+				})).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("id"),
+					},
+				}, nil)
+			},
+			want:    "id",
+			wantErr: false,
+		},
+		{
+			name:       "Should return an error if a Windows AMI type is passed for arm64",
+			k8sVersion: "v1.23.3",
+			arch:       infrav1.ArchitectureArm64,
+			amiType:    &windowsAMI,
+			wantErr:    true,
+		},
 		{
 			name:       "Should return an error if GetParameter call fails with some AWS error",
 			k8sVersion: "v1.23.3",
@@ -465,11 +561,274 @@ func TestEKSAMILookUp(t *testing.T) {
 			s := NewService(clusterScope)
 			s.SSMClient = ssmMock
 
-			got, err := s.eksAMILookup(tt.k8sVersion, tt.amiType)
+			got, err := s.eksAMILookup(tt.k8sVersion, tt.arch, tt.amiType)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(got).Should(Equal(tt.want))
+		})
+	}
+}
+
+func TestInstanceTypeArchitecture(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name         string
+		instanceType string
+		expect       func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		want         infrav1.Architecture
+		wantErr      bool
+	}{
+		{
+			name: "Should default to x86_64 without an instance type",
+			want: infrav1.ArchitectureX86_64,
+		},
+		{
+			name:         "Should return arm64 for a Graviton instance type",
+			instanceType: "m6g.medium",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: aws.StringSlice([]string{"m6g.medium"}),
+				})).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							InstanceType:  aws.String("m6g.medium"),
+							ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{"arm64"})},
+						},
+					},
+				}, nil).Times(1)
+			},
+			want: infrav1.ArchitectureArm64,
+		},
+		{
+			name:         "Should return x86_64 for an instance type supporting i386 and x86_64",
+			instanceType: "t3.nano",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							InstanceType:  aws.String("t3.nano"),
+							ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{"i386", "x86_64"})},
+						},
+					},
+				}, nil).Times(1)
+			},
+			want: infrav1.ArchitectureX86_64,
+		},
+		{
+			name:         "Should return an error if DescribeInstanceTypes fails",
+			instanceType: "m6gd.medium",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resetInstanceTypeCache()
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			// The lookup of a second service, as created by the next reconcile, is served from the cache.
+			for i := 0; i < 2; i++ {
+				s := NewService(clusterScope)
+				s.EC2Client = ec2Mock
+
+				got, err := s.instanceTypeArchitecture(tt.instanceType)
+				if tt.wantErr {
+					g.Expect(err).To(HaveOccurred())
+					return
+				}
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(got).Should(Equal(tt.want))
+			}
+		})
+	}
+}
+
+func TestAMIReferenceLookup(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name       string
+		ref        infrav1.AMIReference
+		k8sVersion string
+		expectSSM  func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		expectEC2  func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "Should resolve a templated SSM parameter",
+			ref:        infrav1.AMIReference{SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sVersion}}/x86_64/latest/image_id")},
+			k8sVersion: "v1.22.5",
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/bottlerocket/aws-k8s-1.22/x86_64/latest/image_id"),
+				})).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("ami-bottlerocket"),
+					},
+				}, nil)
+			},
+			want: "ami-bottlerocket",
+		},
+		{
+			name:    "Should return an error if a templated SSM parameter has no Kubernetes version",
+			ref:     infrav1.AMIReference{SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sVersion}}/x86_64/latest/image_id")},
+			wantErr: true,
+		},
+		{
+			name: "Should select the most recent image of the owners matching the filters",
+			ref: infrav1.AMIReference{
+				Owners:  []string{"aws-marketplace"},
+				Filters: []infrav1.Filter{{Name: "name", Values: []string{"CIS Ubuntu Linux 20.04*"}}},
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.Eq(&ec2.DescribeImagesInput{
+					Owners: aws.StringSlice([]string{"aws-marketplace"}),
+					Filters: []*ec2.Filter{
+						{Name: aws.String("state"), Values: aws.StringSlice([]string{"available"})},
+						{Name: aws.String("name"), Values: aws.StringSlice([]string{"CIS Ubuntu Linux 20.04*"})},
+					},
+				})).Return(&ec2.DescribeImagesOutput{
+					Images: []*ec2.Image{
+						{ImageId: aws.String("ami-old"), CreationDate: aws.String("2021-06-30T23:59:59.000Z")},
+						{ImageId: aws.String("ami-new"), CreationDate: aws.String("2022-01-31T23:59:59.000Z")},
+					},
+				}, nil)
+			},
+			want: "ami-new",
+		},
+		{
+			name: "Should return an error if no image matches the filters",
+			ref:  infrav1.AMIReference{Owners: []string{"self"}},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.Any()).Return(&ec2.DescribeImagesOutput{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resetInstanceTypeCache()
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			if tt.expectSSM != nil {
+				tt.expectSSM(ssmMock.EXPECT())
+			}
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tt.expectEC2 != nil {
+				tt.expectEC2(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.SSMClient = ssmMock
+			s.EC2Client = ec2Mock
+
+			got, err := s.amiReferenceLookup(tt.ref, tt.k8sVersion)
 			if tt.wantErr {
 				g.Expect(err).To(HaveOccurred())
 				return
 			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).Should(Equal(tt.want))
+		})
+	}
+}
+
+func TestDiscoverMachineAMI(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name              string
+		spec              *infrav1.AWSMachineSpec
+		kubernetesVersion string
+		expect            func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		want              string
+		wantErr           bool
+	}{
+		{
+			name: "Should return the AMI ID of the spec",
+			spec: &infrav1.AWSMachineSpec{AMI: infrav1.AMIReference{ID: aws.String("ami-1234")}},
+			want: "ami-1234",
+		},
+		{
+			name:    "Should fail without an AMI ID or a Kubernetes version",
+			spec:    &infrav1.AWSMachineSpec{},
+			wantErr: true,
+		},
+		{
+			name:              "Should look up the latest default AMI for the Kubernetes version",
+			spec:              &infrav1.AWSMachineSpec{},
+			kubernetesVersion: "v1.22.3",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.Any()).Return(&ec2.DescribeImagesOutput{
+					Images: []*ec2.Image{
+						{
+							ImageId:      aws.String("ami-old"),
+							CreationDate: aws.String("2021-12-01T00:00:00.000Z"),
+						},
+						{
+							ImageId:      aws.String("ami-new"),
+							CreationDate: aws.String("2022-03-01T00:00:00.000Z"),
+						},
+					},
+				}, nil)
+			},
+			want: "ami-new",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			got, err := s.DiscoverMachineAMI(tt.spec, tt.kubernetesVersion)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
 			g.Expect(got).Should(Equal(tt.want))
 		})
 	}
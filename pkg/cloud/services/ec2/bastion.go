@@ -81,7 +81,7 @@ func (s *Service) ReconcileBastion() error {
 			record.Warnf(s.scope.InfraCluster(), "FailedFetchingBastion", "Failed to fetch default bastion instance: %v", err)
 			return err
 		}
-		instance, err = s.runInstance("bastion", defaultBastion)
+		instance, err = s.runInstance("bastion", defaultBastion, nil)
 		if err != nil {
 			record.Warnf(s.scope.InfraCluster(), "FailedCreateBastion", "Failed to create bastion instance: %v", err)
 			return err
@@ -168,7 +168,9 @@ func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
 
 func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance, error) {
 	name := fmt.Sprintf("%s-bastion", s.scope.Name())
-	userData, _ := userdata.NewBastion(&userdata.BastionInput{})
+	userData, _ := userdata.NewBastion(&userdata.BastionInput{
+		Proxy: userdata.NewProxy(s.scope.Proxy(), s.scope.AdditionalTrustBundle(), s.scope.NoProxy()...),
+	})
 
 	// If SSHKeyName WAS NOT provided, use the defaultSSHKeyName
 	keyName := s.scope.SSHKeyName()
@@ -188,7 +190,11 @@ func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance
 
 	if ami == "" {
 		var err error
-		ami, err = s.defaultBastionAMILookup()
+		if amiLookup := s.scope.Bastion().AMILookup; amiLookup != nil && usesAMILookup(*amiLookup) {
+			ami, err = s.amiReferenceLookup(*amiLookup, "")
+		} else {
+			ami, err = s.defaultBastionAMILookup()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ReconcileDedicatedHosts ensures the dedicated hosts of the cluster are allocated.
+// Hosts which are no longer wanted are released once no instances run on them.
+func (s *Service) ReconcileDedicatedHosts() error {
+	specs := s.scope.DedicatedHosts()
+	if len(specs) == 0 && !s.hasDedicatedHosts() {
+		s.scope.V(4).Info("Skipping dedicated hosts reconcile")
+		return nil
+	}
+
+	hosts, err := s.describeDedicatedHosts()
+	if err != nil {
+		return err
+	}
+
+	s.scope.V(2).Info("Reconciling dedicated hosts")
+
+	hostsByName := map[string][]*ec2.Host{}
+	for _, host := range hosts {
+		name := dedicatedHostName(host)
+		hostsByName[name] = append(hostsByName[name], host)
+	}
+
+	var surplus []*ec2.Host
+	for i := range specs {
+		spec := &specs[i]
+
+		var matching []*ec2.Host
+		for _, host := range hostsByName[spec.Name] {
+			// Hosts which permanently failed cannot run instances and are replaced.
+			if aws.StringValue(host.State) == ec2.AllocationStatePermanentFailure {
+				s.scope.Info("Replacing dedicated host which permanently failed", "name", spec.Name, "id", aws.StringValue(host.HostId))
+				surplus = append(surplus, host)
+				continue
+			}
+			if dedicatedHostMatches(spec, host) {
+				matching = append(matching, host)
+			} else {
+				surplus = append(surplus, host)
+			}
+		}
+		delete(hostsByName, spec.Name)
+
+		quantity := spec.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+
+		switch missing := quantity - int64(len(matching)); {
+		case missing > 0:
+			if err := s.allocateDedicatedHosts(spec, missing, matching); err != nil {
+				return err
+			}
+		case missing < 0:
+			// Prefer releasing the hosts without instances.
+			var idle, used []*ec2.Host
+			for _, host := range matching {
+				if len(host.Instances) == 0 {
+					idle = append(idle, host)
+				} else {
+					used = append(used, host)
+				}
+			}
+			surplus = append(surplus, append(idle, used...)[:-missing]...)
+		}
+	}
+
+	for _, hosts := range hostsByName {
+		surplus = append(surplus, hosts...)
+	}
+
+	inUse, err := s.releaseIdleDedicatedHosts(surplus)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(specs) > 0:
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.DedicatedHostsReadyCondition)
+	case inUse == 0:
+		// All hosts are released, so they no longer need to be reconciled.
+		conditions.Delete(s.scope.InfraCluster(), infrav1.DedicatedHostsReadyCondition)
+	}
+	s.scope.V(2).Info("Reconcile dedicated hosts completed successfully")
+
+	return nil
+}
+
+// DeleteDedicatedHosts releases all dedicated hosts of the cluster.
+func (s *Service) DeleteDedicatedHosts() error {
+	if len(s.scope.DedicatedHosts()) == 0 && !s.hasDedicatedHosts() {
+		s.scope.V(4).Info("No dedicated hosts to release")
+		return nil
+	}
+
+	hosts, err := s.describeDedicatedHosts()
+	if err != nil {
+		return err
+	}
+	if len(hosts) == 0 {
+		s.scope.V(4).Info("No dedicated hosts to release")
+		return nil
+	}
+
+	ids := make([]*string, 0, len(hosts))
+	for _, host := range hosts {
+		ids = append(ids, host.HostId)
+	}
+
+	return s.releaseDedicatedHosts(ids)
+}
+
+// hasDedicatedHosts returns whether dedicated hosts may have been allocated for the cluster, which is
+// recorded by the DedicatedHostsReady condition. Clusters which never had dedicated hosts are skipped,
+// so that the controller does not need permissions to describe hosts for them.
+func (s *Service) hasDedicatedHosts() bool {
+	return conditions.Has(s.scope.InfraCluster(), infrav1.DedicatedHostsReadyCondition)
+}
+
+// describeDedicatedHosts returns the dedicated hosts owned by the cluster which are not released.
+func (s *Service) describeDedicatedHosts() ([]*ec2.Host, error) {
+	input := &ec2.DescribeHostsInput{
+		Filter: []*ec2.Filter{
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.AllocationStateAvailable, ec2.AllocationStatePending, ec2.AllocationStateUnderAssessment, ec2.AllocationStatePermanentFailure}),
+			},
+		},
+	}
+
+	var hosts []*ec2.Host
+	for {
+		out, err := s.EC2Client.DescribeHosts(input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe dedicated hosts")
+		}
+		hosts = append(hosts, out.Hosts...)
+		if aws.StringValue(out.NextToken) == "" {
+			return hosts, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// allocateDedicatedHosts allocates the given quantity of hosts in addition to the existing hosts of the spec.
+func (s *Service) allocateDedicatedHosts(spec *infrav1.DedicatedHostSpec, quantity int64, existing []*ec2.Host) error {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(spec.Name),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	input := &ec2.AllocateHostsInput{
+		// Auto placement lets instances with tenancy host which do not target a host run on the hosts.
+		AutoPlacement:    aws.String(ec2.AutoPlacementOn),
+		AvailabilityZone: aws.String(spec.AvailabilityZone),
+		ClientToken:      aws.String(s.dedicatedHostsClientToken(spec, quantity, existing)),
+		Quantity:         aws.Int64(quantity),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeDedicatedHost),
+				Tags:         converters.MapToTags(tags),
+			},
+		},
+	}
+	if spec.InstanceType != "" {
+		input.InstanceType = aws.String(spec.InstanceType)
+	} else {
+		input.InstanceFamily = aws.String(spec.InstanceFamily)
+	}
+
+	out, err := s.EC2Client.AllocateHosts(input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAllocateDedicatedHosts", "Failed to allocate dedicated hosts %q: %v", spec.Name, err)
+		return errors.Wrapf(err, "failed to allocate dedicated hosts %q", spec.Name)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAllocateDedicatedHosts", "Allocated dedicated hosts %q with ids %s", spec.Name, strings.Join(aws.StringValueSlice(out.HostIds), ", "))
+	s.scope.Info("Allocated dedicated hosts", "name", spec.Name, "ids", aws.StringValueSlice(out.HostIds))
+
+	return nil
+}
+
+// dedicatedHostsClientToken returns the idempotency token of an allocation of hosts. Retrying the
+// allocation, e.g. after a timeout or before the allocated hosts are returned by DescribeHosts,
+// yields the same token so that AWS does not allocate the hosts again, while a new allocation
+// after the spec changed or hosts were released yields a new token.
+func (s *Service) dedicatedHostsClientToken(spec *infrav1.DedicatedHostSpec, quantity int64, existing []*ec2.Host) string {
+	ids := make([]string, 0, len(existing))
+	for _, host := range existing {
+		ids = append(ids, aws.StringValue(host.HostId))
+	}
+	sort.Strings(ids)
+
+	hash := sha256.New()
+	for _, value := range []string{
+		s.scope.Namespace(),
+		s.scope.Name(),
+		strconv.FormatInt(s.scope.InfraCluster().GetGeneration(), 10),
+		spec.Name,
+		strconv.FormatInt(quantity, 10),
+		strings.Join(ids, ","),
+	} {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+
+	// Client tokens are limited to 64 ASCII characters.
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// releaseIdleDedicatedHosts releases the given hosts on which no instances run, and returns the
+// number of hosts which are kept as they still run instances.
+func (s *Service) releaseIdleDedicatedHosts(hosts []*ec2.Host) (int, error) {
+	var ids []*string
+	inUse := 0
+	for _, host := range hosts {
+		if len(host.Instances) > 0 {
+			s.scope.V(2).Info("Not releasing dedicated host which still runs instances", "id", aws.StringValue(host.HostId), "instances", len(host.Instances))
+			inUse++
+			continue
+		}
+		ids = append(ids, host.HostId)
+	}
+	if len(ids) == 0 {
+		return inUse, nil
+	}
+
+	return inUse, s.releaseDedicatedHosts(ids)
+}
+
+func (s *Service) releaseDedicatedHosts(ids []*string) error {
+	out, err := s.EC2Client.ReleaseHosts(&ec2.ReleaseHostsInput{HostIds: ids})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedReleaseDedicatedHosts", "Failed to release dedicated hosts: %v", err)
+		return errors.Wrapf(err, "failed to release dedicated hosts %s", strings.Join(aws.StringValueSlice(ids), ", "))
+	}
+
+	if len(out.Successful) > 0 {
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulReleaseDedicatedHosts", "Released dedicated hosts %s", strings.Join(aws.StringValueSlice(out.Successful), ", "))
+		s.scope.Info("Released dedicated hosts", "ids", aws.StringValueSlice(out.Successful))
+	}
+
+	if len(out.Unsuccessful) > 0 {
+		failures := make([]string, 0, len(out.Unsuccessful))
+		for _, item := range out.Unsuccessful {
+			msg := aws.StringValue(item.ResourceId)
+			if item.Error != nil {
+				msg += ": " + aws.StringValue(item.Error.Message)
+			}
+			failures = append(failures, msg)
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedReleaseDedicatedHosts", "Failed to release dedicated hosts: %s", strings.Join(failures, "; "))
+		return errors.Errorf("failed to release dedicated hosts: %s", strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// dedicatedHostName returns the value of the Name tag of the host.
+func dedicatedHostName(host *ec2.Host) string {
+	return converters.TagsToMap(host.Tags)["Name"]
+}
+
+// dedicatedHostMatches returns whether the host was allocated with the availability zone
+// and instance type or family of the spec.
+func dedicatedHostMatches(spec *infrav1.DedicatedHostSpec, host *ec2.Host) bool {
+	if aws.StringValue(host.AvailabilityZone) != spec.AvailabilityZone || host.HostProperties == nil {
+		return false
+	}
+	if spec.InstanceType != "" {
+		return aws.StringValue(host.HostProperties.InstanceType) == spec.InstanceType
+	}
+	return aws.StringValue(host.HostProperties.InstanceType) == "" &&
+		aws.StringValue(host.HostProperties.InstanceFamily) == spec.InstanceFamily
+}
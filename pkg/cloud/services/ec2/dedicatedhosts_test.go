@@ -0,0 +1,286 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileDedicatedHosts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInput := &ec2.DescribeHostsInput{
+		Filter: []*ec2.Filter{
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/cluster-name"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.AllocationStateAvailable, ec2.AllocationStatePending, ec2.AllocationStateUnderAssessment, ec2.AllocationStatePermanentFailure}),
+			},
+		},
+	}
+
+	host := func(id, name, instanceType string, instances int) *ec2.Host {
+		h := &ec2.Host{
+			HostId:           aws.String(id),
+			AvailabilityZone: aws.String("us-east-1a"),
+			HostProperties:   &ec2.HostProperties{InstanceType: aws.String(instanceType)},
+			Tags:             []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String(name)}},
+		}
+		for i := 0; i < instances; i++ {
+			h.Instances = append(h.Instances, &ec2.HostInstance{InstanceId: aws.String("i-1")})
+		}
+		return h
+	}
+
+	testCases := []struct {
+		name            string
+		hosts           []infrav1.DedicatedHostSpec
+		allocated       bool
+		expect          func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectErr       bool
+		expectCondition bool
+	}{
+		{
+			name:   "does nothing without dedicated hosts",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:      "releases the hosts after the dedicated hosts were removed",
+			allocated: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{host("h-1", "hosts", "m5.large", 0)}}, nil)
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-1"})})).
+					Return(&ec2.ReleaseHostsOutput{Successful: aws.StringSlice([]string{"h-1"})}, nil)
+			},
+		},
+		{
+			name:      "keeps removed hosts which still run instances",
+			allocated: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{host("h-1", "hosts", "m5.large", 1)}}, nil)
+			},
+			expectCondition: true,
+		},
+		{
+			name: "allocates missing hosts",
+			hosts: []infrav1.DedicatedHostSpec{
+				{Name: "hosts", InstanceFamily: "m5", AvailabilityZone: "us-east-1a", Quantity: 2},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{}, nil)
+				m.AllocateHosts(gomock.Any()).
+					DoAndReturn(func(input *ec2.AllocateHostsInput) (*ec2.AllocateHostsOutput, error) {
+						if aws.Int64Value(input.Quantity) != 2 {
+							t.Fatalf("expected 2 hosts to be allocated, got %d", aws.Int64Value(input.Quantity))
+						}
+						if aws.StringValue(input.InstanceFamily) != "m5" || input.InstanceType != nil {
+							t.Fatalf("expected hosts to be allocated for instance family m5, got %v", input)
+						}
+						if aws.StringValue(input.AutoPlacement) != ec2.AutoPlacementOn {
+							t.Fatalf("expected hosts to be allocated with auto placement, got %q", aws.StringValue(input.AutoPlacement))
+						}
+						if len(aws.StringValue(input.ClientToken)) != 64 {
+							t.Fatalf("expected hosts to be allocated with a client token, got %q", aws.StringValue(input.ClientToken))
+						}
+						return &ec2.AllocateHostsOutput{HostIds: aws.StringSlice([]string{"h-1", "h-2"})}, nil
+					})
+			},
+		},
+		{
+			name: "allocates only the hosts which are missing",
+			hosts: []infrav1.DedicatedHostSpec{
+				{Name: "hosts", InstanceType: "m5.large", AvailabilityZone: "us-east-1a", Quantity: 2},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{host("h-1", "hosts", "m5.large", 0)}}, nil)
+				m.AllocateHosts(gomock.Any()).
+					DoAndReturn(func(input *ec2.AllocateHostsInput) (*ec2.AllocateHostsOutput, error) {
+						if aws.Int64Value(input.Quantity) != 1 {
+							t.Fatalf("expected 1 host to be allocated, got %d", aws.Int64Value(input.Quantity))
+						}
+						return &ec2.AllocateHostsOutput{HostIds: aws.StringSlice([]string{"h-2"})}, nil
+					})
+			},
+		},
+		{
+			name: "releases idle surplus hosts and keeps hosts running instances",
+			hosts: []infrav1.DedicatedHostSpec{
+				{Name: "hosts", InstanceType: "m5.large", AvailabilityZone: "us-east-1a", Quantity: 1},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{
+						host("h-1", "hosts", "m5.large", 1),
+						host("h-2", "hosts", "m5.large", 0),
+						host("h-3", "removed", "m5.large", 1),
+					}}, nil)
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-2"})})).
+					Return(&ec2.ReleaseHostsOutput{Successful: aws.StringSlice([]string{"h-2"})}, nil)
+			},
+		},
+		{
+			name: "replaces hosts which no longer match the spec",
+			hosts: []infrav1.DedicatedHostSpec{
+				{Name: "hosts", InstanceType: "m5.xlarge", AvailabilityZone: "us-east-1a"},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{host("h-1", "hosts", "m5.large", 0)}}, nil)
+				m.AllocateHosts(gomock.Any()).
+					Return(&ec2.AllocateHostsOutput{HostIds: aws.StringSlice([]string{"h-2"})}, nil)
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-1"})})).
+					Return(&ec2.ReleaseHostsOutput{Successful: aws.StringSlice([]string{"h-1"})}, nil)
+			},
+		},
+		{
+			name: "replaces hosts which permanently failed",
+			hosts: []infrav1.DedicatedHostSpec{
+				{Name: "hosts", InstanceType: "m5.large", AvailabilityZone: "us-east-1a", Quantity: 2},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				failed := host("h-2", "hosts", "m5.large", 0)
+				failed.State = aws.String(ec2.AllocationStatePermanentFailure)
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{host("h-1", "hosts", "m5.large", 1), failed}}, nil)
+				m.AllocateHosts(gomock.Any()).
+					DoAndReturn(func(input *ec2.AllocateHostsInput) (*ec2.AllocateHostsOutput, error) {
+						if aws.Int64Value(input.Quantity) != 1 {
+							t.Fatalf("expected 1 host to be allocated, got %d", aws.Int64Value(input.Quantity))
+						}
+						return &ec2.AllocateHostsOutput{HostIds: aws.StringSlice([]string{"h-3"})}, nil
+					})
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-2"})})).
+					Return(&ec2.ReleaseHostsOutput{Successful: aws.StringSlice([]string{"h-2"})}, nil)
+			},
+		},
+		{
+			name:      "returns an error when hosts cannot be released",
+			allocated: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeHosts(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{host("h-1", "hosts", "m5.large", 0)}}, nil)
+				m.ReleaseHosts(gomock.Any()).
+					Return(&ec2.ReleaseHostsOutput{Unsuccessful: []*ec2.UnsuccessfulItem{
+						{ResourceId: aws.String("h-1"), Error: &ec2.UnsuccessfulItemError{Message: aws.String("host is busy")}},
+					}}, nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			clusterScope.AWSCluster.Spec.DedicatedHosts = tc.hosts
+			if tc.allocated {
+				conditions.MarkTrue(clusterScope.AWSCluster, infrav1.DedicatedHostsReadyCondition)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.ReconcileDedicatedHosts()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(conditions.Has(clusterScope.AWSCluster, infrav1.DedicatedHostsReadyCondition)).To(Equal(len(tc.hosts) > 0 || tc.expectCondition))
+		})
+	}
+}
+
+func TestDeleteDedicatedHosts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := setupClusterScope(client)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	// Clusters which never had dedicated hosts are skipped.
+	g.Expect(s.DeleteDedicatedHosts()).To(Succeed())
+
+	conditions.MarkTrue(clusterScope.AWSCluster, infrav1.DedicatedHostsReadyCondition)
+	ec2Mock.EXPECT().DescribeHosts(gomock.Any()).
+		Return(&ec2.DescribeHostsOutput{Hosts: []*ec2.Host{{HostId: aws.String("h-1")}, {HostId: aws.String("h-2")}}}, nil)
+	ec2Mock.EXPECT().ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-1", "h-2"})})).
+		Return(&ec2.ReleaseHostsOutput{Successful: aws.StringSlice([]string{"h-1", "h-2"})}, nil)
+
+	g.Expect(s.DeleteDedicatedHosts()).To(Succeed())
+}
+
+func TestDedicatedHostsClientToken(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := setupClusterScope(client)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(clusterScope)
+	spec := &infrav1.DedicatedHostSpec{Name: "hosts", InstanceType: "m5.large", AvailabilityZone: "us-east-1a", Quantity: 2}
+	existing := []*ec2.Host{{HostId: aws.String("h-2")}, {HostId: aws.String("h-1")}}
+
+	token := s.dedicatedHostsClientToken(spec, 1, existing)
+	g.Expect(token).To(HaveLen(64))
+	g.Expect(s.dedicatedHostsClientToken(spec, 1, []*ec2.Host{existing[1], existing[0]})).To(Equal(token))
+	g.Expect(s.dedicatedHostsClientToken(spec, 2, nil)).NotTo(Equal(token))
+	g.Expect(s.dedicatedHostsClientToken(spec, 1, existing[:1])).NotTo(Equal(token))
+
+	clusterScope.AWSCluster.Generation++
+	g.Expect(s.dedicatedHostsClientToken(spec, 1, existing)).NotTo(Equal(token))
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,6 +32,7 @@ import (
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/exp/api/v1beta1"
 )
@@ -61,6 +63,28 @@ func setupMachinePoolScope(cl client.Client, ec2Scope scope.EC2Scope) (*scope.Ma
 	})
 }
 
+// resetInstanceTypeCache forgets the instance types looked up by previous tests.
+func resetInstanceTypeCache() {
+	instanceTypeCache.Lock()
+	defer instanceTypeCache.Unlock()
+	instanceTypeCache.entries = map[string]cachedInstanceType{}
+}
+
+// expectInstanceTypeArchitecture expects the architecture of the instance type to be looked up once.
+func expectInstanceTypeArchitecture(m *mock_ec2iface.MockEC2APIMockRecorder, instanceType, arch string) {
+	resetInstanceTypeCache()
+	m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{instanceType}),
+	})).Return(&ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			{
+				InstanceType:  aws.String(instanceType),
+				ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{arch})},
+			},
+		},
+	}, nil)
+}
+
 func defaultEC2Tags(name, clusterName string) []*ec2.Tag {
 	return []*ec2.Tag{
 		{
@@ -131,44 +131,18 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 		Additional:  additionalTags,
 	}.WithCloudProvider(s.scope.KubernetesClusterName()).WithMachineName(scope.Machine))
 
-	var err error
 	// Pick image from the machine configuration, or use a default one.
-	if scope.AWSMachine.Spec.AMI.ID != nil { // nolint:nestif
-		input.ImageID = *scope.AWSMachine.Spec.AMI.ID
-	} else {
-		if scope.Machine.Spec.Version == nil {
-			err := errors.New("Either AWSMachine's spec.ami.id or Machine's spec.version must be defined")
-			scope.SetFailureReason(capierrors.CreateMachineError)
-			scope.SetFailureMessage(err)
-			return nil, err
-		}
-
-		imageLookupFormat := scope.AWSMachine.Spec.ImageLookupFormat
-		if imageLookupFormat == "" {
-			imageLookupFormat = scope.InfraCluster.ImageLookupFormat()
-		}
-
-		imageLookupOrg := scope.AWSMachine.Spec.ImageLookupOrg
-		if imageLookupOrg == "" {
-			imageLookupOrg = scope.InfraCluster.ImageLookupOrg()
-		}
-
-		imageLookupBaseOS := scope.AWSMachine.Spec.ImageLookupBaseOS
-		if imageLookupBaseOS == "" {
-			imageLookupBaseOS = scope.InfraCluster.ImageLookupBaseOS()
-		}
+	if scope.AWSMachine.Spec.AMI.ID == nil && !usesAMILookup(scope.AWSMachine.Spec.AMI) && scope.Machine.Spec.Version == nil {
+		err := errors.New("Either AWSMachine's spec.ami.id or Machine's spec.version must be defined")
+		scope.SetFailureReason(capierrors.CreateMachineError)
+		scope.SetFailureMessage(err)
+		return nil, err
+	}
 
-		if scope.IsEKSManaged() && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "" {
-			input.ImageID, err = s.eksAMILookup(*scope.Machine.Spec.Version, scope.AWSMachine.Spec.AMI.EKSOptimizedLookupType)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			input.ImageID, err = s.defaultAMIIDLookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, *scope.Machine.Spec.Version)
-			if err != nil {
-				return nil, err
-			}
-		}
+	var err error
+	input.ImageID, err = s.DiscoverMachineAMI(&scope.AWSMachine.Spec, pointer.StringDeref(scope.Machine.Spec.Version, ""))
+	if err != nil {
+		return nil, err
 	}
 
 	subnetID, err := s.findSubnet(scope)
@@ -199,6 +173,11 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	}
 	input.SecurityGroupIDs = append(input.SecurityGroupIDs, ids...)
 
+	input.NetworkInterfaceSpecs, err = s.resolveNetworkInterfaceSpecs(scope, subnetID, scope.AWSMachine.Spec.NetworkInterfaceSpecs)
+	if err != nil {
+		return nil, err
+	}
+
 	// If SSHKeyName WAS NOT provided in the AWSMachine Spec, fallback to the value provided in the AWSCluster Spec.
 	// If a value was not provided in the AWSCluster Spec, then use the defaultSSHKeyName
 	// Note that:
@@ -228,9 +207,41 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	input.SpotMarketOptions = scope.AWSMachine.Spec.SpotMarketOptions
 
 	input.Tenancy = scope.AWSMachine.Spec.Tenancy
+	input.HostID = scope.AWSMachine.Spec.HostID
+	input.HostResourceGroupArn = scope.AWSMachine.Spec.HostResourceGroupArn
+	input.HostAffinity = scope.AWSMachine.Spec.HostAffinity
+
+	if scope.DisableAPITermination() {
+		input.DisableAPITermination = aws.Bool(true)
+	}
+
+	if scope.DisableAPIStop() {
+		input.DisableAPIStop = aws.Bool(true)
+	}
+
+	input.CPUOptions = scope.AWSMachine.Spec.CPUOptions
+	input.HibernationOptions = scope.AWSMachine.Spec.HibernationOptions
+	input.EnclaveOptions = scope.AWSMachine.Spec.EnclaveOptions
+	input.BootMode = scope.AWSMachine.Spec.BootMode
+
+	if scope.IsEKSManaged() && scope.AWSMachine.Spec.PrivateDNSName != nil && scope.AWSMachine.Spec.PrivateDNSName.HostnameType == infrav1.HostnameTypeResourceName {
+		err := errors.New("resource-based hostnames are not supported by EKS nodes")
+		scope.SetFailureReason(capierrors.CreateMachineError)
+		scope.SetFailureMessage(err)
+		return nil, err
+	}
+	input.PrivateDNSName = scope.PrivateDNSName(subnetID)
+
+	var launchTemplate *ec2.LaunchTemplateSpecification
+	if scope.AWSMachine.Spec.UseLaunchTemplate {
+		launchTemplate, err = s.reconcileMachineLaunchTemplate(scope, aws.String(input.ImageID), userData)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	s.scope.V(2).Info("Running instance", "machine-role", scope.Role())
-	out, err := s.runInstance(scope.Role(), input)
+	out, err := s.runInstance(scope.Role(), input, launchTemplate)
 	if err != nil {
 		// Only record the failure event if the error is not related to failed dependencies.
 		// This is to avoid spamming failure events since the machine will be requeued by the actuator.
@@ -442,7 +453,19 @@ func (s *Service) TerminateInstance(instanceID string) error {
 		InstanceIds: aws.StringSlice([]string{instanceID}),
 	}
 
-	if _, err := s.EC2Client.TerminateInstances(input); err != nil {
+	_, err := s.EC2Client.TerminateInstances(input)
+	if awserrors.IsOperationNotPermitted(err) {
+		// The instance is protected, which is cleared as CAPA is deleting it.
+		s.scope.V(2).Info("Clearing termination and stop protection of instance", "instance-id", instanceID)
+		if err := s.SetTerminationProtection(instanceID, false); err != nil {
+			return err
+		}
+		if err := s.SetStopProtection(instanceID, false); err != nil {
+			return err
+		}
+		_, err = s.EC2Client.TerminateInstances(input)
+	}
+	if err != nil {
 		return errors.Wrapf(err, "failed to terminate instance with id %q", instanceID)
 	}
 
@@ -450,6 +473,66 @@ func (s *Service) TerminateInstance(instanceID string) error {
 	return nil
 }
 
+// GetInstanceProtection returns whether termination and stop protection are enabled for the instance.
+func (s *Service) GetInstanceProtection(instanceID string) (disableAPITermination bool, disableAPIStop bool, err error) {
+	termination, err := s.EC2Client.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiTermination),
+	})
+	if err != nil {
+		return false, false, errors.Wrapf(err, "failed to describe termination protection of instance %q", instanceID)
+	}
+
+	stop, err := s.EC2Client.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiStop),
+	})
+	if err != nil {
+		return false, false, errors.Wrapf(err, "failed to describe stop protection of instance %q", instanceID)
+	}
+
+	if termination.DisableApiTermination != nil {
+		disableAPITermination = aws.BoolValue(termination.DisableApiTermination.Value)
+	}
+	if stop.DisableApiStop != nil {
+		disableAPIStop = aws.BoolValue(stop.DisableApiStop.Value)
+	}
+
+	return disableAPITermination, disableAPIStop, nil
+}
+
+// SetTerminationProtection enables or disables termination protection of the instance.
+func (s *Service) SetTerminationProtection(instanceID string, disableAPITermination bool) error {
+	s.scope.V(2).Info("Updating termination protection of instance", "instance-id", instanceID, "disable-api-termination", disableAPITermination)
+
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId:            aws.String(instanceID),
+		DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(disableAPITermination)},
+	}
+
+	if _, err := s.EC2Client.ModifyInstanceAttribute(input); err != nil {
+		return errors.Wrapf(err, "failed to modify termination protection of instance %q", instanceID)
+	}
+
+	return nil
+}
+
+// SetStopProtection enables or disables stop protection of the instance.
+func (s *Service) SetStopProtection(instanceID string, disableAPIStop bool) error {
+	s.scope.V(2).Info("Updating stop protection of instance", "instance-id", instanceID, "disable-api-stop", disableAPIStop)
+
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId:     aws.String(instanceID),
+		DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(disableAPIStop)},
+	}
+
+	if _, err := s.EC2Client.ModifyInstanceAttribute(input); err != nil {
+		return errors.Wrapf(err, "failed to modify stop protection of instance %q", instanceID)
+	}
+
+	return nil
+}
+
 // TerminateInstanceAndWait terminates and waits
 // for an EC2 instance to terminate.
 func (s *Service) TerminateInstanceAndWait(instanceID string) error {
@@ -470,20 +553,49 @@ func (s *Service) TerminateInstanceAndWait(instanceID string) error {
 	return nil
 }
 
-func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instance, error) {
+func (s *Service) runInstance(role string, i *infrav1.Instance, launchTemplate *ec2.LaunchTemplateSpecification) (*infrav1.Instance, error) {
 	input := &ec2.RunInstancesInput{
-		InstanceType: aws.String(i.Type),
-		ImageId:      aws.String(i.ImageID),
-		KeyName:      i.SSHKeyName,
 		EbsOptimized: i.EBSOptimized,
 		MaxCount:     aws.Int64(1),
 		MinCount:     aws.Int64(1),
-		UserData:     i.UserData,
+	}
+
+	// An instance created from a launch template only gets the settings specific to the instance,
+	// such as its subnet, security groups, tags and placement, as the launch template describes the rest.
+	if launchTemplate != nil {
+		input.LaunchTemplate = launchTemplate
+	} else {
+		input.InstanceType = aws.String(i.Type)
+		input.ImageId = aws.String(i.ImageID)
+		input.KeyName = i.SSHKeyName
+		input.UserData = i.UserData
 	}
 
 	s.scope.V(2).Info("userData size", "bytes", len(*i.UserData), "role", role)
 
-	if len(i.NetworkInterfaces) > 0 {
+	switch {
+	case len(i.NetworkInterfaceSpecs) > 0:
+		netInterfaces := make([]*ec2.InstanceNetworkInterfaceSpecification, 0, len(i.NetworkInterfaceSpecs))
+
+		for _, spec := range i.NetworkInterfaceSpecs {
+			subnetID := i.SubnetID
+			if spec.Subnet != nil && spec.Subnet.ID != nil {
+				subnetID = *spec.Subnet.ID
+			}
+
+			netInterfaces = append(netInterfaces, &ec2.InstanceNetworkInterfaceSpecification{
+				DeviceIndex:                    aws.Int64(spec.DeviceIndex),
+				SubnetId:                       aws.String(subnetID),
+				InterfaceType:                  networkInterfaceType(spec),
+				Groups:                         aws.StringSlice(networkInterfaceSecurityGroupIDs(i.SecurityGroupIDs, spec)),
+				SecondaryPrivateIpAddressCount: spec.SecondaryPrivateIPAddressCount,
+				Ipv4PrefixCount:                spec.IPv4PrefixCount,
+				DeleteOnTermination:            networkInterfaceDeleteOnTermination(spec),
+			})
+		}
+
+		input.NetworkInterfaces = netInterfaces
+	case len(i.NetworkInterfaces) > 0:
 		netInterfaces := make([]*ec2.InstanceNetworkInterfaceSpecification, 0, len(i.NetworkInterfaces))
 
 		for index, id := range i.NetworkInterfaces {
@@ -494,7 +606,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 
 		input.NetworkInterfaces = netInterfaces
-	} else {
+	default:
 		input.SubnetId = aws.String(i.SubnetID)
 
 		if len(i.SecurityGroupIDs) > 0 {
@@ -502,15 +614,33 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 	}
 
-	if i.IAMProfile != "" {
+	if i.IAMProfile != "" && launchTemplate == nil {
 		input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
 			Name: aws.String(i.IAMProfile),
 		}
 	}
 
+	if err := s.checkInstanceOptions(i.ImageID, infrav1.InstanceOptions{
+		InstanceType:          i.Type,
+		Spot:                  i.SpotMarketOptions != nil,
+		CPUOptions:            i.CPUOptions,
+		HibernationOptions:    i.HibernationOptions,
+		EnclaveOptions:        i.EnclaveOptions,
+		BootMode:              i.BootMode,
+		NetworkInterfaceSpecs: i.NetworkInterfaceSpecs,
+	}); err != nil {
+		return nil, err
+	}
+
+	if launchTemplate == nil {
+		setInstanceOptions(input, i)
+	}
+
 	blockdeviceMappings := []*ec2.BlockDeviceMapping{}
 
-	if i.RootVolume != nil {
+	// The root volume is part of the launch template, but the block device mappings of the request
+	// replace those of the launch template, so it is passed along with the non-root volumes.
+	if i.RootVolume != nil && (launchTemplate == nil || len(i.NonRootVolumes) > 0) {
 		rootDeviceName, err := s.checkRootVolume(i.RootVolume, i.ImageID)
 		if err != nil {
 			return nil, err
@@ -556,9 +686,30 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 
 	input.InstanceMarketOptions = getInstanceMarketOptionsRequest(i.SpotMarketOptions)
 
-	if i.Tenancy != "" {
-		input.Placement = &ec2.Placement{
-			Tenancy: &i.Tenancy,
+	// The placement and protection of an instance with a launch template are set by the launch template.
+	if launchTemplate == nil {
+		input.DisableApiTermination = i.DisableAPITermination
+		input.DisableApiStop = i.DisableAPIStop
+
+		if i.Tenancy != "" {
+			input.Placement = &ec2.Placement{
+				Tenancy:              &i.Tenancy,
+				HostId:               i.HostID,
+				HostResourceGroupArn: i.HostResourceGroupArn,
+			}
+			if i.HostAffinity != "" {
+				input.Placement.Affinity = aws.String(i.HostAffinity)
+			}
+		}
+	}
+
+	if i.PrivateDNSName != nil && launchTemplate == nil {
+		input.PrivateDnsNameOptions = &ec2.PrivateDnsNameOptionsRequest{
+			EnableResourceNameDnsARecord:    i.PrivateDNSName.EnableResourceNameDNSARecord,
+			EnableResourceNameDnsAAAARecord: i.PrivateDNSName.EnableResourceNameDNSAAAARecord,
+		}
+		if i.PrivateDNSName.HostnameType != "" {
+			input.PrivateDnsNameOptions.HostnameType = aws.String(string(i.PrivateDNSName.HostnameType))
 		}
 	}
 
@@ -587,6 +738,28 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	return s.SDKToInstance(out.Instances[0])
 }
 
+// setInstanceOptions sets the CPU, hibernation and enclave options of an instance created without a launch template.
+func setInstanceOptions(input *ec2.RunInstancesInput, i *infrav1.Instance) {
+	if i.CPUOptions != nil {
+		input.CpuOptions = &ec2.CpuOptionsRequest{
+			CoreCount:      aws.Int64(i.CPUOptions.CoreCount),
+			ThreadsPerCore: aws.Int64(i.CPUOptions.ThreadsPerCore),
+		}
+	}
+
+	if i.HibernationOptions != nil {
+		input.HibernationOptions = &ec2.HibernationOptionsRequest{
+			Configured: aws.Bool(i.HibernationOptions.Configured),
+		}
+	}
+
+	if i.EnclaveOptions != nil {
+		input.EnclaveOptions = &ec2.EnclaveOptionsRequest{
+			Enabled: aws.Bool(i.EnclaveOptions.Enabled),
+		}
+	}
+}
+
 func volumeToBlockDeviceMapping(v *infrav1.Volume) *ec2.BlockDeviceMapping {
 	ebsDevice := &ec2.EbsBlockDevice{
 		DeleteOnTermination: aws.Bool(true),
@@ -611,6 +784,14 @@ func volumeToBlockDeviceMapping(v *infrav1.Volume) *ec2.BlockDeviceMapping {
 		ebsDevice.VolumeType = aws.String(string(v.Type))
 	}
 
+	if v.SnapshotID != "" {
+		ebsDevice.SnapshotId = aws.String(v.SnapshotID)
+	}
+
+	if v.DeleteOnTermination != nil {
+		ebsDevice.DeleteOnTermination = v.DeleteOnTermination
+	}
+
 	return &ec2.BlockDeviceMapping{
 		DeviceName: &v.DeviceName,
 		Ebs:        ebsDevice,
@@ -808,11 +989,43 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 	i.Addresses = s.getInstanceAddresses(v)
 
 	i.AvailabilityZone = aws.StringValue(v.Placement.AvailabilityZone)
+	i.HostID = v.Placement.HostId
+	i.HostResourceGroupArn = v.Placement.HostResourceGroupArn
+	i.HostAffinity = aws.StringValue(v.Placement.Affinity)
 
 	for _, volume := range v.BlockDeviceMappings {
 		i.VolumeIDs = append(i.VolumeIDs, *volume.Ebs.VolumeId)
 	}
 
+	if v.CpuOptions != nil {
+		i.CPUOptions = &infrav1.CPUOptions{
+			CoreCount:      aws.Int64Value(v.CpuOptions.CoreCount),
+			ThreadsPerCore: aws.Int64Value(v.CpuOptions.ThreadsPerCore),
+		}
+	}
+
+	if v.HibernationOptions != nil {
+		i.HibernationOptions = &infrav1.HibernationOptions{
+			Configured: aws.BoolValue(v.HibernationOptions.Configured),
+		}
+	}
+
+	if v.EnclaveOptions != nil {
+		i.EnclaveOptions = &infrav1.EnclaveOptions{
+			Enabled: aws.BoolValue(v.EnclaveOptions.Enabled),
+		}
+	}
+
+	i.BootMode = infrav1.BootMode(aws.StringValue(v.BootMode))
+
+	if v.PrivateDnsNameOptions != nil {
+		i.PrivateDNSName = &infrav1.PrivateDNSName{
+			HostnameType:                    infrav1.HostnameType(aws.StringValue(v.PrivateDnsNameOptions.HostnameType)),
+			EnableResourceNameDNSARecord:    v.PrivateDnsNameOptions.EnableResourceNameDnsARecord,
+			EnableResourceNameDNSAAAARecord: v.PrivateDnsNameOptions.EnableResourceNameDnsAAAARecord,
+		}
+	}
+
 	return i, nil
 }
 
@@ -845,6 +1058,31 @@ func (s *Service) getInstanceAddresses(instance *ec2.Instance) []clusterv1.Machi
 	return addresses
 }
 
+// InstanceAddresses returns the addresses of the instance for the given private DNS name options.
+// Instances using resource-based hostnames additionally report their resource name as hostname, and
+// as internal DNS name if a DNS record is created for it.
+func InstanceAddresses(instance *infrav1.Instance, options *infrav1.PrivateDNSName, region string) []clusterv1.MachineAddress {
+	if options == nil || options.HostnameType != infrav1.HostnameTypeResourceName {
+		return instance.Addresses
+	}
+
+	resourceName := ResourceName(instance.ID, region)
+	addresses := []clusterv1.MachineAddress{{Type: clusterv1.MachineHostName, Address: resourceName}}
+	if aws.BoolValue(options.EnableResourceNameDNSARecord) || aws.BoolValue(options.EnableResourceNameDNSAAAARecord) {
+		addresses = append(addresses, clusterv1.MachineAddress{Type: clusterv1.MachineInternalDNS, Address: resourceName})
+	}
+
+	return append(addresses, instance.Addresses...)
+}
+
+// ResourceName returns the resource-based hostname of the instance in the given region.
+func ResourceName(instanceID, region string) string {
+	if region == "us-east-1" {
+		return fmt.Sprintf("%s.ec2.internal", instanceID)
+	}
+	return fmt.Sprintf("%s.%s.compute.internal", instanceID, region)
+}
+
 func (s *Service) getNetworkInterfaceSecurityGroups(interfaceID string) ([]string, error) {
 	input := &ec2.DescribeNetworkInterfaceAttributeInput{
 		Attribute:          aws.String("groupSet"),
@@ -941,6 +1179,199 @@ func (s *Service) checkRootVolume(rootVolume *infrav1.Volume, imageID string) (*
 	return rootDeviceName, nil
 }
 
+// checkInstanceOptions checks the CPU, hibernation, enclave and boot mode options against the
+// capabilities of the instance type and the requested AMI. Nothing is looked up if none are set.
+func (s *Service) checkInstanceOptions(imageID string, opts infrav1.InstanceOptions) error {
+	hibernation := opts.HibernationOptions != nil && opts.HibernationOptions.Configured
+	enclave := opts.EnclaveOptions != nil && opts.EnclaveOptions.Enabled
+
+	efa := false
+	for _, spec := range opts.NetworkInterfaceSpecs {
+		if spec.InterfaceType == infrav1.NetworkInterfaceTypeEFA {
+			efa = true
+		}
+	}
+
+	if opts.CPUOptions == nil && !hibernation && !enclave && opts.BootMode == "" && len(opts.NetworkInterfaceSpecs) == 0 {
+		return nil
+	}
+
+	if opts.InstanceType != "" {
+		info, err := s.describeInstanceType(opts.InstanceType)
+		if err != nil {
+			return err
+		}
+
+		if opts.CPUOptions != nil && info.VCpuInfo != nil {
+			if len(info.VCpuInfo.ValidCores) > 0 && !containsInt64(info.VCpuInfo.ValidCores, opts.CPUOptions.CoreCount) {
+				return errors.Errorf("core count %d is not supported by instance type %q, valid core counts are %v",
+					opts.CPUOptions.CoreCount, opts.InstanceType, aws.Int64ValueSlice(info.VCpuInfo.ValidCores))
+			}
+			if len(info.VCpuInfo.ValidThreadsPerCore) > 0 && !containsInt64(info.VCpuInfo.ValidThreadsPerCore, opts.CPUOptions.ThreadsPerCore) {
+				return errors.Errorf("%d threads per core is not supported by instance type %q, valid values are %v",
+					opts.CPUOptions.ThreadsPerCore, opts.InstanceType, aws.Int64ValueSlice(info.VCpuInfo.ValidThreadsPerCore))
+			}
+		}
+
+		if hibernation && !aws.BoolValue(info.HibernationSupported) {
+			return errors.Errorf("instance type %q does not support hibernation", opts.InstanceType)
+		}
+
+		if enclave && aws.StringValue(info.Hypervisor) != ec2.InstanceTypeHypervisorNitro {
+			return errors.Errorf("instance type %q does not support Nitro Enclaves", opts.InstanceType)
+		}
+
+		if info.NetworkInfo != nil {
+			if efa && !aws.BoolValue(info.NetworkInfo.EfaSupported) {
+				return errors.Errorf("instance type %q does not support Elastic Fabric Adapters", opts.InstanceType)
+			}
+			if maxInterfaces := aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces); maxInterfaces > 0 && int64(len(opts.NetworkInterfaceSpecs)) > maxInterfaces {
+				return errors.Errorf("instance type %q supports at most %d network interfaces, but %d are requested",
+					opts.InstanceType, maxInterfaces, len(opts.NetworkInterfaceSpecs))
+			}
+		}
+
+		if opts.BootMode != "" && len(info.SupportedBootModes) > 0 && !containsGroup(aws.StringValueSlice(info.SupportedBootModes), string(opts.BootMode)) {
+			return errors.Errorf("instance type %q does not support boot mode %q", opts.InstanceType, opts.BootMode)
+		}
+	}
+
+	if opts.BootMode != "" {
+		output, err := s.EC2Client.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: []*string{aws.String(imageID)},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe image %q", imageID)
+		}
+
+		if len(output.Images) == 0 {
+			return errors.Errorf("no images returned when looking up ID %q", imageID)
+		}
+
+		// Images without an explicit boot mode boot in the default mode of their architecture.
+		imageBootMode := infrav1.BootMode(aws.StringValue(output.Images[0].BootMode))
+		if imageBootMode == "" {
+			imageBootMode = infrav1.BootModeLegacyBIOS
+			if aws.StringValue(output.Images[0].Architecture) == ec2.ArchitectureValuesArm64 {
+				imageBootMode = infrav1.BootModeUEFI
+			}
+		}
+
+		if imageBootMode != opts.BootMode {
+			return errors.Errorf("image %q boots in %q mode, but %q is required", imageID, imageBootMode, opts.BootMode)
+		}
+	}
+
+	return nil
+}
+
+// resolveNetworkInterfaceSpecs returns a copy of the network interface specs with their subnet and
+// security group references resolved to IDs.
+func (s *Service) resolveNetworkInterfaceSpecs(scope *scope.MachineScope, instanceSubnetID string, specs []infrav1.NetworkInterfaceSpec) ([]infrav1.NetworkInterfaceSpec, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	resolved := make([]infrav1.NetworkInterfaceSpec, 0, len(specs))
+	for _, spec := range specs {
+		if spec.Subnet != nil && (spec.Subnet.ID != nil || spec.Subnet.Filters != nil) {
+			subnetID, err := s.findNetworkInterfaceSubnet(scope, instanceSubnetID, spec.Subnet)
+			if err != nil {
+				return nil, err
+			}
+			spec.Subnet = &infrav1.AWSResourceReference{ID: aws.String(subnetID)}
+		}
+
+		groups, err := s.resolveSecurityGroupReferences(spec.SecurityGroups)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to look up security groups for network interface with device index %d", spec.DeviceIndex)
+		}
+		spec.SecurityGroups = groups
+
+		resolved = append(resolved, spec)
+	}
+
+	return resolved, nil
+}
+
+// resolveSecurityGroupReferences returns the security group references with filters resolved to IDs.
+func (s *Service) resolveSecurityGroupReferences(refs []infrav1.AWSResourceReference) ([]infrav1.AWSResourceReference, error) {
+	resolved := make([]infrav1.AWSResourceReference, 0, len(refs))
+	for _, sg := range refs {
+		if sg.ID != nil {
+			resolved = append(resolved, infrav1.AWSResourceReference{ID: sg.ID})
+		}
+		if sg.Filters != nil {
+			id, err := s.GetFilteredSecurityGroupID(sg)
+			if err != nil {
+				return nil, err
+			}
+			resolved = append(resolved, infrav1.AWSResourceReference{ID: aws.String(id)})
+		}
+	}
+	return resolved, nil
+}
+
+// findNetworkInterfaceSubnet looks up the subnet of a network interface, preferring subnets
+// in the availability zone of the instance subnet.
+func (s *Service) findNetworkInterfaceSubnet(scope *scope.MachineScope, instanceSubnetID string, ref *infrav1.AWSResourceReference) (string, error) {
+	criteria := []*ec2.Filter{
+		filter.EC2.SubnetStates(ec2.SubnetStatePending, ec2.SubnetStateAvailable),
+	}
+	if !scope.IsExternallyManaged() {
+		criteria = append(criteria, filter.EC2.VPC(s.scope.VPC().ID))
+	}
+	if subnet := s.scope.Subnets().FindByID(instanceSubnetID); subnet != nil && subnet.AvailabilityZone != "" {
+		criteria = append(criteria, filter.EC2.AvailabilityZone(subnet.AvailabilityZone))
+	}
+	if ref.ID != nil {
+		criteria = append(criteria, &ec2.Filter{Name: aws.String("subnet-id"), Values: aws.StringSlice([]string{*ref.ID})})
+	}
+	for _, f := range ref.Filters {
+		criteria = append(criteria, &ec2.Filter{Name: aws.String(f.Name), Values: aws.StringSlice(f.Values)})
+	}
+
+	subnets, err := s.getFilteredSubnets(criteria...)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to filter subnets for criteria %q", criteria)
+	}
+	if len(subnets) == 0 {
+		errMessage := fmt.Sprintf("failed to run machine %q, no subnets available for network interface matching criteria %q",
+			scope.Name(), criteria)
+		record.Warnf(scope.AWSMachine, "FailedCreate", errMessage)
+		return "", awserrors.NewFailedDependency(errMessage)
+	}
+
+	return aws.StringValue(subnets[0].SubnetId), nil
+}
+
+// networkInterfaceSecurityGroupIDs returns the security groups of the instance followed by the
+// additional security groups of the network interface.
+func networkInterfaceSecurityGroupIDs(instanceGroups []string, spec infrav1.NetworkInterfaceSpec) []string {
+	groups := make([]string, 0, len(instanceGroups)+len(spec.SecurityGroups))
+	groups = append(groups, instanceGroups...)
+	for _, sg := range spec.SecurityGroups {
+		if sg.ID != nil && !containsGroup(groups, *sg.ID) {
+			groups = append(groups, *sg.ID)
+		}
+	}
+	return groups
+}
+
+func networkInterfaceType(spec infrav1.NetworkInterfaceSpec) *string {
+	if spec.InterfaceType == "" {
+		return nil
+	}
+	return aws.String(string(spec.InterfaceType))
+}
+
+func networkInterfaceDeleteOnTermination(spec infrav1.NetworkInterfaceSpec) *bool {
+	if spec.DeleteOnTermination == nil {
+		return aws.Bool(true)
+	}
+	return spec.DeleteOnTermination
+}
+
 // filterGroups filters a list for a string.
 func filterGroups(list []string, strToFilter string) (newList []string) {
 	for _, item := range list {
@@ -961,6 +1392,26 @@ func containsGroup(list []string, strToSearch string) bool {
 	return false
 }
 
+// containsString returns true if a list contains a string.
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsInt64 returns true if a list contains a value.
+func containsInt64(list []*int64, value int64) bool {
+	for _, item := range list {
+		if aws.Int64Value(item) == value {
+			return true
+		}
+	}
+	return false
+}
+
 func getInstanceMarketOptionsRequest(spotMarketOptions *infrav1.SpotMarketOptions) *ec2.InstanceMarketOptionsRequest {
 	if spotMarketOptions == nil {
 		// Instance is not a Spot instance
@@ -227,6 +227,38 @@ func TestTerminateInstance(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:       "instance with termination protection",
+			instanceID: "i-protected",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				terminate := m.TerminateInstances(gomock.Eq(&ec2.TerminateInstancesInput{
+					InstanceIds: []*string{aws.String("i-protected")},
+				})).
+					Return(nil, awserr.New(awserrors.OperationNotPermitted, "The instance 'i-protected' may not be terminated.", nil))
+				modify := m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:            aws.String("i-protected"),
+					DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil).
+					After(terminate)
+				modifyStop := m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:     aws.String("i-protected"),
+					DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil).
+					After(modify)
+				m.TerminateInstances(gomock.Eq(&ec2.TerminateInstancesInput{
+					InstanceIds: []*string{aws.String("i-protected")},
+				})).
+					Return(&ec2.TerminateInstancesOutput{}, nil).
+					After(modifyStop)
+			},
+			check: func(err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
 		{
 			name:       "instance does not exist",
 			instanceID: "i-donotexist",
@@ -271,6 +303,130 @@ func TestTerminateInstance(t *testing.T) {
 	}
 }
 
+func TestSetInstanceProtection(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name   string
+		set    func(s *Service) error
+		expect func(m *mock_ec2iface.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "enables termination protection",
+			set: func(s *Service) error {
+				return s.SetTerminationProtection("i-1", true)
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:            aws.String("i-1"),
+					DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+		{
+			name: "disables termination protection",
+			set: func(s *Service) error {
+				return s.SetTerminationProtection("i-1", false)
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:            aws.String("i-1"),
+					DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+		{
+			name: "enables stop protection",
+			set: func(s *Service) error {
+				return s.SetStopProtection("i-1", true)
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:     aws.String("i-1"),
+					DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+		{
+			name: "disables stop protection",
+			set: func(s *Service) error {
+				return s.SetStopProtection("i-1", false)
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:     aws.String("i-1"),
+					DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatalf("failed to create scheme: %v", err)
+			}
+			clusterScope, err := setupClusterScope(fake.NewClientBuilder().WithScheme(scheme).Build())
+			if err != nil {
+				t.Fatalf("failed to create test context: %v", err)
+			}
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			if err := tc.set(s); err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetInstanceProtection(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeInstanceAttribute(gomock.Eq(&ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String("i-1"),
+		Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiTermination),
+	})).
+		Return(&ec2.DescribeInstanceAttributeOutput{DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}}, nil)
+	ec2Mock.EXPECT().DescribeInstanceAttribute(gomock.Eq(&ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String("i-1"),
+		Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiStop),
+	})).
+		Return(&ec2.DescribeInstanceAttributeOutput{DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}, nil)
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatalf("failed to create scheme: %v", err)
+	}
+	clusterScope, err := setupClusterScope(fake.NewClientBuilder().WithScheme(scheme).Build())
+	if err != nil {
+		t.Fatalf("failed to create test context: %v", err)
+	}
+
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	disableAPITermination, disableAPIStop, err := s.GetInstanceProtection("i-1")
+	if err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+	if !disableAPITermination || disableAPIStop {
+		t.Fatalf("expected termination protection only, got termination %t and stop %t", disableAPITermination, disableAPIStop)
+	}
+}
+
 func TestCreateInstance(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -557,6 +713,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				amiName, err := GenerateAmiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
@@ -687,6 +844,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				amiName, err := GenerateAmiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
@@ -818,6 +976,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				amiName, err := GenerateAmiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
@@ -2333,6 +2492,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -2442,6 +2602,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -2552,6 +2713,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -2662,6 +2824,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -2769,6 +2932,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -2876,6 +3040,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m5.large", ec2.ArchitectureTypeX8664)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -2930,121 +3095,660 @@ func TestCreateInstance(t *testing.T) {
 				}
 			},
 		},
-	}
-
-	for _, tc := range testcases {
-		t.Run(tc.name, func(t *testing.T) {
-			mockCtrl := gomock.NewController(t)
-			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
-
-			scheme, err := setupScheme()
-			if err != nil {
-				t.Fatalf("failed to create scheme: %v", err)
-			}
-
-			cluster := &clusterv1.Cluster{
+		{
+			name: "with resource-based hostnames from the subnet",
+			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "test1",
+					Labels: map[string]string{"set": "node"},
 				},
-				Spec: clusterv1.ClusterSpec{
-					ClusterNetwork: &clusterv1.ClusterNetwork{
-						ServiceDomain: "cluster.local",
-						Services: &clusterv1.NetworkRanges{
-							CIDRBlocks: []string{"192.168.0.0/16"},
-						},
-						Pods: &clusterv1.NetworkRanges{
-							CIDRBlocks: []string{"192.168.0.0/16"},
-						},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
 					},
 				},
-			}
-
-			machine := &tc.machine
-
-			awsMachine := &infrav1.AWSMachine{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "aws-test1",
-					OwnerReferences: []metav1.OwnerReference{
-						{
-							APIVersion: clusterv1.GroupVersion.String(),
-							Kind:       "Machine",
-							Name:       "test1",
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				PrivateDNSName: &infrav1.PrivateDNSName{
+					EnableResourceNameDNSAAAARecord: aws.Bool(true),
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+								PrivateDNSNameOptionsOnLaunch: &infrav1.PrivateDNSName{
+									HostnameType:                 infrav1.HostnameTypeResourceName,
+									EnableResourceNameDNSARecord: aws.Bool(true),
+								},
+							},
 						},
 					},
 				},
-			}
-
-			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster, machine).Build()
-			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-				Client:     client,
-				Cluster:    cluster,
-				AWSCluster: tc.awsCluster,
-			})
-			if err != nil {
-				t.Fatalf("Failed to create test context: %v", err)
-			}
-
-			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
-				Client:       client,
-				Cluster:      cluster,
-				Machine:      machine,
-				AWSMachine:   awsMachine,
-				InfraCluster: clusterScope,
-			})
-			if err != nil {
-				t.Fatalf("Failed to create test context: %v", err)
-			}
-			machineScope.AWSMachine.Spec = *tc.machineConfig
-			tc.expect(ec2Mock.EXPECT())
-
-			s := NewService(clusterScope)
-			s.EC2Client = ec2Mock
-
-			instance, err := s.CreateInstance(machineScope, data, "")
-			tc.check(instance, err)
-		})
-	}
-}
-
-func TestGetInstanceMarketOptionsRequest(t *testing.T) {
-	testCases := []struct {
-		name              string
-		spotMarketOptions *infrav1.SpotMarketOptions
-		expectedRequest   *ec2.InstanceMarketOptionsRequest
-	}{
-		{
-			name:              "with no Spot options specified",
-			spotMarketOptions: nil,
-			expectedRequest:   nil,
-		},
-		{
-			name:              "with an empty Spot options specified",
-			spotMarketOptions: &infrav1.SpotMarketOptions{},
-			expectedRequest: &ec2.InstanceMarketOptionsRequest{
-				MarketType: aws.String(ec2.MarketTypeSpot),
-				SpotOptions: &ec2.SpotMarketOptions{
-					InstanceInterruptionBehavior: aws.String(ec2.InstanceInterruptionBehaviorTerminate),
-					SpotInstanceType:             aws.String(ec2.SpotInstanceTypeOneTime),
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
 				},
 			},
-		},
-		{
-			name: "with an empty MaxPrice specified",
-			spotMarketOptions: &infrav1.SpotMarketOptions{
-				MaxPrice: aws.String(""),
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						expected := &ec2.PrivateDnsNameOptionsRequest{
+							HostnameType:                    aws.String("resource-name"),
+							EnableResourceNameDnsARecord:    aws.Bool(true),
+							EnableResourceNameDnsAAAARecord: aws.Bool(true),
+						}
+						if !cmp.Equal(input.PrivateDnsNameOptions, expected) {
+							t.Fatalf("Expected private DNS name options %+v, got %+v", expected, input.PrivateDnsNameOptions)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+									PrivateDnsNameOptions: &ec2.PrivateDnsNameOptionsResponse{
+										HostnameType:                    aws.String("resource-name"),
+										EnableResourceNameDnsARecord:    aws.Bool(true),
+										EnableResourceNameDnsAAAARecord: aws.Bool(true),
+									},
+								},
+							},
+						}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
 			},
-			expectedRequest: &ec2.InstanceMarketOptionsRequest{
-				MarketType: aws.String(ec2.MarketTypeSpot),
-				SpotOptions: &ec2.SpotMarketOptions{
-					InstanceInterruptionBehavior: aws.String(ec2.InstanceInterruptionBehaviorTerminate),
-					SpotInstanceType:             aws.String(ec2.SpotInstanceTypeOneTime),
-				},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+
+				expected := &infrav1.PrivateDNSName{
+					HostnameType:                    infrav1.HostnameTypeResourceName,
+					EnableResourceNameDNSARecord:    aws.Bool(true),
+					EnableResourceNameDNSAAAARecord: aws.Bool(true),
+				}
+				if !cmp.Equal(instance.PrivateDNSName, expected) {
+					t.Fatalf("Expected private DNS name options %+v, got %+v", expected, instance.PrivateDNSName)
+				}
 			},
 		},
 		{
-			name: "with a valid MaxPrice specified",
-			spotMarketOptions: &infrav1.SpotMarketOptions{
-				MaxPrice: aws.String("0.01"),
+			name: "with a launch template",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "m5.large",
+				IAMInstanceProfile: "foo",
+				UseLaunchTemplate:  true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateName: aws.String("default/test1/aws-test1"),
+					Versions:           aws.StringSlice([]string{"$Latest"}),
+				})).
+					Return(nil, awserr.New(awserrors.LaunchTemplateNameNotFound, "not found", nil))
+				m.CreateLaunchTemplate(gomock.AssignableToTypeOf(&ec2.CreateLaunchTemplateInput{})).
+					DoAndReturn(func(input *ec2.CreateLaunchTemplateInput) (*ec2.CreateLaunchTemplateOutput, error) {
+						if aws.StringValue(input.LaunchTemplateName) != "default/test1/aws-test1" {
+							t.Fatalf("expected launch template to be named after the AWSMachine, got %q", aws.StringValue(input.LaunchTemplateName))
+						}
+						data := input.LaunchTemplateData
+						if aws.StringValue(data.ImageId) != "abc" || aws.StringValue(data.InstanceType) != "m5.large" {
+							t.Fatalf("unexpected launch template data: %v", data)
+						}
+						if got := aws.StringValueSlice(data.SecurityGroupIds); !cmp.Equal(got, []string{"2", "3"}) {
+							t.Fatalf("expected core security groups in launch template, got %v", got)
+						}
+						return &ec2.CreateLaunchTemplateOutput{
+							LaunchTemplate: &ec2.LaunchTemplate{
+								LaunchTemplateId: aws.String("lt-1"),
+							},
+						}, nil
+					})
+				m.DescribeLaunchTemplateVersions(gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateName: aws.String("default/test1/aws-test1"),
+					Versions:           aws.StringSlice([]string{"$Latest"}),
+				})).
+					Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+						LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+							{
+								LaunchTemplateId:   aws.String("lt-1"),
+								LaunchTemplateName: aws.String("default/test1/aws-test1"),
+								VersionNumber:      aws.Int64(1),
+								LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+									ImageId:      aws.String("abc"),
+									InstanceType: aws.String("m5.large"),
+									IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{
+										Name: aws.String("foo"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if !cmp.Equal(input.LaunchTemplate, &ec2.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-1"),
+							Version:          aws.String("1"),
+						}) {
+							t.Fatalf("expected instance to be created from launch template version 1, got %v", input.LaunchTemplate)
+						}
+						if input.InstanceType != nil || input.ImageId != nil || input.UserData != nil || input.IamInstanceProfile != nil {
+							t.Fatalf("expected the settings of the launch template not to be passed again, got %v", input)
+						}
+						if aws.StringValue(input.SubnetId) != "subnet-1" {
+							t.Fatalf("expected the subnet of the instance to be passed, got %v", input.SubnetId)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with a launch template for an older AMI",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "m5.large",
+				IAMInstanceProfile: "foo",
+				UseLaunchTemplate:  true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				launchTemplateVersion := func(version int64, imageID string) *ec2.DescribeLaunchTemplateVersionsOutput {
+					return &ec2.DescribeLaunchTemplateVersionsOutput{
+						LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+							{
+								LaunchTemplateId:   aws.String("lt-1"),
+								LaunchTemplateName: aws.String("default/test1/aws-test1"),
+								VersionNumber:      aws.Int64(version),
+								LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+									ImageId:      aws.String(imageID),
+									InstanceType: aws.String("m5.large"),
+									IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{
+										Name: aws.String("foo"),
+									},
+									SecurityGroupIds: aws.StringSlice([]string{"2", "3"}),
+								},
+							},
+						},
+					}
+				}
+				byName := &ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateName: aws.String("default/test1/aws-test1"),
+					Versions:           aws.StringSlice([]string{"$Latest"}),
+				}
+				m.DescribeLaunchTemplateVersions(gomock.Eq(byName)).
+					Return(launchTemplateVersion(1, "old"), nil).Times(2)
+				m.DescribeLaunchTemplates(gomock.Eq(&ec2.DescribeLaunchTemplatesInput{
+					LaunchTemplateIds: aws.StringSlice([]string{"lt-1"}),
+				})).
+					Return(&ec2.DescribeLaunchTemplatesOutput{
+						LaunchTemplates: []*ec2.LaunchTemplate{
+							{
+								LaunchTemplateId: aws.String("lt-1"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.DescribeLaunchTemplateVersions(gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-1"),
+					MinVersion:       aws.String("0"),
+					MaxVersion:       aws.String("$Latest"),
+					MaxResults:       aws.Int64(3),
+				})).
+					Return(launchTemplateVersion(1, "old"), nil)
+				m.CreateLaunchTemplateVersion(gomock.AssignableToTypeOf(&ec2.CreateLaunchTemplateVersionInput{})).
+					DoAndReturn(func(input *ec2.CreateLaunchTemplateVersionInput) (*ec2.CreateLaunchTemplateVersionOutput, error) {
+						if aws.StringValue(input.LaunchTemplateId) != "lt-1" || aws.StringValue(input.LaunchTemplateData.ImageId) != "abc" {
+							t.Fatalf("expected a new version of launch template lt-1 with AMI abc, got %v", input)
+						}
+						return &ec2.CreateLaunchTemplateVersionOutput{}, nil
+					})
+				m.DescribeLaunchTemplateVersions(gomock.Eq(byName)).
+					Return(launchTemplateVersion(2, "abc"), nil)
+				m.RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if !cmp.Equal(input.LaunchTemplate, &ec2.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-1"),
+							Version:          aws.String("2"),
+						}) {
+							t.Fatalf("expected instance to be created from launch template version 2, got %v", input.LaunchTemplate)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with a launch template of the same name not owned by the cluster",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "m5.large",
+				IAMInstanceProfile: "foo",
+				UseLaunchTemplate:  true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateName: aws.String("default/test1/aws-test1"),
+					Versions:           aws.StringSlice([]string{"$Latest"}),
+				})).
+					Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+						LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+							{
+								LaunchTemplateId:   aws.String("lt-other"),
+								LaunchTemplateName: aws.String("default/test1/aws-test1"),
+								VersionNumber:      aws.Int64(1),
+								LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+									ImageId:      aws.String("abc"),
+									InstanceType: aws.String("m5.large"),
+									IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{
+										Name: aws.String("foo"),
+									},
+								},
+							},
+						},
+					}, nil).Times(2)
+				m.DescribeLaunchTemplates(gomock.Eq(&ec2.DescribeLaunchTemplatesInput{
+					LaunchTemplateIds: aws.StringSlice([]string{"lt-other"}),
+				})).
+					Return(&ec2.DescribeLaunchTemplatesOutput{
+						LaunchTemplates: []*ec2.LaunchTemplate{
+							{
+								LaunchTemplateId: aws.String("lt-other"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/other"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for a launch template not owned by the cluster")
+				}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			resetInstanceTypeCache()
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatalf("failed to create scheme: %v", err)
+			}
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test1",
+				},
+				Spec: clusterv1.ClusterSpec{
+					ClusterNetwork: &clusterv1.ClusterNetwork{
+						ServiceDomain: "cluster.local",
+						Services: &clusterv1.NetworkRanges{
+							CIDRBlocks: []string{"192.168.0.0/16"},
+						},
+						Pods: &clusterv1.NetworkRanges{
+							CIDRBlocks: []string{"192.168.0.0/16"},
+						},
+					},
+				},
+			}
+
+			machine := &tc.machine
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-test1",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "Machine",
+							Name:       "test1",
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster, machine).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    cluster,
+				AWSCluster: tc.awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      cluster,
+				Machine:      machine,
+				AWSMachine:   awsMachine,
+				InfraCluster: clusterScope,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+			machineScope.AWSMachine.Spec = *tc.machineConfig
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			instance, err := s.CreateInstance(machineScope, data, "")
+			tc.check(instance, err)
+		})
+	}
+}
+
+func TestInstanceAddresses(t *testing.T) {
+	instance := &infrav1.Instance{
+		ID: "i-1234",
+		Addresses: []clusterv1.MachineAddress{
+			{Type: clusterv1.MachineInternalDNS, Address: "ip-10-0-0-1.eu-west-1.compute.internal"},
+			{Type: clusterv1.MachineInternalIP, Address: "10.0.0.1"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		options  *infrav1.PrivateDNSName
+		region   string
+		expected []clusterv1.MachineAddress
+	}{
+		{
+			name:     "IP-based hostnames",
+			options:  &infrav1.PrivateDNSName{HostnameType: infrav1.HostnameTypeIPName},
+			region:   "eu-west-1",
+			expected: instance.Addresses,
+		},
+		{
+			name:    "resource-based hostnames without DNS records",
+			options: &infrav1.PrivateDNSName{HostnameType: infrav1.HostnameTypeResourceName},
+			region:  "eu-west-1",
+			expected: append([]clusterv1.MachineAddress{
+				{Type: clusterv1.MachineHostName, Address: "i-1234.eu-west-1.compute.internal"},
+			}, instance.Addresses...),
+		},
+		{
+			name:    "resource-based hostnames with DNS records in us-east-1",
+			options: &infrav1.PrivateDNSName{HostnameType: infrav1.HostnameTypeResourceName, EnableResourceNameDNSARecord: aws.Bool(true)},
+			region:  "us-east-1",
+			expected: append([]clusterv1.MachineAddress{
+				{Type: clusterv1.MachineHostName, Address: "i-1234.ec2.internal"},
+				{Type: clusterv1.MachineInternalDNS, Address: "i-1234.ec2.internal"},
+			}, instance.Addresses...),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if addresses := InstanceAddresses(instance, tc.options, tc.region); !cmp.Equal(addresses, tc.expected) {
+				t.Fatalf("Expected addresses %v, got %v", tc.expected, addresses)
+			}
+		})
+	}
+}
+
+func TestGetInstanceMarketOptionsRequest(t *testing.T) {
+	testCases := []struct {
+		name              string
+		spotMarketOptions *infrav1.SpotMarketOptions
+		expectedRequest   *ec2.InstanceMarketOptionsRequest
+	}{
+		{
+			name:              "with no Spot options specified",
+			spotMarketOptions: nil,
+			expectedRequest:   nil,
+		},
+		{
+			name:              "with an empty Spot options specified",
+			spotMarketOptions: &infrav1.SpotMarketOptions{},
+			expectedRequest: &ec2.InstanceMarketOptionsRequest{
+				MarketType: aws.String(ec2.MarketTypeSpot),
+				SpotOptions: &ec2.SpotMarketOptions{
+					InstanceInterruptionBehavior: aws.String(ec2.InstanceInterruptionBehaviorTerminate),
+					SpotInstanceType:             aws.String(ec2.SpotInstanceTypeOneTime),
+				},
+			},
+		},
+		{
+			name: "with an empty MaxPrice specified",
+			spotMarketOptions: &infrav1.SpotMarketOptions{
+				MaxPrice: aws.String(""),
+			},
+			expectedRequest: &ec2.InstanceMarketOptionsRequest{
+				MarketType: aws.String(ec2.MarketTypeSpot),
+				SpotOptions: &ec2.SpotMarketOptions{
+					InstanceInterruptionBehavior: aws.String(ec2.InstanceInterruptionBehaviorTerminate),
+					SpotInstanceType:             aws.String(ec2.SpotInstanceTypeOneTime),
+				},
+			},
+		},
+		{
+			name: "with a valid MaxPrice specified",
+			spotMarketOptions: &infrav1.SpotMarketOptions{
+				MaxPrice: aws.String("0.01"),
 			},
 			expectedRequest: &ec2.InstanceMarketOptionsRequest{
 				MarketType: aws.String(ec2.MarketTypeSpot),
@@ -3200,3 +3904,142 @@ func TestGetFilteredSecurityGroupID(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckInstanceOptions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	m5Large := &ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			{
+				InstanceType:         aws.String("m5.large"),
+				HibernationSupported: aws.Bool(true),
+				Hypervisor:           aws.String(ec2.InstanceTypeHypervisorNitro),
+				SupportedBootModes:   aws.StringSlice([]string{ec2.BootModeTypeLegacyBios, ec2.BootModeTypeUefi}),
+				NetworkInfo: &ec2.NetworkInfo{
+					EfaSupported:             aws.Bool(false),
+					MaximumNetworkInterfaces: aws.Int64(3),
+				},
+				VCpuInfo: &ec2.VCpuInfo{
+					ValidCores:          aws.Int64Slice([]int64{1}),
+					ValidThreadsPerCore: aws.Int64Slice([]int64{1, 2}),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		opts      infrav1.InstanceOptions
+		expect    func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name:   "does not call AWS when no options are set",
+			opts:   infrav1.InstanceOptions{InstanceType: "m5.large"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "accepts cpu options supported by the instance type",
+			opts: infrav1.InstanceOptions{
+				InstanceType: "m5.large",
+				CPUOptions:   &infrav1.CPUOptions{CoreCount: 1, ThreadsPerCore: 1},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(m5Large, nil)
+			},
+		},
+		{
+			name: "rejects a core count not supported by the instance type",
+			opts: infrav1.InstanceOptions{
+				InstanceType: "m5.large",
+				CPUOptions:   &infrav1.CPUOptions{CoreCount: 4, ThreadsPerCore: 1},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(m5Large, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "rejects an Elastic Fabric Adapter on an instance type without EFA support",
+			opts: infrav1.InstanceOptions{
+				InstanceType: "m5.large",
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{DeviceIndex: 0, InterfaceType: infrav1.NetworkInterfaceTypeEFA},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(m5Large, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "rejects more network interfaces than the instance type supports",
+			opts: infrav1.InstanceOptions{
+				InstanceType: "m5.large",
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{DeviceIndex: 0}, {DeviceIndex: 1}, {DeviceIndex: 2}, {DeviceIndex: 3},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(m5Large, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "accepts an image booting in the requested mode",
+			opts: infrav1.InstanceOptions{
+				InstanceType: "m5.large",
+				BootMode:     infrav1.BootModeUEFI,
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(m5Large, nil)
+				m.DescribeImages(gomock.Eq(&ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{"ami-1"})})).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{{ImageId: aws.String("ami-1"), BootMode: aws.String(ec2.BootModeValuesUefi)}},
+					}, nil)
+			},
+		},
+		{
+			name: "rejects an image defaulting to a different boot mode",
+			opts: infrav1.InstanceOptions{
+				InstanceType: "m5.large",
+				BootMode:     infrav1.BootModeUEFI,
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).Return(m5Large, nil)
+				m.DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{{ImageId: aws.String("ami-1"), Architecture: aws.String(ec2.ArchitectureValuesX8664)}},
+					}, nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resetInstanceTypeCache()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatalf("failed to create scheme: %v", err)
+			}
+			clusterScope, err := setupClusterScope(fake.NewClientBuilder().WithScheme(scheme).Build())
+			if err != nil {
+				t.Fatalf("failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.checkInstanceOptions("ami-1", tc.opts)
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ReconcileInstanceStatus reports the EC2 status checks and the scheduled events of the
+// instance as the InstanceReachable and NoScheduledEvents conditions of the AWSMachine.
+func (s *Service) ReconcileInstanceStatus(scope *scope.MachineScope, instance *infrav1.Instance) error {
+	out, err := s.EC2Client.DescribeInstanceStatus(&ec2.DescribeInstanceStatusInput{
+		InstanceIds: aws.StringSlice([]string{instance.ID}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe status of instance %q", instance.ID)
+	}
+
+	if len(out.InstanceStatuses) == 0 {
+		s.scope.V(4).Info("No status reported for instance yet", "instance-id", instance.ID)
+		return nil
+	}
+
+	SetInstanceStatusConditions(scope.AWSMachine, out.InstanceStatuses[0])
+
+	return nil
+}
+
+// SetInstanceStatusConditions sets the InstanceReachable and NoScheduledEvents conditions of the AWSMachine
+// from the status of its instance.
+func SetInstanceStatusConditions(machine *infrav1.AWSMachine, status *ec2.InstanceStatus) {
+	setInstanceReachableCondition(machine, status)
+	setNoScheduledEventsCondition(machine, status)
+}
+
+// ClearInstanceStatusConditions marks the InstanceReachable and NoScheduledEvents conditions of the AWSMachine
+// as unknown while its instance is stopped, as EC2 does not report the status of stopped instances.
+func ClearInstanceStatusConditions(machine *infrav1.AWSMachine) {
+	conditions.MarkUnknown(machine, infrav1.InstanceReachableCondition, infrav1.InstanceStoppedReason, "")
+	conditions.MarkUnknown(machine, infrav1.NoScheduledEventsCondition, infrav1.InstanceStoppedReason, "")
+}
+
+func setInstanceReachableCondition(machine *infrav1.AWSMachine, status *ec2.InstanceStatus) {
+	wasReachable := !conditions.IsFalse(machine, infrav1.InstanceReachableCondition)
+
+	systemStatus := instanceStatusSummaryStatus(status.SystemStatus)
+	instanceStatus := instanceStatusSummaryStatus(status.InstanceStatus)
+
+	switch {
+	case systemStatus == ec2.SummaryStatusImpaired:
+		conditions.MarkFalse(machine, infrav1.InstanceReachableCondition, infrav1.SystemStatusImpairedReason, clusterv1.ConditionSeverityError,
+			"system status check failed%s", impairedSince(status.SystemStatus))
+	case instanceStatus == ec2.SummaryStatusImpaired:
+		conditions.MarkFalse(machine, infrav1.InstanceReachableCondition, infrav1.InstanceStatusImpairedReason, clusterv1.ConditionSeverityError,
+			"instance status check failed%s", impairedSince(status.InstanceStatus))
+	case systemStatus == ec2.SummaryStatusInitializing || instanceStatus == ec2.SummaryStatusInitializing:
+		conditions.MarkFalse(machine, infrav1.InstanceReachableCondition, infrav1.InstanceStatusChecksInitializingReason, clusterv1.ConditionSeverityInfo, "")
+		return
+	case systemStatus == ec2.SummaryStatusOk && instanceStatus == ec2.SummaryStatusOk:
+		conditions.MarkTrue(machine, infrav1.InstanceReachableCondition)
+		return
+	default:
+		conditions.MarkUnknown(machine, infrav1.InstanceReachableCondition, infrav1.InstanceStatusUnavailableReason, "system status %q, instance status %q", systemStatus, instanceStatus)
+		return
+	}
+
+	if wasReachable {
+		record.Warnf(machine, conditions.GetReason(machine, infrav1.InstanceReachableCondition), "EC2 %s", conditions.GetMessage(machine, infrav1.InstanceReachableCondition))
+	}
+}
+
+func setNoScheduledEventsCondition(machine *infrav1.AWSMachine, status *ec2.InstanceStatus) {
+	var events []string
+	for _, event := range status.Events {
+		description := aws.StringValue(event.Description)
+		// Completed and canceled events stay listed for some time with their description prefixed.
+		if strings.HasPrefix(description, "[Completed]") || strings.HasPrefix(description, "[Canceled]") {
+			continue
+		}
+		events = append(events, fmt.Sprintf("%s from %s to %s: %s", aws.StringValue(event.Code),
+			formatEventTime(event.NotBefore), formatEventTime(event.NotAfter), description))
+	}
+
+	if len(events) == 0 {
+		conditions.MarkTrue(machine, infrav1.NoScheduledEventsCondition)
+		return
+	}
+
+	message := strings.Join(events, "; ")
+	if conditions.GetMessage(machine, infrav1.NoScheduledEventsCondition) != message {
+		record.Warnf(machine, infrav1.InstanceEventScheduledReason, "EC2 scheduled events for instance: %s", message)
+	}
+	conditions.MarkFalse(machine, infrav1.NoScheduledEventsCondition, infrav1.InstanceEventScheduledReason, clusterv1.ConditionSeverityWarning, "%s", message)
+}
+
+func instanceStatusSummaryStatus(summary *ec2.InstanceStatusSummary) string {
+	if summary == nil {
+		return ""
+	}
+	return aws.StringValue(summary.Status)
+}
+
+// impairedSince returns when the failing status check started failing, if reported.
+func impairedSince(summary *ec2.InstanceStatusSummary) string {
+	for _, detail := range summary.Details {
+		if detail.ImpairedSince != nil {
+			return " since " + formatEventTime(detail.ImpairedSince)
+		}
+	}
+	return ""
+}
+
+func formatEventTime(t *time.Time) string {
+	if t == nil {
+		return "unspecified"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
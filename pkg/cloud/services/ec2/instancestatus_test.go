@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileInstanceStatus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	notBefore := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(2 * time.Hour)
+
+	summary := func(status string) *ec2.InstanceStatusSummary {
+		return &ec2.InstanceStatusSummary{Status: aws.String(status)}
+	}
+
+	testCases := []struct {
+		name                  string
+		status                *ec2.InstanceStatus
+		expectReachable       corev1.ConditionStatus
+		expectReachableReason string
+		expectNoEvents        corev1.ConditionStatus
+		expectEventsMessage   string
+	}{
+		{
+			name: "healthy instance without events",
+			status: &ec2.InstanceStatus{
+				SystemStatus:   summary(ec2.SummaryStatusOk),
+				InstanceStatus: summary(ec2.SummaryStatusOk),
+			},
+			expectReachable: corev1.ConditionTrue,
+			expectNoEvents:  corev1.ConditionTrue,
+		},
+		{
+			name: "failing system status check",
+			status: &ec2.InstanceStatus{
+				SystemStatus: &ec2.InstanceStatusSummary{
+					Status: aws.String(ec2.SummaryStatusImpaired),
+					Details: []*ec2.InstanceStatusDetails{
+						{Name: aws.String("reachability"), Status: aws.String("failed"), ImpairedSince: aws.Time(notBefore)},
+					},
+				},
+				InstanceStatus: summary(ec2.SummaryStatusOk),
+			},
+			expectReachable:       corev1.ConditionFalse,
+			expectReachableReason: infrav1.SystemStatusImpairedReason,
+			expectNoEvents:        corev1.ConditionTrue,
+		},
+		{
+			name: "failing instance status check",
+			status: &ec2.InstanceStatus{
+				SystemStatus:   summary(ec2.SummaryStatusOk),
+				InstanceStatus: summary(ec2.SummaryStatusImpaired),
+			},
+			expectReachable:       corev1.ConditionFalse,
+			expectReachableReason: infrav1.InstanceStatusImpairedReason,
+			expectNoEvents:        corev1.ConditionTrue,
+		},
+		{
+			name: "initializing status checks",
+			status: &ec2.InstanceStatus{
+				SystemStatus:   summary(ec2.SummaryStatusOk),
+				InstanceStatus: summary(ec2.SummaryStatusInitializing),
+			},
+			expectReachable:       corev1.ConditionFalse,
+			expectReachableReason: infrav1.InstanceStatusChecksInitializingReason,
+			expectNoEvents:        corev1.ConditionTrue,
+		},
+		{
+			name: "inconclusive status checks",
+			status: &ec2.InstanceStatus{
+				SystemStatus:   summary(ec2.SummaryStatusInsufficientData),
+				InstanceStatus: summary(ec2.SummaryStatusOk),
+			},
+			expectReachable:       corev1.ConditionUnknown,
+			expectReachableReason: infrav1.InstanceStatusUnavailableReason,
+			expectNoEvents:        corev1.ConditionTrue,
+		},
+		{
+			name: "scheduled retirement",
+			status: &ec2.InstanceStatus{
+				SystemStatus:   summary(ec2.SummaryStatusOk),
+				InstanceStatus: summary(ec2.SummaryStatusOk),
+				Events: []*ec2.InstanceStatusEvent{
+					{
+						Code:        aws.String(ec2.EventCodeInstanceRetirement),
+						Description: aws.String("The instance is running on degraded hardware"),
+						NotBefore:   aws.Time(notBefore),
+						NotAfter:    aws.Time(notAfter),
+					},
+					{
+						Code:        aws.String(ec2.EventCodeSystemReboot),
+						Description: aws.String("[Completed] Scheduled reboot"),
+						NotBefore:   aws.Time(notBefore),
+					},
+				},
+			},
+			expectReachable:     corev1.ConditionTrue,
+			expectNoEvents:      corev1.ConditionFalse,
+			expectEventsMessage: "instance-retirement from 2022-03-01T10:00:00Z to 2022-03-01T12:00:00Z: The instance is running on degraded hardware",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      newCluster(),
+				Machine:      &clusterv1.Machine{},
+				InfraCluster: clusterScope,
+				AWSMachine:   &infrav1.AWSMachine{ObjectMeta: metav1.ObjectMeta{Name: "aws-test1"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ec2Mock.EXPECT().DescribeInstanceStatus(gomock.Eq(&ec2.DescribeInstanceStatusInput{
+				InstanceIds: aws.StringSlice([]string{"i-1"}),
+			})).Return(&ec2.DescribeInstanceStatusOutput{InstanceStatuses: []*ec2.InstanceStatus{tc.status}}, nil)
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.ReconcileInstanceStatus(machineScope, &infrav1.Instance{ID: "i-1"})).To(Succeed())
+
+			reachable := conditions.Get(machineScope.AWSMachine, infrav1.InstanceReachableCondition)
+			g.Expect(reachable).NotTo(BeNil())
+			g.Expect(reachable.Status).To(Equal(tc.expectReachable))
+			g.Expect(reachable.Reason).To(Equal(tc.expectReachableReason))
+
+			noEvents := conditions.Get(machineScope.AWSMachine, infrav1.NoScheduledEventsCondition)
+			g.Expect(noEvents).NotTo(BeNil())
+			g.Expect(noEvents.Status).To(Equal(tc.expectNoEvents))
+			g.Expect(noEvents.Message).To(Equal(tc.expectEventsMessage))
+		})
+	}
+}
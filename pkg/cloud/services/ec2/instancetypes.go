@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+)
+
+// instanceTypeCacheTTL is how long the description of an instance type is cached. Instance types
+// rarely change, but new capabilities are picked up eventually.
+const instanceTypeCacheTTL = 24 * time.Hour
+
+type cachedInstanceType struct {
+	info    *ec2.InstanceTypeInfo
+	expires time.Time
+}
+
+// instanceTypeCache caches the descriptions of instance types by region and instance type. It is
+// shared by the whole process, as a Service is created for every reconcile.
+var instanceTypeCache = struct {
+	sync.Mutex
+	entries map[string]cachedInstanceType
+}{entries: map[string]cachedInstanceType{}}
+
+// describeInstanceType returns the description of the instance type in the region of the cluster,
+// looking it up with DescribeInstanceTypes unless it is cached.
+func (s *Service) describeInstanceType(instanceType string) (*ec2.InstanceTypeInfo, error) {
+	key := s.scope.Region() + "/" + instanceType
+
+	instanceTypeCache.Lock()
+	cached, ok := instanceTypeCache.entries[key]
+	instanceTypeCache.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.info, nil
+	}
+
+	out, err := s.EC2Client.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{instanceType}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+
+	if len(out.InstanceTypes) == 0 {
+		return nil, errors.Errorf("no instance types returned when looking up %q", instanceType)
+	}
+	info := out.InstanceTypes[0]
+
+	instanceTypeCache.Lock()
+	instanceTypeCache.entries[key] = cachedInstanceType{info: info, expires: time.Now().Add(instanceTypeCacheTTL)}
+	instanceTypeCache.Unlock()
+
+	return info, nil
+}
@@ -92,7 +92,7 @@ func (s *Service) GetLaunchTemplateID(launchTemplateName string) (string, error)
 }
 
 // CreateLaunchTemplate generates a launch template to be used with the autoscaling group.
-func (s *Service) CreateLaunchTemplate(scope *scope.MachinePoolScope, imageID *string, userData []byte) (string, error) {
+func (s *Service) CreateLaunchTemplate(scope scope.LaunchTemplateScope, imageID *string, userData []byte) (string, error) {
 	s.scope.Info("Create a new launch template")
 
 	launchTemplateData, err := s.createLaunchTemplateData(scope, imageID, userData)
@@ -102,7 +102,7 @@ func (s *Service) CreateLaunchTemplate(scope *scope.MachinePoolScope, imageID *s
 
 	input := &ec2.CreateLaunchTemplateInput{
 		LaunchTemplateData: launchTemplateData,
-		LaunchTemplateName: aws.String(scope.Name()),
+		LaunchTemplateName: aws.String(scope.LaunchTemplateName()),
 	}
 
 	additionalTags := scope.AdditionalTags()
@@ -113,7 +113,7 @@ func (s *Service) CreateLaunchTemplate(scope *scope.MachinePoolScope, imageID *s
 		ClusterName: s.scope.Name(),
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
 		Name:        aws.String(scope.Name()),
-		Role:        aws.String("node"),
+		Role:        aws.String(launchTemplateRole(scope)),
 		Additional:  additionalTags,
 	})
 
@@ -136,8 +136,8 @@ func (s *Service) CreateLaunchTemplate(scope *scope.MachinePoolScope, imageID *s
 }
 
 // CreateLaunchTemplateVersion will create a launch template.
-func (s *Service) CreateLaunchTemplateVersion(scope *scope.MachinePoolScope, imageID *string, userData []byte) error {
-	s.scope.V(2).Info("creating new launch template version", "machine-pool", scope.Name())
+func (s *Service) CreateLaunchTemplateVersion(scope scope.LaunchTemplateScope, imageID *string, userData []byte) error {
+	s.scope.V(2).Info("creating new launch template version", "name", scope.LaunchTemplateName())
 
 	launchTemplateData, err := s.createLaunchTemplateData(scope, imageID, userData)
 	if err != nil {
@@ -146,7 +146,7 @@ func (s *Service) CreateLaunchTemplateVersion(scope *scope.MachinePoolScope, ima
 
 	input := &ec2.CreateLaunchTemplateVersionInput{
 		LaunchTemplateData: launchTemplateData,
-		LaunchTemplateId:   aws.String(scope.AWSMachinePool.Status.LaunchTemplateID),
+		LaunchTemplateId:   aws.String(scope.GetLaunchTemplateIDStatus()),
 	}
 
 	_, err = s.EC2Client.CreateLaunchTemplateVersion(input)
@@ -157,8 +157,35 @@ func (s *Service) CreateLaunchTemplateVersion(scope *scope.MachinePoolScope, ima
 	return nil
 }
 
-func (s *Service) createLaunchTemplateData(scope *scope.MachinePoolScope, imageID *string, userData []byte) (*ec2.RequestLaunchTemplateData, error) {
-	lt := scope.AWSMachinePool.Spec.AWSLaunchTemplate
+// launchTemplatePrivateDNSName returns the effective private DNS name options of a launch template.
+// EKS nodes must use IP-based hostnames, which are enforced if a cluster subnet defaults to resource-based hostnames.
+func (s *Service) launchTemplatePrivateDNSName(scope scope.LaunchTemplateScope, options *infrav1.PrivateDNSName) (*infrav1.PrivateDNSName, error) {
+	if !scope.IsEKSManaged() {
+		return options, nil
+	}
+
+	if options != nil && options.HostnameType == infrav1.HostnameTypeResourceName {
+		return nil, errors.New("resource-based hostnames are not supported by EKS nodes")
+	}
+
+	if options == nil || options.HostnameType == "" {
+		for _, subnet := range s.scope.Subnets() {
+			if subnet.PrivateDNSNameOptionsOnLaunch != nil && subnet.PrivateDNSNameOptionsOnLaunch.HostnameType == infrav1.HostnameTypeResourceName {
+				options = options.DeepCopy()
+				if options == nil {
+					options = &infrav1.PrivateDNSName{}
+				}
+				options.HostnameType = infrav1.HostnameTypeIPName
+				break
+			}
+		}
+	}
+
+	return options, nil
+}
+
+func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imageID *string, userData []byte) (*ec2.RequestLaunchTemplateData, error) {
+	lt := scope.GetLaunchTemplate()
 
 	// An explicit empty string for SSHKeyName means do not specify a key in the ASG launch
 	var sshKeyNamePtr *string
@@ -175,7 +202,7 @@ func (s *Service) createLaunchTemplateData(scope *scope.MachinePoolScope, imageI
 		UserData: pointer.StringPtr(base64.StdEncoding.EncodeToString(userData)),
 	}
 
-	ids, err := s.GetCoreNodeSecurityGroups(scope)
+	ids, err := s.getLaunchTemplateCoreSecurityGroups(scope)
 	if err != nil {
 		return nil, err
 	}
@@ -185,13 +212,94 @@ func (s *Service) createLaunchTemplateData(scope *scope.MachinePoolScope, imageI
 	}
 
 	// add additional security groups as well
-	for _, additionalGroup := range scope.AWSMachinePool.Spec.AWSLaunchTemplate.AdditionalSecurityGroups {
+	for _, additionalGroup := range lt.AdditionalSecurityGroups {
 		data.SecurityGroupIds = append(data.SecurityGroupIds, additionalGroup.ID)
 	}
 
+	if len(lt.NetworkInterfaceSpecs) > 0 {
+		// The security groups of a launch template with network interfaces are set on each interface.
+		instanceGroups := aws.StringValueSlice(data.SecurityGroupIds)
+		data.SecurityGroupIds = nil
+
+		for _, spec := range lt.NetworkInterfaceSpecs {
+			groups, err := s.resolveSecurityGroupReferences(spec.SecurityGroups)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to look up security groups for network interface with device index %d", spec.DeviceIndex)
+			}
+			spec.SecurityGroups = groups
+
+			data.NetworkInterfaces = append(data.NetworkInterfaces, &ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+				DeviceIndex:                    aws.Int64(spec.DeviceIndex),
+				InterfaceType:                  networkInterfaceType(spec),
+				Groups:                         aws.StringSlice(networkInterfaceSecurityGroupIDs(instanceGroups, spec)),
+				SecondaryPrivateIpAddressCount: spec.SecondaryPrivateIPAddressCount,
+				Ipv4PrefixCount:                spec.IPv4PrefixCount,
+				DeleteOnTermination:            networkInterfaceDeleteOnTermination(spec),
+			})
+		}
+	}
+
 	// set the AMI ID
 	data.ImageId = imageID
 
+	if err := s.checkInstanceOptions(aws.StringValue(imageID), infrav1.InstanceOptions{
+		InstanceType:          lt.InstanceType,
+		CPUOptions:            lt.CPUOptions,
+		HibernationOptions:    lt.HibernationOptions,
+		EnclaveOptions:        lt.EnclaveOptions,
+		BootMode:              lt.BootMode,
+		NetworkInterfaceSpecs: lt.NetworkInterfaceSpecs,
+	}); err != nil {
+		return nil, err
+	}
+
+	if lt.CPUOptions != nil {
+		data.CpuOptions = &ec2.LaunchTemplateCpuOptionsRequest{
+			CoreCount:      aws.Int64(lt.CPUOptions.CoreCount),
+			ThreadsPerCore: aws.Int64(lt.CPUOptions.ThreadsPerCore),
+		}
+	}
+
+	if lt.HibernationOptions != nil {
+		data.HibernationOptions = &ec2.LaunchTemplateHibernationOptionsRequest{
+			Configured: aws.Bool(lt.HibernationOptions.Configured),
+		}
+	}
+
+	if lt.EnclaveOptions != nil {
+		data.EnclaveOptions = &ec2.LaunchTemplateEnclaveOptionsRequest{
+			Enabled: aws.Bool(lt.EnclaveOptions.Enabled),
+		}
+	}
+
+	privateDNSName, err := s.launchTemplatePrivateDNSName(scope, lt.PrivateDNSName)
+	if err != nil {
+		return nil, err
+	}
+	if privateDNSName != nil {
+		data.PrivateDnsNameOptions = &ec2.LaunchTemplatePrivateDnsNameOptionsRequest{
+			EnableResourceNameDnsARecord:    privateDNSName.EnableResourceNameDNSARecord,
+			EnableResourceNameDnsAAAARecord: privateDNSName.EnableResourceNameDNSAAAARecord,
+		}
+		if privateDNSName.HostnameType != "" {
+			data.PrivateDnsNameOptions.HostnameType = aws.String(string(privateDNSName.HostnameType))
+		}
+	}
+
+	if lt.Tenancy != "" {
+		data.Placement = &ec2.LaunchTemplatePlacementRequest{
+			Tenancy:              aws.String(lt.Tenancy),
+			HostId:               lt.HostID,
+			HostResourceGroupArn: lt.HostResourceGroupArn,
+		}
+		if lt.HostAffinity != "" {
+			data.Placement.Affinity = aws.String(lt.HostAffinity)
+		}
+	}
+
+	data.DisableApiTermination = lt.DisableAPITermination
+	data.DisableApiStop = lt.DisableAPIStop
+
 	// Set up root volume
 	if lt.RootVolume != nil {
 		rootDeviceName, err := s.checkRootVolume(lt.RootVolume, *data.ImageId)
@@ -236,12 +344,110 @@ func volumeToLaunchTemplateBlockDeviceMappingRequest(v *infrav1.Volume) *ec2.Lau
 		ltEbsDevice.VolumeType = aws.String(string(v.Type))
 	}
 
+	if v.SnapshotID != "" {
+		ltEbsDevice.SnapshotId = aws.String(v.SnapshotID)
+	}
+
+	if v.DeleteOnTermination != nil {
+		ltEbsDevice.DeleteOnTermination = v.DeleteOnTermination
+	}
+
 	return &ec2.LaunchTemplateBlockDeviceMappingRequest{
 		DeviceName: &v.DeviceName,
 		Ebs:        ltEbsDevice,
 	}
 }
 
+// reconcileMachineLaunchTemplate makes sure the launch template of the AWSMachine describes the instance
+// about to be created, and returns a reference to its latest version.
+// The instance parameters are still passed to RunInstances and take precedence over the launch template.
+func (s *Service) reconcileMachineLaunchTemplate(scope *scope.MachineScope, imageID *string, userData []byte) (*ec2.LaunchTemplateSpecification, error) {
+	launchTemplateName := scope.LaunchTemplateName()
+	launchTemplate, launchTemplateUserDataHash, err := s.GetLaunchTemplate(launchTemplateName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get launch template")
+	}
+
+	switch {
+	case launchTemplate == nil:
+		launchTemplateID, err := s.CreateLaunchTemplate(scope, imageID, userData)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create launch template")
+		}
+		scope.SetLaunchTemplateIDStatus(launchTemplateID)
+	default:
+		// The status is blank after a move, look up the ID of the existing launch template.
+		if scope.GetLaunchTemplateIDStatus() == "" {
+			launchTemplateID, err := s.GetLaunchTemplateID(launchTemplateName)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get launch template ID")
+			}
+			scope.SetLaunchTemplateIDStatus(launchTemplateID)
+		}
+
+		owned, err := s.IsLaunchTemplateOwned(scope.GetLaunchTemplateIDStatus())
+		if err != nil {
+			return nil, err
+		}
+		if !owned {
+			return nil, errors.Errorf("launch template %q is not owned by cluster %q", launchTemplateName, s.scope.Name())
+		}
+
+		needsUpdate, err := s.LaunchTemplateNeedsUpdate(scope, scope.GetLaunchTemplate(), launchTemplate)
+		if err != nil {
+			return nil, err
+		}
+
+		if needsUpdate || aws.StringValue(imageID) != aws.StringValue(launchTemplate.AMI.ID) || launchTemplateUserDataHash != userdata.ComputeHash(userData) {
+			if err := s.PruneLaunchTemplateVersions(scope.GetLaunchTemplateIDStatus()); err != nil {
+				return nil, err
+			}
+			if err := s.CreateLaunchTemplateVersion(scope, imageID, userData); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	launchTemplate, _, err = s.GetLaunchTemplate(launchTemplateName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get launch template")
+	}
+	if launchTemplate == nil {
+		return nil, errors.Errorf("launch template %q not found", launchTemplateName)
+	}
+
+	version := strconv.FormatInt(aws.Int64Value(launchTemplate.VersionNumber), 10)
+	scope.SetLaunchTemplateVersionStatus(version)
+
+	return &ec2.LaunchTemplateSpecification{
+		LaunchTemplateId: aws.String(scope.GetLaunchTemplateIDStatus()),
+		Version:          aws.String(version),
+	}, nil
+}
+
+// IsLaunchTemplateOwned returns whether the launch template is tagged as owned by the cluster.
+// It returns false if the launch template does not exist.
+func (s *Service) IsLaunchTemplateOwned(id string) (bool, error) {
+	out, err := s.EC2Client.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: aws.StringSlice([]string{id}),
+	})
+	switch {
+	case awserrors.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, errors.Wrapf(err, "failed to describe launch template %q", id)
+	}
+
+	for _, lt := range out.LaunchTemplates {
+		for _, tag := range lt.Tags {
+			if aws.StringValue(tag.Key) == infrav1.ClusterTagKey(s.scope.Name()) && aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 // DeleteLaunchTemplate delete a launch template.
 func (s *Service) DeleteLaunchTemplate(id string) error {
 	s.scope.V(2).Info("Deleting launch template", "id", id)
@@ -337,6 +543,58 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 		}
 	}
 
+	if v.CpuOptions != nil {
+		i.CPUOptions = &infrav1.CPUOptions{
+			CoreCount:      aws.Int64Value(v.CpuOptions.CoreCount),
+			ThreadsPerCore: aws.Int64Value(v.CpuOptions.ThreadsPerCore),
+		}
+	}
+
+	if v.HibernationOptions != nil {
+		i.HibernationOptions = &infrav1.HibernationOptions{
+			Configured: aws.BoolValue(v.HibernationOptions.Configured),
+		}
+	}
+
+	if v.EnclaveOptions != nil {
+		i.EnclaveOptions = &infrav1.EnclaveOptions{
+			Enabled: aws.BoolValue(v.EnclaveOptions.Enabled),
+		}
+	}
+
+	if v.PrivateDnsNameOptions != nil {
+		i.PrivateDNSName = &infrav1.PrivateDNSName{
+			HostnameType:                    infrav1.HostnameType(aws.StringValue(v.PrivateDnsNameOptions.HostnameType)),
+			EnableResourceNameDNSARecord:    v.PrivateDnsNameOptions.EnableResourceNameDnsARecord,
+			EnableResourceNameDNSAAAARecord: v.PrivateDnsNameOptions.EnableResourceNameDnsAAAARecord,
+		}
+	}
+
+	if v.Placement != nil {
+		i.Tenancy = aws.StringValue(v.Placement.Tenancy)
+		i.HostID = v.Placement.HostId
+		i.HostResourceGroupArn = v.Placement.HostResourceGroupArn
+		i.HostAffinity = aws.StringValue(v.Placement.Affinity)
+	}
+
+	i.DisableAPITermination = v.DisableApiTermination
+	i.DisableAPIStop = v.DisableApiStop
+
+	for _, ni := range v.NetworkInterfaces {
+		spec := infrav1.NetworkInterfaceSpec{
+			DeviceIndex:                    aws.Int64Value(ni.DeviceIndex),
+			InterfaceType:                  infrav1.NetworkInterfaceType(aws.StringValue(ni.InterfaceType)),
+			SecondaryPrivateIPAddressCount: ni.SecondaryPrivateIpAddressCount,
+			IPv4PrefixCount:                ni.Ipv4PrefixCount,
+			DeleteOnTermination:            ni.DeleteOnTermination,
+		}
+		// As with AdditionalSecurityGroups below, this includes the security groups of the instances.
+		for _, id := range ni.Groups {
+			spec.SecurityGroups = append(spec.SecurityGroups, infrav1.AWSResourceReference{ID: id})
+		}
+		i.NetworkInterfaceSpecs = append(i.NetworkInterfaceSpecs, spec)
+	}
+
 	for _, id := range v.SecurityGroupIds {
 		// FIXME(dlipovetsky): This will include the core security groups as well, making the
 		// "Additional" a bit dishonest. However, including the core groups drastically simplifies
@@ -359,7 +617,7 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 //
 // FIXME(dlipovetsky): This check should account for changed userdata, but does not yet do so.
 // Although userdata is stored in an EC2 Launch Template, it is not a field of AWSLaunchTemplate.
-func (s *Service) LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error) {
+func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error) {
 	if incoming.IamInstanceProfile != existing.IamInstanceProfile {
 		return true, nil
 	}
@@ -368,12 +626,48 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incom
 		return true, nil
 	}
 
+	if !cmp.Equal(incoming.CPUOptions, existing.CPUOptions) {
+		return true, nil
+	}
+
+	if hibernationConfigured(incoming.HibernationOptions) != hibernationConfigured(existing.HibernationOptions) {
+		return true, nil
+	}
+
+	if enclaveEnabled(incoming.EnclaveOptions) != enclaveEnabled(existing.EnclaveOptions) {
+		return true, nil
+	}
+
+	privateDNSName, err := s.launchTemplatePrivateDNSName(scope, incoming.PrivateDNSName)
+	if err != nil {
+		return false, err
+	}
+	if privateDNSNameNeedsUpdate(privateDNSName, existing.PrivateDNSName) {
+		return true, nil
+	}
+
+	if placementValueOrDefault(incoming.Tenancy) != placementValueOrDefault(existing.Tenancy) ||
+		aws.StringValue(incoming.HostID) != aws.StringValue(existing.HostID) ||
+		aws.StringValue(incoming.HostResourceGroupArn) != aws.StringValue(existing.HostResourceGroupArn) ||
+		placementValueOrDefault(incoming.HostAffinity) != placementValueOrDefault(existing.HostAffinity) {
+		return true, nil
+	}
+
+	if aws.BoolValue(incoming.DisableAPITermination) != aws.BoolValue(existing.DisableAPITermination) ||
+		aws.BoolValue(incoming.DisableAPIStop) != aws.BoolValue(existing.DisableAPIStop) {
+		return true, nil
+	}
+
+	if len(incoming.NetworkInterfaceSpecs) > 0 || len(existing.NetworkInterfaceSpecs) > 0 {
+		return s.launchTemplateNetworkInterfacesNeedUpdate(scope, incoming, existing)
+	}
+
 	incomingIDs := make([]string, len(incoming.AdditionalSecurityGroups))
 	for i, ref := range incoming.AdditionalSecurityGroups {
 		incomingIDs[i] = aws.StringValue(ref.ID)
 	}
 
-	coreIDs, err := s.GetCoreNodeSecurityGroups(scope)
+	coreIDs, err := s.getLaunchTemplateCoreSecurityGroups(scope)
 	if err != nil {
 		return false, err
 	}
@@ -395,6 +689,131 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incom
 	return false, nil
 }
 
+// launchTemplateNetworkInterfacesNeedUpdate compares the network interfaces of two launch templates.
+// The security groups of a launch template with network interfaces are set on each interface,
+// so they are compared per interface as well.
+func (s *Service) launchTemplateNetworkInterfacesNeedUpdate(scope scope.LaunchTemplateScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error) {
+	if len(incoming.NetworkInterfaceSpecs) != len(existing.NetworkInterfaceSpecs) {
+		return true, nil
+	}
+
+	instanceGroups, err := s.getLaunchTemplateCoreSecurityGroups(scope)
+	if err != nil {
+		return false, err
+	}
+	for _, ref := range incoming.AdditionalSecurityGroups {
+		instanceGroups = append(instanceGroups, aws.StringValue(ref.ID))
+	}
+
+	existingByIndex := make(map[int64]infrav1.NetworkInterfaceSpec, len(existing.NetworkInterfaceSpecs))
+	for _, spec := range existing.NetworkInterfaceSpecs {
+		existingByIndex[spec.DeviceIndex] = spec
+	}
+
+	for _, spec := range incoming.NetworkInterfaceSpecs {
+		current, ok := existingByIndex[spec.DeviceIndex]
+		if !ok {
+			return true, nil
+		}
+
+		if networkInterfaceTypeOrDefault(spec) != networkInterfaceTypeOrDefault(current) {
+			return true, nil
+		}
+
+		if aws.Int64Value(spec.SecondaryPrivateIPAddressCount) != aws.Int64Value(current.SecondaryPrivateIPAddressCount) {
+			return true, nil
+		}
+
+		if aws.Int64Value(spec.IPv4PrefixCount) != aws.Int64Value(current.IPv4PrefixCount) {
+			return true, nil
+		}
+
+		if aws.BoolValue(networkInterfaceDeleteOnTermination(spec)) != aws.BoolValue(networkInterfaceDeleteOnTermination(current)) {
+			return true, nil
+		}
+
+		groups, err := s.resolveSecurityGroupReferences(spec.SecurityGroups)
+		if err != nil {
+			return false, err
+		}
+		spec.SecurityGroups = groups
+
+		incomingIDs := networkInterfaceSecurityGroupIDs(instanceGroups, spec)
+		existingIDs := make([]string, len(current.SecurityGroups))
+		for i, ref := range current.SecurityGroups {
+			existingIDs[i] = aws.StringValue(ref.ID)
+		}
+
+		sort.Strings(incomingIDs)
+		sort.Strings(existingIDs)
+
+		if !cmp.Equal(incomingIDs, existingIDs) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getLaunchTemplateCoreSecurityGroups returns the security group IDs managed by this actuator
+// for the instances created from the launch template.
+func (s *Service) getLaunchTemplateCoreSecurityGroups(ltScope scope.LaunchTemplateScope) ([]string, error) {
+	switch ltScope := ltScope.(type) {
+	case *scope.MachineScope:
+		return s.GetCoreSecurityGroups(ltScope)
+	case *scope.MachinePoolScope:
+		return s.GetCoreNodeSecurityGroups(ltScope)
+	default:
+		return nil, errors.Errorf("unsupported launch template scope %T", ltScope)
+	}
+}
+
+// launchTemplateRole returns the role tag of the instances created from the launch template.
+func launchTemplateRole(ltScope scope.LaunchTemplateScope) string {
+	if machineScope, ok := ltScope.(*scope.MachineScope); ok {
+		return machineScope.Role()
+	}
+	return "node"
+}
+
+func networkInterfaceTypeOrDefault(spec infrav1.NetworkInterfaceSpec) infrav1.NetworkInterfaceType {
+	if spec.InterfaceType == "" {
+		return infrav1.NetworkInterfaceTypeInterface
+	}
+	return spec.InterfaceType
+}
+
+// privateDNSNameNeedsUpdate compares the private DNS name options of two launch templates.
+// A hostname type which is not set takes the default of the subnet, so it matches any existing one.
+func privateDNSNameNeedsUpdate(incoming, existing *infrav1.PrivateDNSName) bool {
+	if incoming == nil || existing == nil {
+		return (incoming == nil) != (existing == nil)
+	}
+
+	if incoming.HostnameType != "" && incoming.HostnameType != existing.HostnameType {
+		return true
+	}
+
+	return aws.BoolValue(incoming.EnableResourceNameDNSARecord) != aws.BoolValue(existing.EnableResourceNameDNSARecord) ||
+		aws.BoolValue(incoming.EnableResourceNameDNSAAAARecord) != aws.BoolValue(existing.EnableResourceNameDNSAAAARecord)
+}
+
+// placementValueOrDefault returns the placement value, where an empty value is the default of EC2.
+func placementValueOrDefault(v string) string {
+	if v == "" {
+		return "default"
+	}
+	return v
+}
+
+func hibernationConfigured(o *infrav1.HibernationOptions) bool {
+	return o != nil && o.Configured
+}
+
+func enclaveEnabled(o *infrav1.EnclaveOptions) bool {
+	return o != nil && o.Enabled
+}
+
 // DiscoverLaunchTemplateAMI will discover the AMI launch template.
 func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*string, error) {
 	lt := scope.AWSMachinePool.Spec.AWSLaunchTemplate
@@ -403,6 +822,14 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*str
 		return lt.AMI.ID, nil
 	}
 
+	if usesAMILookup(lt.AMI) {
+		lookupAMI, err := s.amiReferenceLookup(lt.AMI, pointer.StringDeref(scope.MachinePool.Spec.Template.Spec.Version, ""))
+		if err != nil {
+			return nil, err
+		}
+		return aws.String(lookupAMI), nil
+	}
+
 	if scope.MachinePool.Spec.Template.Spec.Version == nil {
 		err := errors.New("Either AWSMachinePool's spec.awslaunchtemplate.ami.id or MachinePool's spec.template.spec.version must be defined")
 		s.scope.Error(err, "")
@@ -427,13 +854,18 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*str
 		imageLookupBaseOS = scope.InfraCluster.ImageLookupBaseOS()
 	}
 
+	arch, err := s.launchTemplateArchitecture(scope)
+	if err != nil {
+		return nil, err
+	}
+
 	if scope.IsEKSManaged() && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "" {
-		lookupAMI, err = s.eksAMILookup(*scope.MachinePool.Spec.Template.Spec.Version, scope.AWSMachinePool.Spec.AWSLaunchTemplate.AMI.EKSOptimizedLookupType)
+		lookupAMI, err = s.eksAMILookup(*scope.MachinePool.Spec.Template.Spec.Version, arch, scope.AWSMachinePool.Spec.AWSLaunchTemplate.AMI.EKSOptimizedLookupType)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		lookupAMI, err = s.defaultAMIIDLookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, *scope.MachinePool.Spec.Template.Spec.Version)
+		lookupAMI, err = s.defaultAMIIDLookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, arch, *scope.MachinePool.Spec.Template.Spec.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -442,7 +874,34 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*str
 	return aws.String(lookupAMI), nil
 }
 
-func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope *scope.MachinePoolScope) []*ec2.LaunchTemplateTagSpecificationRequest {
+// launchTemplateArchitecture returns the architecture of the instances of the machine pool. Without an
+// instance type in the launch template, the architecture of the mixed instances policy overrides is used.
+func (s *Service) launchTemplateArchitecture(scope *scope.MachinePoolScope) (infrav1.Architecture, error) {
+	instanceType := scope.AWSMachinePool.Spec.AWSLaunchTemplate.InstanceType
+	if instanceType != "" || scope.AWSMachinePool.Spec.MixedInstancesPolicy == nil {
+		return s.instanceTypeArchitecture(instanceType)
+	}
+
+	var arch infrav1.Architecture
+	for _, override := range scope.AWSMachinePool.Spec.MixedInstancesPolicy.Overrides {
+		overrideArch, err := s.instanceTypeArchitecture(override.InstanceType)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case arch == "":
+			arch, instanceType = overrideArch, override.InstanceType
+		case overrideArch != arch:
+			return "", errors.Errorf("instance type %q has architecture %s, but instance type %q has architecture %s", override.InstanceType, overrideArch, instanceType, arch)
+		}
+	}
+	if arch == "" {
+		return infrav1.ArchitectureX86_64, nil
+	}
+	return arch, nil
+}
+
+func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope scope.LaunchTemplateScope) []*ec2.LaunchTemplateTagSpecificationRequest {
 	tagSpecifications := make([]*ec2.LaunchTemplateTagSpecificationRequest, 0)
 	additionalTags := scope.AdditionalTags()
 	// Set the cloud provider tag
@@ -452,7 +911,7 @@ func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope *scope.Machin
 		ClusterName: s.scope.Name(),
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
 		Name:        aws.String(scope.Name()),
-		Role:        aws.String("node"),
+		Role:        aws.String(launchTemplateRole(scope)),
 		Additional:  additionalTags,
 	})
 
@@ -199,6 +199,12 @@ func TestGetLaunchTemplate(t *testing.T) {
 					SSHKeyName:               aws.String("foo-keyname"),
 					VersionNumber:            aws.Int64(1),
 					AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-id")}},
+					NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+						{
+							DeviceIndex:    1,
+							SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("foo-group")}},
+						},
+					},
 				}
 
 				g.Expect(err).NotTo(HaveOccurred())
@@ -257,6 +263,12 @@ func TestGetLaunchTemplate(t *testing.T) {
 					SSHKeyName:               aws.String("foo-keyname"),
 					VersionNumber:            aws.Int64(1),
 					AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-id")}},
+					NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+						{
+							DeviceIndex:    1,
+							SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("foo-group")}},
+						},
+					},
 				}
 
 				g.Expect(err).NotTo(HaveOccurred())
@@ -337,9 +349,53 @@ func TestService_SDKToLaunchTemplate(t *testing.T) {
 				IamInstanceProfile: "foo-profile",
 				SSHKeyName:         aws.String("foo-keyname"),
 				VersionNumber:      aws.Int64(1),
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{
+						DeviceIndex:    1,
+						SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("foo-group")}},
+					},
+				},
 			},
 			wantHash: testUserDataHash,
 		},
+		{
+			name: "private DNS name, placement and protection",
+			input: &ec2.LaunchTemplateVersion{
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{
+						Name: aws.String("foo-profile"),
+					},
+					PrivateDnsNameOptions: &ec2.LaunchTemplatePrivateDnsNameOptions{
+						HostnameType:                 aws.String("resource-name"),
+						EnableResourceNameDnsARecord: aws.Bool(true),
+					},
+					Placement: &ec2.LaunchTemplatePlacement{
+						Tenancy:  aws.String("host"),
+						HostId:   aws.String("h-12345"),
+						Affinity: aws.String("host"),
+					},
+					DisableApiTermination: aws.Bool(true),
+					DisableApiStop:        aws.Bool(false),
+				},
+				VersionNumber: aws.Int64(2),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name:               "foo",
+				IamInstanceProfile: "foo-profile",
+				VersionNumber:      aws.Int64(2),
+				PrivateDNSName: &infrav1.PrivateDNSName{
+					HostnameType:                 infrav1.HostnameTypeResourceName,
+					EnableResourceNameDNSARecord: aws.Bool(true),
+				},
+				Tenancy:               "host",
+				HostID:                aws.String("h-12345"),
+				HostAffinity:          "host",
+				DisableAPITermination: aws.Bool(true),
+				DisableAPIStop:        aws.Bool(false),
+			},
+			wantHash: userdata.ComputeHash(nil),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -437,6 +493,154 @@ func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "the same network interfaces",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-999")},
+				},
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{DeviceIndex: 0},
+					{
+						DeviceIndex:     1,
+						InterfaceType:   infrav1.NetworkInterfaceTypeEFA,
+						SecurityGroups:  []infrav1.AWSResourceReference{{ID: aws.String("sg-efa")}},
+						IPv4PrefixCount: aws.Int64(2),
+					},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{
+						DeviceIndex:         1,
+						InterfaceType:       infrav1.NetworkInterfaceTypeEFA,
+						SecurityGroups:      []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}, {ID: aws.String("sg-999")}, {ID: aws.String("sg-efa")}},
+						IPv4PrefixCount:     aws.Int64(2),
+						DeleteOnTermination: aws.Bool(true),
+					},
+					{
+						DeviceIndex:         0,
+						InterfaceType:       infrav1.NetworkInterfaceTypeInterface,
+						SecurityGroups:      []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}, {ID: aws.String("sg-999")}},
+						DeleteOnTermination: aws.Bool(true),
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "network interface added",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{DeviceIndex: 0},
+					{DeviceIndex: 1, InterfaceType: infrav1.NetworkInterfaceTypeEFA},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{
+						DeviceIndex:    0,
+						SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "private DNS name unchanged",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				PrivateDNSName: &infrav1.PrivateDNSName{EnableResourceNameDNSARecord: aws.Bool(true)},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+				PrivateDNSName: &infrav1.PrivateDNSName{
+					HostnameType:                 infrav1.HostnameTypeResourceName,
+					EnableResourceNameDNSARecord: aws.Bool(true),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "private DNS name changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				PrivateDNSName: &infrav1.PrivateDNSName{HostnameType: infrav1.HostnameTypeIPName},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+				PrivateDNSName:           &infrav1.PrivateDNSName{HostnameType: infrav1.HostnameTypeResourceName},
+			},
+			want: true,
+		},
+		{
+			name:     "private DNS name removed",
+			incoming: &expinfrav1.AWSLaunchTemplate{},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+				PrivateDNSName:           &infrav1.PrivateDNSName{HostnameType: infrav1.HostnameTypeResourceName},
+			},
+			want: true,
+		},
+		{
+			name: "default tenancy unchanged",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				Tenancy: "default",
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+			},
+			want: false,
+		},
+		{
+			name: "host placement changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				Tenancy: "host",
+				HostID:  aws.String("h-22222"),
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+				Tenancy:                  "host",
+				HostID:                   aws.String("h-11111"),
+			},
+			want: true,
+		},
+		{
+			name: "termination protection unchanged",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				DisableAPITermination: aws.Bool(false),
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+			},
+			want: false,
+		},
+		{
+			name: "stop protection enabled",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				DisableAPIStop: aws.Bool(true),
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+				DisableAPIStop:           aws.Bool(false),
+			},
+			want: true,
+		},
+		{
+			name: "network interface type changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{DeviceIndex: 0, InterfaceType: infrav1.NetworkInterfaceTypeEFA},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				NetworkInterfaceSpecs: []infrav1.NetworkInterfaceSpec{
+					{
+						DeviceIndex:    0,
+						SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+					},
+				},
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1030,11 +1234,12 @@ func TestDiscoverLaunchTemplateAMI(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	testCases := []struct {
-		name              string
-		awsLaunchTemplate expinfrav1.AWSLaunchTemplate
-		machineTemplate   clusterv1.MachineTemplateSpec
-		expect            func(m *mock_ec2iface.MockEC2APIMockRecorder)
-		check             func(*WithT, *string, error)
+		name                 string
+		awsLaunchTemplate    expinfrav1.AWSLaunchTemplate
+		mixedInstancesPolicy *expinfrav1.MixedInstancesPolicy
+		machineTemplate      clusterv1.MachineTemplateSpec
+		expect               func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		check                func(*WithT, *string, error)
 	}{
 		{
 			name: "Should return default AMI for non EKS managed cluster if Image lookup format, org and BaseOS passed",
@@ -1147,6 +1352,66 @@ func TestDiscoverLaunchTemplateAMI(t *testing.T) {
 				g.Expect(err).To(HaveOccurred())
 			},
 		},
+		{
+			name: "Should look up the AMI for the architecture of the mixed instances policy overrides without an instance type",
+			awsLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+				Name: "aws-launch-tmpl",
+			},
+			mixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+				Overrides: []expinfrav1.Overrides{{InstanceType: "m6g.large"}, {InstanceType: "c6g.large"}},
+			},
+			machineTemplate: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Version: aws.String(DefaultAmiNameFormat),
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m6g.large", "arm64")
+				expectInstanceTypeArchitecture(m, "c6g.large", "arm64")
+				m.DescribeImages(gomock.AssignableToTypeOf(&ec2.DescribeImagesInput{})).
+					DoAndReturn(func(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+						for _, filter := range input.Filters {
+							if aws.StringValue(filter.Name) == "architecture" && aws.StringValue(filter.Values[0]) != "arm64" {
+								return nil, awserrors.NewFailedDependency("unexpected architecture " + aws.StringValue(filter.Values[0]))
+							}
+						}
+						return &ec2.DescribeImagesOutput{
+							Images: []*ec2.Image{
+								{
+									ImageId:      aws.String("latest-arm64"),
+									CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+								},
+							},
+						}, nil
+					})
+			},
+			check: func(g *WithT, res *string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(res).Should(Equal(aws.String("latest-arm64")))
+			},
+		},
+		{
+			name: "Should return error if the mixed instances policy overrides mix architectures",
+			awsLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+				Name: "aws-launch-tmpl",
+			},
+			mixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+				Overrides: []expinfrav1.Overrides{{InstanceType: "m6g.large"}, {InstanceType: "m5.large"}},
+			},
+			machineTemplate: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Version: aws.String(DefaultAmiNameFormat),
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "m6g.large", "arm64")
+				expectInstanceTypeArchitecture(m, "m5.large", "x86_64")
+			},
+			check: func(g *WithT, res *string, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(res).To(BeNil())
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1165,6 +1430,7 @@ func TestDiscoverLaunchTemplateAMI(t *testing.T) {
 			g.Expect(err).NotTo(HaveOccurred())
 
 			ms.AWSMachinePool.Spec.AWSLaunchTemplate = tc.awsLaunchTemplate
+			ms.AWSMachinePool.Spec.MixedInstancesPolicy = tc.mixedInstancesPolicy
 			ms.MachinePool.Spec.Template = tc.machineTemplate
 
 			if tc.expect != nil {
@@ -1189,6 +1455,7 @@ func TestDiscoverLaunchTemplateAMI_ForEKS(t *testing.T) {
 		awsLaunchTemplate expinfrav1.AWSLaunchTemplate
 		machineTemplate   clusterv1.MachineTemplateSpec
 		expect            func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		expectEC2         func(m *mock_ec2iface.MockEC2APIMockRecorder)
 		check             func(*WithT, *string, error)
 	}{
 		{
@@ -1201,6 +1468,9 @@ func TestDiscoverLaunchTemplateAMI_ForEKS(t *testing.T) {
 						},
 					}, nil)
 			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectInstanceTypeArchitecture(m, "t3.large", ec2.ArchitectureTypeX8664)
+			},
 			check: func(g *WithT, res *string, err error) {
 				g.Expect(res).Should(Equal(aws.String("latest")))
 				g.Expect(err).NotTo(HaveOccurred())
@@ -1212,6 +1482,7 @@ func TestDiscoverLaunchTemplateAMI_ForEKS(t *testing.T) {
 			g := NewWithT(t)
 
 			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
 
 			scheme, err := setupScheme()
 			g.Expect(err).NotTo(HaveOccurred())
@@ -1226,9 +1497,13 @@ func TestDiscoverLaunchTemplateAMI_ForEKS(t *testing.T) {
 			if tc.expect != nil {
 				tc.expect(ssmMock.EXPECT())
 			}
+			if tc.expectEC2 != nil {
+				tc.expectEC2(ec2Mock.EXPECT())
+			}
 
 			s := NewService(mcps)
 			s.SSMClient = ssmMock
+			s.EC2Client = ec2Mock
 
 			id, err := s.DiscoverLaunchTemplateAMI(ms)
 			tc.check(g, id, err)
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// ReconcileRetainedVolumes ensures that the retained volumes of the machine exist in the
+// availability zone of the instance and are attached to it. The IDs of the volumes are recorded
+// in the status of the machine, and the IDs of attached volumes are added to the instance's
+// VolumeIDs. It returns true if a volume is still being created, so the caller should requeue
+// to attach it once it is available.
+func (s *Service) ReconcileRetainedVolumes(scope *scope.MachineScope, instance *infrav1.Instance) (bool, error) {
+	pending := false
+	for i := range scope.AWSMachine.Spec.RetainedVolumes {
+		retained := scope.AWSMachine.Spec.RetainedVolumes[i]
+
+		volume, err := s.getRetainedVolume(scope.GetRetainedVolumeIDStatus(retained.Name), retained.Name, instance.AvailabilityZone)
+		if err != nil {
+			return false, err
+		}
+
+		if volume == nil {
+			volume, err = s.createRetainedVolume(scope, &retained, instance.AvailabilityZone)
+			if err != nil {
+				record.Warnf(scope.AWSMachine, "FailedCreateRetainedVolume", "Failed to create retained volume %q: %v", retained.Name, err)
+				return false, err
+			}
+			record.Eventf(scope.AWSMachine, "SuccessfulCreateRetainedVolume", "Created retained volume %q with id %q", retained.Name, aws.StringValue(volume.VolumeId))
+		}
+
+		volumeID := aws.StringValue(volume.VolumeId)
+		scope.SetRetainedVolumeIDStatus(retained.Name, volumeID)
+
+		// A volume can only be attached once it is available.
+		if aws.StringValue(volume.State) == ec2.VolumeStateCreating {
+			s.scope.V(2).Info("Waiting for retained volume to become available", "volume-id", volumeID)
+			pending = true
+			continue
+		}
+
+		if attachedInstanceID := volumeAttachedInstanceID(volume); attachedInstanceID != "" {
+			if attachedInstanceID != instance.ID {
+				return false, errors.Errorf("retained volume %q is still attached to instance %q", retained.Name, attachedInstanceID)
+			}
+		} else {
+			s.scope.V(2).Info("Attaching retained volume", "volume-id", volumeID, "instance-id", instance.ID, "device", retained.Volume.DeviceName)
+			if _, err := s.EC2Client.AttachVolume(&ec2.AttachVolumeInput{
+				Device:     aws.String(retained.Volume.DeviceName),
+				InstanceId: aws.String(instance.ID),
+				VolumeId:   volume.VolumeId,
+			}); err != nil {
+				record.Warnf(scope.AWSMachine, "FailedAttachRetainedVolume", "Failed to attach retained volume %q: %v", retained.Name, err)
+				return false, errors.Wrapf(err, "failed to attach retained volume %q to instance %q", volumeID, instance.ID)
+			}
+			record.Eventf(scope.AWSMachine, "SuccessfulAttachRetainedVolume", "Attached retained volume %q with id %q", retained.Name, volumeID)
+		}
+
+		if !containsString(instance.VolumeIDs, volumeID) {
+			instance.VolumeIDs = append(instance.VolumeIDs, volumeID)
+		}
+	}
+
+	return pending, nil
+}
+
+// getRetainedVolume returns the retained volume of the cluster with the given name in
+// the given availability zone, or nil if it does not exist. The volume with the recorded
+// ID is looked up first, as a volume which was just created may not be found by its tags yet.
+func (s *Service) getRetainedVolume(id, name, availabilityZone string) (*ec2.Volume, error) {
+	if id != "" {
+		out, err := s.EC2Client.DescribeVolumes(&ec2.DescribeVolumesInput{VolumeIds: aws.StringSlice([]string{id})})
+		switch {
+		case awserrors.IsNotFound(err):
+		case err != nil:
+			return nil, errors.Wrapf(err, "failed to describe retained volume %q", name)
+		case len(out.Volumes) > 0 && isRetainedVolumeUsable(out.Volumes[0], availabilityZone):
+			return out.Volumes[0], nil
+		}
+	}
+
+	input := &ec2.DescribeVolumesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.Name(name),
+			filter.EC2.AvailabilityZone(availabilityZone),
+			{
+				Name:   aws.String("status"),
+				Values: aws.StringSlice([]string{ec2.VolumeStateCreating, ec2.VolumeStateAvailable, ec2.VolumeStateInUse}),
+			},
+		},
+	}
+
+	out, err := s.EC2Client.DescribeVolumes(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe retained volume %q", name)
+	}
+
+	switch len(out.Volumes) {
+	case 0:
+		return nil, nil
+	case 1:
+		return out.Volumes[0], nil
+	default:
+		return nil, errors.Errorf("found %d retained volumes named %q in availability zone %q, expected at most one", len(out.Volumes), name, availabilityZone)
+	}
+}
+
+// isRetainedVolumeUsable returns true if the volume is in the given availability zone and has not
+// been deleted.
+func isRetainedVolumeUsable(v *ec2.Volume, availabilityZone string) bool {
+	if aws.StringValue(v.AvailabilityZone) != availabilityZone {
+		return false
+	}
+	switch aws.StringValue(v.State) {
+	case ec2.VolumeStateCreating, ec2.VolumeStateAvailable, ec2.VolumeStateInUse:
+		return true
+	}
+	return false
+}
+
+func (s *Service) createRetainedVolume(scope *scope.MachineScope, retained *infrav1.RetainedVolume, availabilityZone string) (*ec2.Volume, error) {
+	v := retained.Volume
+
+	input := &ec2.CreateVolumeInput{
+		AvailabilityZone: aws.String(availabilityZone),
+		Size:             aws.Int64(v.Size),
+		Encrypted:        v.Encrypted,
+		Throughput:       v.Throughput,
+	}
+
+	if v.IOPS != 0 {
+		input.Iops = aws.Int64(v.IOPS)
+	}
+
+	if v.EncryptionKey != "" {
+		input.Encrypted = aws.Bool(true)
+		input.KmsKeyId = aws.String(v.EncryptionKey)
+	}
+
+	if v.Type != "" {
+		input.VolumeType = aws.String(string(v.Type))
+	}
+
+	if v.SnapshotID != "" {
+		input.SnapshotId = aws.String(v.SnapshotID)
+	}
+
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(retained.Name),
+		Role:        aws.String(scope.Role()),
+		Additional:  scope.AdditionalTags(),
+	})
+	input.TagSpecifications = []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(ec2.ResourceTypeVolume),
+			Tags:         converters.MapToTags(tags),
+		},
+	}
+
+	out, err := s.EC2Client.CreateVolume(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create retained volume %q", retained.Name)
+	}
+
+	return out, nil
+}
+
+// volumeAttachedInstanceID returns the ID of the instance the volume is attached or being attached to.
+func volumeAttachedInstanceID(v *ec2.Volume) string {
+	for _, attachment := range v.Attachments {
+		switch aws.StringValue(attachment.State) {
+		case ec2.VolumeAttachmentStateAttached, ec2.VolumeAttachmentStateAttaching, ec2.VolumeAttachmentStateBusy:
+			return aws.StringValue(attachment.InstanceId)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcileRetainedVolumes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	retainedVolume := infrav1.RetainedVolume{
+		Name: "data",
+		Volume: infrav1.Volume{
+			DeviceName: "/dev/sdb",
+			Size:       100,
+			Type:       infrav1.VolumeTypeGP3,
+			SnapshotID: "snap-1",
+		},
+	}
+
+	describeInput := &ec2.DescribeVolumesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/cluster-name"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+			{
+				Name:   aws.String("tag:Name"),
+				Values: aws.StringSlice([]string{"data"}),
+			},
+			{
+				Name:   aws.String("availability-zone"),
+				Values: aws.StringSlice([]string{"us-east-1a"}),
+			},
+			{
+				Name:   aws.String("status"),
+				Values: aws.StringSlice([]string{ec2.VolumeStateCreating, ec2.VolumeStateAvailable, ec2.VolumeStateInUse}),
+			},
+		},
+	}
+
+	attachInput := &ec2.AttachVolumeInput{
+		Device:     aws.String("/dev/sdb"),
+		InstanceId: aws.String("i-1"),
+		VolumeId:   aws.String("vol-1"),
+	}
+
+	describeByIDInput := &ec2.DescribeVolumesInput{VolumeIds: aws.StringSlice([]string{"vol-1"})}
+
+	testCases := []struct {
+		name               string
+		recordedVolumeID   string
+		expect             func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectErr          bool
+		expectPending      bool
+		expectedVolumeIDs  []string
+		expectedRecordedID string
+	}{
+		{
+			name: "creates a missing volume and requeues until it is available",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVolumesOutput{}, nil)
+				m.CreateVolume(gomock.Any()).
+					DoAndReturn(func(input *ec2.CreateVolumeInput) (*ec2.Volume, error) {
+						if aws.StringValue(input.SnapshotId) != "snap-1" {
+							t.Fatalf("expected volume to be created from snapshot, got %q", aws.StringValue(input.SnapshotId))
+						}
+						if aws.StringValue(input.AvailabilityZone) != "us-east-1a" {
+							t.Fatalf("expected volume to be created in the instance availability zone, got %q", aws.StringValue(input.AvailabilityZone))
+						}
+						return &ec2.Volume{VolumeId: aws.String("vol-1"), State: aws.String(ec2.VolumeStateCreating)}, nil
+					})
+			},
+			expectPending:      true,
+			expectedVolumeIDs:  []string{"vol-root"},
+			expectedRecordedID: "vol-1",
+		},
+		{
+			name: "attaches an available volume",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-1")}},
+					}, nil)
+				m.AttachVolume(gomock.Eq(attachInput)).
+					Return(&ec2.VolumeAttachment{}, nil)
+			},
+			expectedVolumeIDs:  []string{"vol-root", "vol-1"},
+			expectedRecordedID: "vol-1",
+		},
+		{
+			name: "requeues for a volume which is still being created",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-1"), State: aws.String(ec2.VolumeStateCreating)}},
+					}, nil)
+			},
+			expectPending:      true,
+			expectedVolumeIDs:  []string{"vol-root"},
+			expectedRecordedID: "vol-1",
+		},
+		{
+			name:             "attaches the recorded volume once it is available",
+			recordedVolumeID: "vol-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(describeByIDInput)).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{
+							{
+								VolumeId:         aws.String("vol-1"),
+								AvailabilityZone: aws.String("us-east-1a"),
+								State:            aws.String(ec2.VolumeStateAvailable),
+							},
+						},
+					}, nil)
+				m.AttachVolume(gomock.Eq(attachInput)).
+					Return(&ec2.VolumeAttachment{}, nil)
+			},
+			expectedVolumeIDs:  []string{"vol-root", "vol-1"},
+			expectedRecordedID: "vol-1",
+		},
+		{
+			name:             "looks up the volume by name when the recorded volume no longer exists",
+			recordedVolumeID: "vol-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(describeByIDInput)).
+					Return(nil, awserr.New("InvalidVolume.NotFound", "", nil))
+				m.DescribeVolumes(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-2"), State: aws.String(ec2.VolumeStateAvailable)}},
+					}, nil)
+				m.AttachVolume(gomock.Eq(&ec2.AttachVolumeInput{
+					Device:     aws.String("/dev/sdb"),
+					InstanceId: aws.String("i-1"),
+					VolumeId:   aws.String("vol-2"),
+				})).
+					Return(&ec2.VolumeAttachment{}, nil)
+			},
+			expectedVolumeIDs:  []string{"vol-root", "vol-2"},
+			expectedRecordedID: "vol-2",
+		},
+		{
+			name: "does nothing when the volume is already attached to the instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{
+							{
+								VolumeId: aws.String("vol-1"),
+								Attachments: []*ec2.VolumeAttachment{
+									{InstanceId: aws.String("i-1"), State: aws.String(ec2.VolumeAttachmentStateAttached)},
+								},
+							},
+						},
+					}, nil)
+			},
+			expectedVolumeIDs:  []string{"vol-root", "vol-1"},
+			expectedRecordedID: "vol-1",
+		},
+		{
+			name: "returns an error when the volume is still attached to another instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{
+							{
+								VolumeId: aws.String("vol-1"),
+								Attachments: []*ec2.VolumeAttachment{
+									{InstanceId: aws.String("i-old"), State: aws.String(ec2.VolumeAttachmentStateDetaching)},
+									{InstanceId: aws.String("i-old"), State: aws.String(ec2.VolumeAttachmentStateAttached)},
+								},
+							},
+						},
+					}, nil)
+			},
+			expectErr:          true,
+			expectedVolumeIDs:  []string{"vol-root"},
+			expectedRecordedID: "vol-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      newCluster(),
+				Machine:      &clusterv1.Machine{},
+				InfraCluster: clusterScope,
+				AWSMachine: &infrav1.AWSMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "aws-test1"},
+					Spec: infrav1.AWSMachineSpec{
+						RetainedVolumes: []infrav1.RetainedVolume{retainedVolume},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.recordedVolumeID != "" {
+				machineScope.SetRetainedVolumeIDStatus("data", tc.recordedVolumeID)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			instance := &infrav1.Instance{
+				ID:               "i-1",
+				AvailabilityZone: "us-east-1a",
+				VolumeIDs:        []string{"vol-root"},
+			}
+			pending, err := s.ReconcileRetainedVolumes(machineScope, instance)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(pending).To(Equal(tc.expectPending))
+			g.Expect(instance.VolumeIDs).To(Equal(tc.expectedVolumeIDs))
+			g.Expect(machineScope.GetRetainedVolumeIDStatus("data")).To(Equal(tc.expectedRecordedID))
+		})
+	}
+}
@@ -16,13 +16,14 @@ limitations under the License.
 
 package instancestate
 
-// ReconcileEC2Events will reconcile a Service's EC2 events.
-func (s Service) ReconcileEC2Events() error {
+// ReconcileEC2Events will reconcile a Service's EC2 events, including the Auto Scaling events of machine pools
+// if machinePools is set.
+func (s Service) ReconcileEC2Events(machinePools bool) error {
 	if err := s.reconcileSQSQueue(); err != nil {
 		return err
 	}
 
-	return s.reconcileRules()
+	return s.reconcileRules(machinePools)
 }
 
 // DeleteEC2Events will delete a Service's EC2 events.
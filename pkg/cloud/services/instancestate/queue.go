@@ -19,6 +19,7 @@ package instancestate
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,39 +30,92 @@ import (
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/iam/api/v1beta1"
 )
 
+// maxReceiveCount is the number of times a message is received from the queue before it is moved
+// to the dead-letter queue.
+const maxReceiveCount = 5
+
+// deadLetterQueueMessageRetentionSeconds keeps messages in the dead-letter queue for the maximum of 14 days.
+const deadLetterQueueMessageRetentionSeconds = 14 * 24 * 60 * 60
+
 func (s *Service) reconcileSQSQueue() error {
+	dlqAttrs := make(map[string]string)
+	dlqAttrs[sqs.QueueAttributeNameMessageRetentionPeriod] = strconv.Itoa(deadLetterQueueMessageRetentionSeconds)
+	dlqURL, err := s.createOrUpdateQueue(GenerateDeadLetterQueueName(s.scope.Name()), dlqAttrs)
+	if err != nil {
+		return errors.Wrap(err, "unable to create dead-letter queue")
+	}
+
+	dlqAttrsResp, err := s.SQSClient.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameQueueArn}),
+		QueueUrl:       aws.String(dlqURL),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to get dead-letter queue attributes")
+	}
+
+	redrivePolicy, err := json.Marshal(map[string]string{
+		"deadLetterTargetArn": aws.StringValue(dlqAttrsResp.Attributes[sqs.QueueAttributeNameQueueArn]),
+		"maxReceiveCount":     strconv.Itoa(maxReceiveCount),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to JSON marshal redrive policy")
+	}
+
 	attrs := make(map[string]string)
 	attrs[sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds] = "20"
+	attrs[sqs.QueueAttributeNameRedrivePolicy] = string(redrivePolicy)
+	if _, err := s.createOrUpdateQueue(GenerateQueueName(s.scope.Name()), attrs); err != nil {
+		return errors.Wrap(err, "unable to create new queue")
+	}
+
+	return nil
+}
 
-	_, err := s.SQSClient.CreateQueue(&sqs.CreateQueueInput{
-		QueueName:  aws.String(GenerateQueueName(s.scope.Name())),
+// createOrUpdateQueue creates the queue, or updates its attributes if it already exists with different attributes,
+// and returns its URL.
+func (s *Service) createOrUpdateQueue(name string, attrs map[string]string) (string, error) {
+	resp, err := s.SQSClient.CreateQueue(&sqs.CreateQueueInput{
+		QueueName:  aws.String(name),
 		Attributes: aws.StringMap(attrs),
 	})
+	if err == nil {
+		return aws.StringValue(resp.QueueUrl), nil
+	}
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != sqs.ErrCodeQueueNameExists {
+		return "", err
+	}
 
+	urlResp, err := s.SQSClient.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String(name)})
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			if aerr.Code() == sqs.ErrCodeQueueNameExists {
-				return nil
-			}
-		}
+		return "", errors.Wrapf(err, "unable to get URL of queue %s", name)
+	}
+	_, err = s.SQSClient.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		QueueUrl:   urlResp.QueueUrl,
+		Attributes: aws.StringMap(attrs),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to update attributes of queue %s", name)
 	}
-	return errors.Wrap(err, "unable to create new queue")
+
+	return aws.StringValue(urlResp.QueueUrl), nil
 }
 
 func (s *Service) deleteSQSQueue() error {
-	resp, err := s.SQSClient.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String(GenerateQueueName(s.scope.Name()))})
-	if err != nil {
-		if queueNotFoundError(err) {
-			return nil
+	for _, name := range []string{GenerateQueueName(s.scope.Name()), GenerateDeadLetterQueueName(s.scope.Name())} {
+		resp, err := s.SQSClient.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String(name)})
+		if err != nil {
+			if queueNotFoundError(err) {
+				continue
+			}
+			return errors.Wrap(err, "unable to get queue URL")
+		}
+		_, err = s.SQSClient.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: resp.QueueUrl})
+		if err != nil && !queueNotFoundError(err) {
+			return errors.Wrap(err, "unable to delete queue")
 		}
-		return errors.Wrap(err, "unable to get queue URL")
-	}
-	_, err = s.SQSClient.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: resp.QueueUrl})
-	if err != nil && queueNotFoundError(err) {
-		return nil
 	}
 
-	return errors.Wrap(err, "unable to delete queue")
+	return nil
 }
 
 func (s *Service) createPolicyForRule(input *createPolicyForRuleInput) error {
@@ -77,7 +131,7 @@ func (s *Service) createPolicyForRule(input *createPolicyForRuleInput) error {
 				Action:    iamv1.Actions{"sqs:SendMessage"},
 				Resource:  iamv1.Resources{input.QueueArn},
 				Condition: iamv1.Conditions{
-					"ArnEquals": map[string]string{"aws:SourceArn": input.RuleArn},
+					"ArnEquals": map[string][]string{"aws:SourceArn": input.RuleArns},
 				},
 			},
 		},
@@ -102,6 +156,12 @@ func GenerateQueueName(clusterName string) string {
 	return fmt.Sprintf("%s-queue", adjusted)
 }
 
+// GenerateDeadLetterQueueName will generate the name of the queue receiving the messages which failed processing.
+func GenerateDeadLetterQueueName(clusterName string) string {
+	adjusted := strings.ReplaceAll(clusterName, ".", "-")
+	return fmt.Sprintf("%s-dlq", adjusted)
+}
+
 func queueNotFoundError(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok {
 		if aerr.Code() == sqs.ErrCodeQueueDoesNotExist {
@@ -114,5 +174,5 @@ func queueNotFoundError(err error) bool {
 type createPolicyForRuleInput struct {
 	QueueArn string
 	QueueURL string
-	RuleArn  string
+	RuleArns []string
 }
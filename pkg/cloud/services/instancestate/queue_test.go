@@ -35,40 +35,72 @@ func TestReconcileSQSQueue(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
+	dlqAttrs := map[string]string{
+		sqs.QueueAttributeNameMessageRetentionPeriod: "1209600",
+	}
+	attrs := map[string]string{
+		sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds: "20",
+		sqs.QueueAttributeNameRedrivePolicy:                 `{"deadLetterTargetArn":"test-cluster-dlq-arn","maxReceiveCount":"5"}`,
+	}
+	expectDeadLetterQueue := func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+		m.CreateQueue(&sqs.CreateQueueInput{
+			QueueName:  aws.String("test-cluster-dlq"),
+			Attributes: aws.StringMap(dlqAttrs),
+		}).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+		m.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameQueueArn}),
+			QueueUrl:       aws.String("test-cluster-dlq-url"),
+		}).Return(&sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameQueueArn: "test-cluster-dlq-arn"})}, nil)
+	}
+
 	testCases := []struct {
 		name      string
 		expect    func(m *mock_sqsiface.MockSQSAPIMockRecorder)
 		expectErr bool
 	}{
 		{
-			name: "successfully creates an SQS queue",
+			name: "successfully creates an SQS queue with a dead-letter queue",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
-				attrs := make(map[string]string)
-				attrs[sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds] = "20"
+				expectDeadLetterQueue(m)
 				m.CreateQueue(&sqs.CreateQueueInput{
 					QueueName:  aws.String("test-cluster-queue"),
 					Attributes: aws.StringMap(attrs),
-				}).Return(nil, nil)
+				}).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
 			},
 			expectErr: false,
 		},
 		{
-			name: "does not error if queue already exists",
+			name: "updates the attributes if queue already exists",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
-				attrs := make(map[string]string)
-				attrs[sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds] = "20"
+				expectDeadLetterQueue(m)
 				m.CreateQueue(&sqs.CreateQueueInput{
 					QueueName:  aws.String("test-cluster-queue"),
 					Attributes: aws.StringMap(attrs),
 				}).Return(nil, awserr.New(sqs.ErrCodeQueueNameExists, "", nil))
+				m.GetQueueUrl(&sqs.GetQueueUrlInput{
+					QueueName: aws.String("test-cluster-queue"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+				m.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+					QueueUrl:   aws.String("test-cluster-queue-url"),
+					Attributes: aws.StringMap(attrs),
+				}).Return(nil, nil)
 			},
 			expectErr: false,
 		},
+		{
+			name: "errors when dead-letter queue can't be created",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.CreateQueue(&sqs.CreateQueueInput{
+					QueueName:  aws.String("test-cluster-dlq"),
+					Attributes: aws.StringMap(dlqAttrs),
+				}).Return(nil, errors.New("some error"))
+			},
+			expectErr: true,
+		},
 		{
 			name: "errors when unexpected error occurs",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
-				attrs := make(map[string]string)
-				attrs[sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds] = "20"
+				expectDeadLetterQueue(m)
 				m.CreateQueue(&sqs.CreateQueueInput{
 					QueueName:  aws.String("test-cluster-queue"),
 					Attributes: aws.StringMap(attrs),
@@ -110,7 +142,7 @@ func TestDeleteSQSQueue(t *testing.T) {
 		expectErr bool
 	}{
 		{
-			name: "deletes queue successfully",
+			name: "deletes queue and dead-letter queue successfully",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(&sqs.GetQueueUrlInput{
 					QueueName: aws.String("test-cluster-queue"),
@@ -118,6 +150,12 @@ func TestDeleteSQSQueue(t *testing.T) {
 				m.DeleteQueue(&sqs.DeleteQueueInput{
 					QueueUrl: aws.String("test-cluster-queue-url"),
 				}).Return(nil, nil)
+				m.GetQueueUrl(&sqs.GetQueueUrlInput{
+					QueueName: aws.String("test-cluster-dlq"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+				m.DeleteQueue(&sqs.DeleteQueueInput{
+					QueueUrl: aws.String("test-cluster-dlq-url"),
+				}).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -127,6 +165,12 @@ func TestDeleteSQSQueue(t *testing.T) {
 				m.GetQueueUrl(&sqs.GetQueueUrlInput{
 					QueueName: aws.String("test-cluster-queue"),
 				}).Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "", nil))
+				m.GetQueueUrl(&sqs.GetQueueUrlInput{
+					QueueName: aws.String("test-cluster-dlq"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+				m.DeleteQueue(&sqs.DeleteQueueInput{
+					QueueUrl: aws.String("test-cluster-dlq-url"),
+				}).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -148,6 +192,12 @@ func TestDeleteSQSQueue(t *testing.T) {
 				m.DeleteQueue(&sqs.DeleteQueueInput{
 					QueueUrl: aws.String("test-cluster-queue-url"),
 				}).Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "", nil))
+				m.GetQueueUrl(&sqs.GetQueueUrlInput{
+					QueueName: aws.String("test-cluster-dlq"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+				m.DeleteQueue(&sqs.DeleteQueueInput{
+					QueueUrl: aws.String("test-cluster-dlq-url"),
+				}).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -202,7 +252,7 @@ func TestCreatePolicyForRule(t *testing.T) {
 			input: &createPolicyForRuleInput{
 				QueueArn: "test-cluster-queue-arn",
 				QueueURL: "test-cluster-queue-url",
-				RuleArn:  "test-cluster-rule-arn",
+				RuleArns: []string{"test-cluster-rule-arn", "test-cluster-spot-rule-arn"},
 			},
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				buffer := new(bytes.Buffer)
@@ -283,7 +333,10 @@ const expectedPolicyJSON = `{
       ],
       "Condition": {
         "ArnEquals": {
-          "aws:SourceArn": "test-cluster-rule-arn"
+          "aws:SourceArn": [
+            "test-cluster-rule-arn",
+            "test-cluster-spot-rule-arn"
+          ]
         }
       }
     }
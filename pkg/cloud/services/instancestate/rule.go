@@ -19,6 +19,7 @@ package instancestate
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -29,36 +30,68 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 )
 
-// Ec2StateChangeNotification defines the EC2 instance's state change notification.
-const Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+const (
+	// Ec2StateChangeNotification defines the EC2 instance's state change notification.
+	Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+	// Ec2SpotInstanceInterruptionWarning defines the warning sent two minutes before a spot instance is interrupted.
+	Ec2SpotInstanceInterruptionWarning = "EC2 Spot Instance Interruption Warning"
+	// Ec2InstanceRebalanceRecommendation defines the notification sent when a spot instance is at an elevated risk of interruption.
+	Ec2InstanceRebalanceRecommendation = "EC2 Instance Rebalance Recommendation"
+)
 
-// reconcileRules creates rules and attaches the queue as a target.
-func (s Service) reconcileRules() error {
-	var ruleNotFound bool
-	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+const (
+	// AutoScalingInstanceLaunchSuccessful defines the Auto Scaling event sent when an instance was launched.
+	AutoScalingInstanceLaunchSuccessful = "EC2 Instance Launch Successful"
+	// AutoScalingInstanceLaunchUnsuccessful defines the Auto Scaling event sent when an instance failed to launch.
+	AutoScalingInstanceLaunchUnsuccessful = "EC2 Instance Launch Unsuccessful"
+	// AutoScalingInstanceTerminateSuccessful defines the Auto Scaling event sent when an instance was terminated.
+	AutoScalingInstanceTerminateSuccessful = "EC2 Instance Terminate Successful"
+	// AutoScalingInstanceTerminateUnsuccessful defines the Auto Scaling event sent when an instance failed to terminate.
+	AutoScalingInstanceTerminateUnsuccessful = "EC2 Instance Terminate Unsuccessful"
+)
+
+var (
+	spotDetailTypes = []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation}
+	asgDetailTypes  = []string{
+		AutoScalingInstanceLaunchSuccessful,
+		AutoScalingInstanceLaunchUnsuccessful,
+		AutoScalingInstanceTerminateSuccessful,
+		AutoScalingInstanceTerminateUnsuccessful,
+	}
+)
+
+// reconcileRules creates rules and attaches the queue as a target. The rule for Auto Scaling lifecycle events is
+// only created for machine pools.
+func (s Service) reconcileRules(machinePools bool) error {
+	ec2Rule, err := s.reconcileRule(s.getEC2RuleName(), eventPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{Ec2StateChangeNotification},
+		EventDetail: &eventDetail{
+			States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+		},
 	})
 	if err != nil {
-		if resourceNotFoundError(err) {
-			ruleNotFound = true
-		} else {
-			return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
-		}
+		return err
 	}
 
-	if ruleNotFound {
-		err = s.createRule()
-		if err != nil {
-			return errors.Wrap(err, "unable to create rule")
-		}
-		// fetch newly created rule
-		ruleResp, err = s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-			Name: aws.String(s.getEC2RuleName()),
-		})
+	spotRule, err := s.reconcileRule(s.getSpotRuleName(), eventPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: spotDetailTypes,
+	})
+	if err != nil {
+		return err
+	}
 
+	rules := []*eventbridge.DescribeRuleOutput{ec2Rule, spotRule}
+	if machinePools {
+		asgRule, err := s.reconcileRule(s.getASGRuleName(), eventPattern{
+			Source:     []string{"aws.autoscaling"},
+			DetailType: asgDetailTypes,
+		})
 		if err != nil {
-			return errors.Wrapf(err, "unable to describe new rule %s", s.getEC2RuleName())
+			return err
 		}
+		rules = append(rules, asgRule)
 	}
 
 	queueURLResp, err := s.SQSClient.GetQueueUrl(&sqs.GetQueueUrlInput{
@@ -77,41 +110,25 @@ func (s Service) reconcileRules() error {
 		return errors.Wrap(err, "unable to get queue attributes")
 	}
 
-	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
-		Rule: aws.String(s.getEC2RuleName()),
-	})
-	if err != nil {
-		return errors.Wrapf(err, "unable to list targets for rule %s", s.getEC2RuleName())
-	}
-
-	targetFound := false
-	for _, target := range targetsResp.Targets {
-		// check if queue is already added as a target
-		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == *queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn] {
-			targetFound = true
+	ruleArns := make([]string, 0, len(rules))
+	policyFound := queueAttrs.Attributes[sqs.QueueAttributeNamePolicy] != nil
+	for _, rule := range rules {
+		if err := s.reconcileRuleTarget(rule, *queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn]); err != nil {
+			return err
 		}
-	}
-
-	if !targetFound {
-		_, err = s.EventBridgeClient.PutTargets(&eventbridge.PutTargetsInput{
-			Rule: ruleResp.Name,
-			Targets: []*eventbridge.Target{{
-				Arn: queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn],
-				Id:  aws.String(GenerateQueueName(s.scope.Name())),
-			}},
-		})
-
-		if err != nil {
-			return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
+		ruleArns = append(ruleArns, *rule.Arn)
+		// rules added after the queue policy was created are not authorized yet
+		if policyFound && !strings.Contains(*queueAttrs.Attributes[sqs.QueueAttributeNamePolicy], *rule.Arn) {
+			policyFound = false
 		}
 	}
 
-	if queueAttrs.Attributes[sqs.QueueAttributeNamePolicy] == nil {
-		// add a policy for the rule so the rule is authorized to emit messages to the queue
+	if !policyFound {
+		// add a policy for the rules so the rules are authorized to emit messages to the queue
 		err = s.createPolicyForRule(&createPolicyForRuleInput{
 			QueueArn: *queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn],
 			QueueURL: *queueURLResp.QueueUrl,
-			RuleArn:  *ruleResp.Arn,
+			RuleArns: ruleArns,
 		})
 		if err != nil {
 			return err
@@ -121,22 +138,71 @@ func (s Service) reconcileRules() error {
 	return nil
 }
 
-func (s Service) createRule() error {
-	eventPattern := eventPattern{
-		Source:     []string{"aws.ec2"},
-		DetailType: []string{Ec2StateChangeNotification},
-		EventDetail: &eventDetail{
-			States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
-		},
+// reconcileRule creates the rule if it doesn't exist and returns it.
+func (s Service) reconcileRule(name string, pattern eventPattern) (*eventbridge.DescribeRuleOutput, error) {
+	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String(name),
+	})
+	if err == nil {
+		return ruleResp, nil
+	}
+	if !resourceNotFoundError(err) {
+		return nil, errors.Wrapf(err, "unable to describe rule %s", name)
+	}
+
+	if err := s.createRule(name, pattern); err != nil {
+		return nil, errors.Wrap(err, "unable to create rule")
+	}
+	// fetch newly created rule
+	ruleResp, err = s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to describe new rule %s", name)
+	}
+
+	return ruleResp, nil
+}
+
+// reconcileRuleTarget adds the queue as a target of the rule if it isn't already.
+func (s Service) reconcileRuleTarget(rule *eventbridge.DescribeRuleOutput, queueArn string) error {
+	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
+		Rule: rule.Name,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to list targets for rule %s", aws.StringValue(rule.Name))
+	}
+
+	for _, target := range targetsResp.Targets {
+		// check if queue is already added as a target
+		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == queueArn {
+			return nil
+		}
 	}
-	data, err := json.Marshal(eventPattern)
+
+	_, err = s.EventBridgeClient.PutTargets(&eventbridge.PutTargetsInput{
+		Rule: rule.Name,
+		Targets: []*eventbridge.Target{{
+			Arn: aws.String(queueArn),
+			Id:  aws.String(GenerateQueueName(s.scope.Name())),
+		}},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), aws.StringValue(rule.Name))
+	}
+
+	return nil
+}
+
+func (s Service) createRule(name string, pattern eventPattern) error {
+	data, err := json.Marshal(pattern)
 	if err != nil {
 		return err
 	}
 	// create in disabled state so the rule doesn't pick up all EC2 instances. As machines get created,
 	// the rule will get updated to track those machines
 	_, err = s.EventBridgeClient.PutRule(&eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(name),
 		EventPattern: aws.String(string(data)),
 		State:        aws.String(eventbridge.RuleStateDisabled),
 	})
@@ -145,15 +211,25 @@ func (s Service) createRule() error {
 }
 
 func (s Service) deleteRules() error {
+	for _, name := range []string{s.getEC2RuleName(), s.getSpotRuleName(), s.getASGRuleName()} {
+		if err := s.deleteRule(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Service) deleteRule(name string) error {
 	_, err := s.EventBridgeClient.RemoveTargets(&eventbridge.RemoveTargetsInput{
-		Rule: aws.String(s.getEC2RuleName()),
+		Rule: aws.String(name),
 		Ids:  aws.StringSlice([]string{GenerateQueueName(s.scope.Name())}),
 	})
 	if err != nil && !resourceNotFoundError(err) {
-		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), name)
 	}
 	_, err = s.EventBridgeClient.DeleteRule(&eventbridge.DeleteRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(name),
 	})
 
 	if err != nil && resourceNotFoundError(err) {
@@ -165,76 +241,134 @@ func (s Service) deleteRules() error {
 
 // AddInstanceToEventPattern will add an instance to an event pattern.
 func (s Service) AddInstanceToEventPattern(instanceID string) error {
+	return s.addToRule(s.getEC2RuleName(), []string{Ec2StateChangeNotification}, instanceIDs, instanceID)
+}
+
+// AddSpotInstanceToEventPattern will add a spot instance to the event pattern of the rule
+// for spot interruption warnings and rebalance recommendations.
+func (s Service) AddSpotInstanceToEventPattern(instanceID string) error {
+	return s.addToRule(s.getSpotRuleName(), spotDetailTypes, instanceIDs, instanceID)
+}
+
+// AddMachinePoolToEventPattern will add the Auto Scaling group of a machine pool to the event pattern of the rule
+// for Auto Scaling lifecycle events, and its instances to the event pattern of the EC2 instance state rule.
+// It is a no-op if the rule for Auto Scaling lifecycle events does not exist.
+func (s Service) AddMachinePoolToEventPattern(asgName string, instanceIDList []string) error {
+	if err := s.addToRule(s.getASGRuleName(), asgDetailTypes, autoScalingGroupNames, asgName); err != nil {
+		if resourceNotFoundError(errors.Cause(err)) {
+			return nil
+		}
+		return err
+	}
+	if len(instanceIDList) == 0 {
+		return nil
+	}
+
+	return s.addToRule(s.getEC2RuleName(), []string{Ec2StateChangeNotification}, instanceIDs, instanceIDList...)
+}
+
+// addToRule adds the values to the field of the event pattern detail of the rule, and enables the rule.
+func (s Service) addToRule(ruleName string, detailTypes []string, field eventDetailField, values ...string) error {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(ruleName),
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to describe rule %s", ruleName)
 	}
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
 	if err != nil {
 		return err
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
+	e.DetailType = detailTypes
+	if e.EventDetail == nil {
+		e.EventDetail = &eventDetail{}
+	}
 
-	for _, r := range e.EventDetail.InstanceIDs {
-		if r == instanceID {
-			// instance is already tracked by rule
-			return nil
+	tracked := field(e.EventDetail)
+	added := false
+	for _, value := range values {
+		// skip values already tracked by rule
+		if !containsString(*tracked, value) {
+			*tracked = append(*tracked, value)
+			added = true
 		}
 	}
+	if !added {
+		return nil
+	}
 
-	e.EventDetail.InstanceIDs = append(e.EventDetail.InstanceIDs, instanceID)
 	eventData, err := json.Marshal(e)
 	if err != nil {
 		return err
 	}
 	_, err = s.EventBridgeClient.PutRule(&eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(ruleName),
 		EventPattern: aws.String(string(eventData)),
 		State:        aws.String(eventbridge.RuleStateEnabled),
 	})
 	return err
 }
 
-// RemoveInstanceFromEventPattern attempts a best effort update to the event rule to remove the instance.
+// RemoveInstanceFromEventPattern attempts a best effort update to the event rules to remove the instance.
 // Any errors encountered won't be blocking.
 func (s Service) RemoveInstanceFromEventPattern(instanceID string) {
+	s.RemoveInstancesFromEventPattern([]string{instanceID})
+}
+
+// RemoveInstancesFromEventPattern attempts a best effort update to the event rules to remove the instances.
+// Any errors encountered won't be blocking.
+func (s Service) RemoveInstancesFromEventPattern(instanceIDList []string) {
+	s.removeFromRule(s.getEC2RuleName(), []string{Ec2StateChangeNotification}, instanceIDs, instanceIDList...)
+	s.removeFromRule(s.getSpotRuleName(), spotDetailTypes, instanceIDs, instanceIDList...)
+}
+
+// RemoveMachinePoolFromEventPattern attempts a best effort update to the event rules to remove the Auto Scaling group
+// of a machine pool and its instances. Any errors encountered won't be blocking.
+func (s Service) RemoveMachinePoolFromEventPattern(asgName string, instanceIDList []string) {
+	s.removeFromRule(s.getASGRuleName(), asgDetailTypes, autoScalingGroupNames, asgName)
+	if len(instanceIDList) > 0 {
+		s.RemoveInstancesFromEventPattern(instanceIDList)
+	}
+}
+
+// removeFromRule removes the values from the field of the event pattern detail of the rule, and disables the rule
+// once the field is empty.
+func (s Service) removeFromRule(ruleName string, detailTypes []string, field eventDetailField, values ...string) {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(ruleName),
 	})
 	if err != nil {
 		return
 	}
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
-	if err != nil {
+	if err != nil || e.EventDetail == nil {
 		return
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
+	e.DetailType = detailTypes
 
-	found := false
-	for i, r := range e.EventDetail.InstanceIDs {
-		if r == instanceID {
-			found = true
-			e.EventDetail.InstanceIDs = append(e.EventDetail.InstanceIDs[:i], e.EventDetail.InstanceIDs[i+1:]...)
-			break
+	tracked := field(e.EventDetail)
+	remaining := make([]string, 0, len(*tracked))
+	for _, r := range *tracked {
+		if !containsString(values, r) {
+			remaining = append(remaining, r)
 		}
 	}
 
-	if found {
+	if len(remaining) != len(*tracked) {
+		*tracked = remaining
 		eventData, err := json.Marshal(e)
 		if err != nil {
 			return
 		}
 		input := &eventbridge.PutRuleInput{
-			Name:         aws.String(s.getEC2RuleName()),
+			Name:         aws.String(ruleName),
 			EventPattern: aws.String(string(eventData)),
 			State:        aws.String(eventbridge.RuleStateEnabled),
 		}
 
-		if len(e.EventDetail.InstanceIDs) == 0 {
+		if len(remaining) == 0 {
 			input.State = aws.String(eventbridge.RuleStateDisabled)
 		}
 		_, _ = s.EventBridgeClient.PutRule(input)
@@ -245,6 +379,23 @@ func (s Service) getEC2RuleName() string {
 	return fmt.Sprintf("%s-ec2-rule", s.scope.Name())
 }
 
+func (s Service) getSpotRuleName() string {
+	return fmt.Sprintf("%s-ec2-spot-rule", s.scope.Name())
+}
+
+func (s Service) getASGRuleName() string {
+	return fmt.Sprintf("%s-asg-rule", s.scope.Name())
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceNotFoundError(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == eventbridge.ErrCodeResourceNotFoundException {
 		return true
@@ -259,6 +410,18 @@ type eventPattern struct {
 }
 
 type eventDetail struct {
-	InstanceIDs []string                `json:"instance-id,omitempty"`
-	States      []infrav1.InstanceState `json:"state,omitempty"`
+	InstanceIDs           []string                `json:"instance-id,omitempty"`
+	States                []infrav1.InstanceState `json:"state,omitempty"`
+	AutoScalingGroupNames []string                `json:"AutoScalingGroupName,omitempty"`
+}
+
+// eventDetailField returns the values of a field of an event pattern detail.
+type eventDetailField func(*eventDetail) *[]string
+
+func instanceIDs(d *eventDetail) *[]string {
+	return &d.InstanceIDs
+}
+
+func autoScalingGroupNames(d *eventDetail) *[]string {
+	return &d.AutoScalingGroupNames
 }
@@ -37,16 +37,20 @@ func TestReconcileRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	ruleName := "test-cluster-ec2-rule"
+	spotRuleName := "test-cluster-ec2-spot-rule"
+	asgRuleName := "test-cluster-asg-rule"
 
 	testCases := []struct {
 		name                        string
+		machinePools                bool
 		eventBridgeExpect           func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
 		postCreateEventBridgeExpect func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
 		sqsExpect                   func(m *mock_sqsiface.MockSQSAPIMockRecorder)
 		expectErr                   bool
 	}{
 		{
-			name: "successfully creates missing rule and target",
+			name:         "successfully creates missing rule and target",
+			machinePools: true,
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
 					Name: aws.String(ruleName),
@@ -67,6 +71,41 @@ func TestReconcileRules(t *testing.T) {
 					State:        aws.String(eventbridge.RuleStateDisabled),
 					EventPattern: aws.String(string(data)),
 				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+				spotData, err := json.Marshal(&eventPattern{
+					Source:     []string{"aws.ec2"},
+					DetailType: []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation},
+				})
+				if err != nil {
+					t.Fatalf("got an unexpected error: %v", err)
+				}
+				m.PutRule(gomock.Eq(&eventbridge.PutRuleInput{
+					Name:         aws.String(spotRuleName),
+					State:        aws.String(eventbridge.RuleStateDisabled),
+					EventPattern: aws.String(string(spotData)),
+				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(asgRuleName),
+				})).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+				asgData, err := json.Marshal(&eventPattern{
+					Source: []string{"aws.autoscaling"},
+					DetailType: []string{
+						AutoScalingInstanceLaunchSuccessful,
+						AutoScalingInstanceLaunchUnsuccessful,
+						AutoScalingInstanceTerminateSuccessful,
+						AutoScalingInstanceTerminateUnsuccessful,
+					},
+				})
+				if err != nil {
+					t.Fatalf("got an unexpected error: %v", err)
+				}
+				m.PutRule(gomock.Eq(&eventbridge.PutRuleInput{
+					Name:         aws.String(asgRuleName),
+					State:        aws.String(eventbridge.RuleStateDisabled),
+					EventPattern: aws.String(string(asgData)),
+				}))
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
@@ -87,6 +126,32 @@ func TestReconcileRules(t *testing.T) {
 						Id:  aws.String("test-cluster-queue"),
 					}},
 				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(spotRuleName), Arn: aws.String("spot-rule-arn")}, nil)
+				m.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
+					Rule: aws.String(spotRuleName),
+				}).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+				m.PutTargets(gomock.Eq(&eventbridge.PutTargetsInput{
+					Rule: aws.String(spotRuleName),
+					Targets: []*eventbridge.Target{{
+						Arn: aws.String("test-cluster-queue-arn"),
+						Id:  aws.String("test-cluster-queue"),
+					}},
+				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(asgRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(asgRuleName), Arn: aws.String("asg-rule-arn")}, nil)
+				m.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
+					Rule: aws.String(asgRuleName),
+				}).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+				m.PutTargets(gomock.Eq(&eventbridge.PutTargetsInput{
+					Rule: aws.String(asgRuleName),
+					Targets: []*eventbridge.Target{{
+						Arn: aws.String("test-cluster-queue-arn"),
+						Id:  aws.String("test-cluster-queue"),
+					}},
+				}))
 			},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(gomock.Eq(&sqs.GetQueueUrlInput{
@@ -103,29 +168,92 @@ func TestReconcileRules(t *testing.T) {
 			expectErr: false,
 		},
 		{
-			name: "skips creating target and queue policy if they already exist",
+			name:         "skips creating target and queue policy if they already exist",
+			machinePools: true,
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(ruleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(spotRuleName), Arn: aws.String("spot-rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(asgRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(asgRuleName), Arn: aws.String("asg-rule-arn")}, nil)
+				m.ListTargetsByRule(gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{
+						Id:  aws.String("test-cluster-queue"),
+						Arn: aws.String("test-cluster-queue-arn"),
+					}},
+				}, nil).Times(3)
+			},
+			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+				attrs := make(map[string]string)
+				attrs[sqs.QueueAttributeNameQueueArn] = "test-cluster-queue-arn"
+				attrs[sqs.QueueAttributeNamePolicy] = `{"aws:SourceArn":["rule-arn","spot-rule-arn","asg-rule-arn"]}`
+				m.GetQueueAttributes(gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(attrs)}, nil)
+			},
+		},
+		{
+			name:         "recreates queue policy if it doesn't authorize all rules",
+			machinePools: true,
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
 					Name: aws.String(ruleName),
 				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(spotRuleName), Arn: aws.String("spot-rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(asgRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(asgRuleName), Arn: aws.String("asg-rule-arn")}, nil)
 				m.ListTargetsByRule(gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
 					Targets: []*eventbridge.Target{{
 						Id:  aws.String("test-cluster-queue"),
 						Arn: aws.String("test-cluster-queue-arn"),
 					}},
-				}, nil)
+				}, nil).Times(3)
+			},
+			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+				attrs := make(map[string]string)
+				attrs[sqs.QueueAttributeNameQueueArn] = "test-cluster-queue-arn"
+				attrs[sqs.QueueAttributeNamePolicy] = `{"aws:SourceArn":"rule-arn"}`
+				m.GetQueueAttributes(gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(attrs)}, nil)
+				m.SetQueueAttributes(gomock.AssignableToTypeOf(&sqs.SetQueueAttributesInput{})).Return(nil, nil)
+			},
+		},
+		{
+			name: "skips the rule for Auto Scaling events without machine pools",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(ruleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(spotRuleName), Arn: aws.String("spot-rule-arn")}, nil)
+				m.ListTargetsByRule(gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{
+						Id:  aws.String("test-cluster-queue"),
+						Arn: aws.String("test-cluster-queue-arn"),
+					}},
+				}, nil).Times(2)
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
 				attrs := make(map[string]string)
 				attrs[sqs.QueueAttributeNameQueueArn] = "test-cluster-queue-arn"
-				attrs[sqs.QueueAttributeNamePolicy] = "some policy"
+				attrs[sqs.QueueAttributeNamePolicy] = `{"aws:SourceArn":["rule-arn","spot-rule-arn"]}`
 				m.GetQueueAttributes(gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(attrs)}, nil)
 			},
 		},
 		{
-			name: "returns error if DescribeRule runs into unexpected error",
+			name:         "returns error if DescribeRule runs into unexpected error",
+			machinePools: true,
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
 					Name: aws.String(ruleName),
@@ -152,7 +280,7 @@ func TestReconcileRules(t *testing.T) {
 			s.EventBridgeClient = eventbridgeMock
 			s.SQSClient = sqsMock
 
-			err = s.reconcileRules()
+			err = s.reconcileRules(tc.machinePools)
 			if tc.expectErr {
 				g.Expect(err).NotTo(BeNil())
 			} else {
@@ -181,6 +309,20 @@ func TestDeleteRules(t *testing.T) {
 				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				})).Return(nil, nil)
+				m.RemoveTargets(gomock.Eq(&eventbridge.RemoveTargetsInput{
+					Rule: aws.String("test-cluster-ec2-spot-rule"),
+					Ids:  aws.StringSlice([]string{"test-cluster-queue"}),
+				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-ec2-spot-rule"),
+				})).Return(nil, nil)
+				m.RemoveTargets(gomock.Eq(&eventbridge.RemoveTargetsInput{
+					Rule: aws.String("test-cluster-asg-rule"),
+					Ids:  aws.StringSlice([]string{"test-cluster-queue"}),
+				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-asg-rule"),
+				})).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -188,10 +330,16 @@ func TestDeleteRules(t *testing.T) {
 			name: "continues to remove rule when target doesn't exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.RemoveTargets(gomock.AssignableToTypeOf(&eventbridge.RemoveTargetsInput{})).
-					Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+					Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil)).Times(3)
 				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-ec2-spot-rule"),
+				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-asg-rule"),
+				})).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -312,6 +460,175 @@ func TestAddInstanceToRule(t *testing.T) {
 	}
 }
 
+func TestAddSpotInstanceToRule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	g := NewWithT(t)
+	eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	clusterScope, err := setupCluster("test-cluster")
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	patternData, err := json.Marshal(eventPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation},
+	})
+	g.Expect(err).To(Not(HaveOccurred()))
+	expectedData, err := json.Marshal(eventPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation},
+		EventDetail: &eventDetail{
+			InstanceIDs: []string{"instance-a"},
+		},
+	})
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-ec2-spot-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{
+		EventPattern: aws.String(string(patternData)),
+	}, nil)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-ec2-spot-rule"),
+		EventPattern: aws.String(string(expectedData)),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}).Return(nil, nil)
+
+	s := NewService(clusterScope)
+	s.EventBridgeClient = eventbridgeMock
+
+	g.Expect(s.AddSpotInstanceToEventPattern("instance-a")).To(Succeed())
+}
+
+func TestAddMachinePoolToRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	g := NewWithT(t)
+	eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	clusterScope, err := setupCluster("test-cluster")
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	asgPattern := eventPattern{
+		Source:     []string{"aws.autoscaling"},
+		DetailType: asgDetailTypes,
+	}
+	asgPatternData, err := json.Marshal(asgPattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+	asgPattern.EventDetail = &eventDetail{AutoScalingGroupNames: []string{"test-pool"}}
+	expectedASGPatternData, err := json.Marshal(asgPattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+	g.Expect(string(expectedASGPatternData)).To(ContainSubstring(`"detail":{"AutoScalingGroupName":["test-pool"]}`))
+
+	ec2Pattern := eventPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{Ec2StateChangeNotification},
+		EventDetail: &eventDetail{
+			InstanceIDs: []string{"instance-a"},
+			States:      []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+		},
+	}
+	ec2PatternData, err := json.Marshal(ec2Pattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+	ec2Pattern.EventDetail.InstanceIDs = []string{"instance-a", "instance-b"}
+	expectedEC2PatternData, err := json.Marshal(ec2Pattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-asg-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{EventPattern: aws.String(string(asgPatternData))}, nil)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-asg-rule"),
+		EventPattern: aws.String(string(expectedASGPatternData)),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}).Return(nil, nil)
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-ec2-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{EventPattern: aws.String(string(ec2PatternData))}, nil)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-ec2-rule"),
+		EventPattern: aws.String(string(expectedEC2PatternData)),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}).Return(nil, nil)
+
+	s := NewService(clusterScope)
+	s.EventBridgeClient = eventbridgeMock
+
+	g.Expect(s.AddMachinePoolToEventPattern("test-pool", []string{"instance-a", "instance-b"})).To(Succeed())
+}
+
+func TestAddMachinePoolToRulesWithoutRule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	g := NewWithT(t)
+	eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	clusterScope, err := setupCluster("test-cluster")
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-asg-rule"),
+	}).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+
+	s := NewService(clusterScope)
+	s.EventBridgeClient = eventbridgeMock
+
+	g.Expect(s.AddMachinePoolToEventPattern("test-pool", []string{"instance-a"})).To(Succeed())
+}
+
+func TestRemoveMachinePoolFromRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	g := NewWithT(t)
+	eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	clusterScope, err := setupCluster("test-cluster")
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	asgPattern := eventPattern{
+		Source:      []string{"aws.autoscaling"},
+		DetailType:  asgDetailTypes,
+		EventDetail: &eventDetail{AutoScalingGroupNames: []string{"test-pool", "other-pool"}},
+	}
+	asgPatternData, err := json.Marshal(asgPattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+	asgPattern.EventDetail.AutoScalingGroupNames = []string{"other-pool"}
+	expectedASGPatternData, err := json.Marshal(asgPattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	ec2Pattern := eventPattern{
+		Source:      []string{"aws.ec2"},
+		DetailType:  []string{Ec2StateChangeNotification},
+		EventDetail: &eventDetail{InstanceIDs: []string{"instance-a", "instance-b"}},
+	}
+	ec2PatternData, err := json.Marshal(ec2Pattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+	ec2Pattern.EventDetail.InstanceIDs = nil
+	expectedEC2PatternData, err := json.Marshal(ec2Pattern)
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-asg-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{EventPattern: aws.String(string(asgPatternData))}, nil)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-asg-rule"),
+		EventPattern: aws.String(string(expectedASGPatternData)),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}).Return(nil, nil)
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-ec2-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{EventPattern: aws.String(string(ec2PatternData))}, nil)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-ec2-rule"),
+		EventPattern: aws.String(string(expectedEC2PatternData)),
+		State:        aws.String(eventbridge.RuleStateDisabled),
+	}).Return(nil, nil)
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-ec2-spot-rule"),
+	}).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+
+	s := NewService(clusterScope)
+	s.EventBridgeClient = eventbridgeMock
+
+	s.RemoveMachinePoolFromEventPattern("test-pool", []string{"instance-a", "instance-b"})
+}
+
 func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -403,6 +720,9 @@ func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 			clusterScope, err := setupCluster("test-cluster")
 			g.Expect(err).To(Not(HaveOccurred()))
 			tc.eventBridgeExpect(eventbridgeMock.EXPECT())
+			eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+				Name: aws.String("test-cluster-ec2-spot-rule"),
+			}).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
 
 			s := NewService(clusterScope)
 			s.EventBridgeClient = eventbridgeMock
@@ -17,9 +17,12 @@ limitations under the License.
 package services
 
 import (
+	"time"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 )
 
 const (
@@ -49,6 +52,7 @@ type EC2Interface interface {
 	TerminateInstance(id string) error
 	CreateInstance(scope *scope.MachineScope, userData []byte, userDataFormat string) (*infrav1.Instance, error)
 	GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error)
+	AdoptInstance(scope *scope.MachineScope) (*infrav1.Instance, error)
 
 	GetCoreSecurityGroups(machine *scope.MachineScope) ([]string, error)
 	GetInstanceSecurityGroups(instanceID string) (map[string][]string, error)
@@ -58,17 +62,26 @@ type EC2Interface interface {
 
 	TerminateInstanceAndWait(instanceID string) error
 	DetachSecurityGroupsFromNetworkInterface(groups []string, interfaceID string) error
+	ReconcileRetainedVolumes(scope *scope.MachineScope, instance *infrav1.Instance) (bool, error)
+	GetInstanceProtection(instanceID string) (disableAPITermination bool, disableAPIStop bool, err error)
+	SetTerminationProtection(instanceID string, disableAPITermination bool) error
+	SetStopProtection(instanceID string, disableAPIStop bool) error
+	ReconcileInstanceStatus(scope *scope.MachineScope, instance *infrav1.Instance) error
 
+	DiscoverMachineAMI(spec *infrav1.AWSMachineSpec, kubernetesVersion string) (string, error)
 	DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*string, error)
 	GetLaunchTemplate(id string) (lt *expinfrav1.AWSLaunchTemplate, userDataHash string, err error)
 	GetLaunchTemplateID(id string) (string, error)
-	CreateLaunchTemplate(scope *scope.MachinePoolScope, imageID *string, userData []byte) (string, error)
-	CreateLaunchTemplateVersion(scope *scope.MachinePoolScope, imageID *string, userData []byte) error
+	CreateLaunchTemplate(scope scope.LaunchTemplateScope, imageID *string, userData []byte) (string, error)
+	CreateLaunchTemplateVersion(scope scope.LaunchTemplateScope, imageID *string, userData []byte) error
 	PruneLaunchTemplateVersions(id string) error
+	IsLaunchTemplateOwned(id string) (bool, error)
 	DeleteLaunchTemplate(id string) error
-	LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error)
+	LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error)
 	DeleteBastion() error
 	ReconcileBastion() error
+	DeleteDedicatedHosts() error
+	ReconcileDedicatedHosts() error
 }
 
 // SecretInterface encapsulated the methods exposed to the
@@ -76,7 +89,8 @@ type EC2Interface interface {
 type SecretInterface interface {
 	Delete(m *scope.MachineScope) error
 	Create(m *scope.MachineScope, data []byte) (string, int32, error)
-	UserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint) ([]byte, error)
+	UserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint, proxy *userdata.Proxy) ([]byte, error)
+	WindowsUserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint, proxy *userdata.Proxy) ([]byte, error)
 }
 
 // ELBInterface encapsulates the methods exposed to the cluster and machine
@@ -109,4 +123,5 @@ type ObjectStoreInterface interface {
 	ReconcileBucket() error
 	Delete(m *scope.MachineScope) error
 	Create(m *scope.MachineScope, data []byte) (objectURL string, err error)
+	PresignedURL(m *scope.MachineScope, expiration time.Duration) (presignedURL string, err error)
 }
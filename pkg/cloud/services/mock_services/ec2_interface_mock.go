@@ -52,6 +52,21 @@ func (m *MockEC2Interface) EXPECT() *MockEC2InterfaceMockRecorder {
 	return m.recorder
 }
 
+// AdoptInstance mocks base method.
+func (m *MockEC2Interface) AdoptInstance(arg0 *scope.MachineScope) (*v1beta1.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptInstance", arg0)
+	ret0, _ := ret[0].(*v1beta1.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdoptInstance indicates an expected call of AdoptInstance.
+func (mr *MockEC2InterfaceMockRecorder) AdoptInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptInstance", reflect.TypeOf((*MockEC2Interface)(nil).AdoptInstance), arg0)
+}
+
 // CreateInstance mocks base method.
 func (m *MockEC2Interface) CreateInstance(arg0 *scope.MachineScope, arg1 []byte, arg2 string) (*v1beta1.Instance, error) {
 	m.ctrl.T.Helper()
@@ -68,7 +83,7 @@ func (mr *MockEC2InterfaceMockRecorder) CreateInstance(arg0, arg1, arg2 interfac
 }
 
 // CreateLaunchTemplate mocks base method.
-func (m *MockEC2Interface) CreateLaunchTemplate(arg0 *scope.MachinePoolScope, arg1 *string, arg2 []byte) (string, error) {
+func (m *MockEC2Interface) CreateLaunchTemplate(arg0 scope.LaunchTemplateScope, arg1 *string, arg2 []byte) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateLaunchTemplate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(string)
@@ -83,7 +98,7 @@ func (mr *MockEC2InterfaceMockRecorder) CreateLaunchTemplate(arg0, arg1, arg2 in
 }
 
 // CreateLaunchTemplateVersion mocks base method.
-func (m *MockEC2Interface) CreateLaunchTemplateVersion(arg0 *scope.MachinePoolScope, arg1 *string, arg2 []byte) error {
+func (m *MockEC2Interface) CreateLaunchTemplateVersion(arg0 scope.LaunchTemplateScope, arg1 *string, arg2 []byte) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateLaunchTemplateVersion", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -110,6 +125,20 @@ func (mr *MockEC2InterfaceMockRecorder) DeleteBastion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBastion", reflect.TypeOf((*MockEC2Interface)(nil).DeleteBastion))
 }
 
+// DeleteDedicatedHosts mocks base method.
+func (m *MockEC2Interface) DeleteDedicatedHosts() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDedicatedHosts")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDedicatedHosts indicates an expected call of DeleteDedicatedHosts.
+func (mr *MockEC2InterfaceMockRecorder) DeleteDedicatedHosts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDedicatedHosts", reflect.TypeOf((*MockEC2Interface)(nil).DeleteDedicatedHosts))
+}
+
 // DeleteLaunchTemplate mocks base method.
 func (m *MockEC2Interface) DeleteLaunchTemplate(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -153,6 +182,21 @@ func (mr *MockEC2InterfaceMockRecorder) DiscoverLaunchTemplateAMI(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverLaunchTemplateAMI", reflect.TypeOf((*MockEC2Interface)(nil).DiscoverLaunchTemplateAMI), arg0)
 }
 
+// DiscoverMachineAMI mocks base method.
+func (m *MockEC2Interface) DiscoverMachineAMI(arg0 *v1beta1.AWSMachineSpec, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverMachineAMI", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverMachineAMI indicates an expected call of DiscoverMachineAMI.
+func (mr *MockEC2InterfaceMockRecorder) DiscoverMachineAMI(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverMachineAMI", reflect.TypeOf((*MockEC2Interface)(nil).DiscoverMachineAMI), arg0, arg1)
+}
+
 // GetCoreSecurityGroups mocks base method.
 func (m *MockEC2Interface) GetCoreSecurityGroups(arg0 *scope.MachineScope) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +227,22 @@ func (mr *MockEC2InterfaceMockRecorder) GetFilteredSecurityGroupID(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredSecurityGroupID", reflect.TypeOf((*MockEC2Interface)(nil).GetFilteredSecurityGroupID), arg0)
 }
 
+// GetInstanceProtection mocks base method.
+func (m *MockEC2Interface) GetInstanceProtection(arg0 string) (bool, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceProtection", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceProtection indicates an expected call of GetInstanceProtection.
+func (mr *MockEC2InterfaceMockRecorder) GetInstanceProtection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceProtection", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceProtection), arg0)
+}
+
 // GetInstanceSecurityGroups mocks base method.
 func (m *MockEC2Interface) GetInstanceSecurityGroups(arg0 string) (map[string][]string, error) {
 	m.ctrl.T.Helper()
@@ -259,8 +319,23 @@ func (mr *MockEC2InterfaceMockRecorder) InstanceIfExists(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceIfExists", reflect.TypeOf((*MockEC2Interface)(nil).InstanceIfExists), arg0)
 }
 
+// IsLaunchTemplateOwned mocks base method.
+func (m *MockEC2Interface) IsLaunchTemplateOwned(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLaunchTemplateOwned", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsLaunchTemplateOwned indicates an expected call of IsLaunchTemplateOwned.
+func (mr *MockEC2InterfaceMockRecorder) IsLaunchTemplateOwned(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLaunchTemplateOwned", reflect.TypeOf((*MockEC2Interface)(nil).IsLaunchTemplateOwned), arg0)
+}
+
 // LaunchTemplateNeedsUpdate mocks base method.
-func (m *MockEC2Interface) LaunchTemplateNeedsUpdate(arg0 *scope.MachinePoolScope, arg1, arg2 *v1beta10.AWSLaunchTemplate) (bool, error) {
+func (m *MockEC2Interface) LaunchTemplateNeedsUpdate(arg0 scope.LaunchTemplateScope, arg1, arg2 *v1beta10.AWSLaunchTemplate) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "LaunchTemplateNeedsUpdate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
@@ -302,6 +377,77 @@ func (mr *MockEC2InterfaceMockRecorder) ReconcileBastion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileBastion", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileBastion))
 }
 
+// ReconcileDedicatedHosts mocks base method.
+func (m *MockEC2Interface) ReconcileDedicatedHosts() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileDedicatedHosts")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileDedicatedHosts indicates an expected call of ReconcileDedicatedHosts.
+func (mr *MockEC2InterfaceMockRecorder) ReconcileDedicatedHosts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileDedicatedHosts", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileDedicatedHosts))
+}
+
+// ReconcileInstanceStatus mocks base method.
+func (m *MockEC2Interface) ReconcileInstanceStatus(arg0 *scope.MachineScope, arg1 *v1beta1.Instance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileInstanceStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileInstanceStatus indicates an expected call of ReconcileInstanceStatus.
+func (mr *MockEC2InterfaceMockRecorder) ReconcileInstanceStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileInstanceStatus", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileInstanceStatus), arg0, arg1)
+}
+
+// ReconcileRetainedVolumes mocks base method.
+func (m *MockEC2Interface) ReconcileRetainedVolumes(arg0 *scope.MachineScope, arg1 *v1beta1.Instance) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRetainedVolumes", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileRetainedVolumes indicates an expected call of ReconcileRetainedVolumes.
+func (mr *MockEC2InterfaceMockRecorder) ReconcileRetainedVolumes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRetainedVolumes", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileRetainedVolumes), arg0, arg1)
+}
+
+// SetStopProtection mocks base method.
+func (m *MockEC2Interface) SetStopProtection(arg0 string, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStopProtection", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStopProtection indicates an expected call of SetStopProtection.
+func (mr *MockEC2InterfaceMockRecorder) SetStopProtection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStopProtection", reflect.TypeOf((*MockEC2Interface)(nil).SetStopProtection), arg0, arg1)
+}
+
+// SetTerminationProtection mocks base method.
+func (m *MockEC2Interface) SetTerminationProtection(arg0 string, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTerminationProtection", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTerminationProtection indicates an expected call of SetTerminationProtection.
+func (mr *MockEC2InterfaceMockRecorder) SetTerminationProtection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTerminationProtection", reflect.TypeOf((*MockEC2Interface)(nil).SetTerminationProtection), arg0, arg1)
+}
+
 // TerminateInstance mocks base method.
 func (m *MockEC2Interface) TerminateInstance(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ package mock_services
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	scope "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
@@ -93,6 +94,21 @@ func (mr *MockObjectStoreInterfaceMockRecorder) DeleteBucket() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockObjectStoreInterface)(nil).DeleteBucket))
 }
 
+// PresignedURL mocks base method.
+func (m *MockObjectStoreInterface) PresignedURL(arg0 *scope.MachineScope, arg1 time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignedURL", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignedURL indicates an expected call of PresignedURL.
+func (mr *MockObjectStoreInterfaceMockRecorder) PresignedURL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedURL", reflect.TypeOf((*MockObjectStoreInterface)(nil).PresignedURL), arg0, arg1)
+}
+
 // ReconcileBucket mocks base method.
 func (m *MockObjectStoreInterface) ReconcileBucket() error {
 	m.ctrl.T.Helper()
@@ -136,7 +136,10 @@ func (s *Service) reconcileSubnets() error {
 
 			// Update subnet spec with the existing subnet details
 			// TODO(vincepri): check if subnet needs to be updated.
+			privateDNSNameOptions := sub.PrivateDNSNameOptionsOnLaunch
 			existingSubnet.DeepCopyInto(sub)
+			// The private DNS name options are only applied when the subnet is created, so keep the desired ones.
+			sub.PrivateDNSNameOptionsOnLaunch = privateDNSNameOptions
 		} else if unmanagedVPC {
 			// If there is no existing subnet and we have an umanaged vpc report an error
 			record.Warnf(s.scope.InfraCluster(), "FailedMatchSubnet", "Using unmanaged VPC and failed to find existing subnet for specified subnet id %d, cidr %q", sub.ID, sub.CidrBlock)
@@ -385,6 +388,14 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifySubnetAttributes", "Modified managed Subnet %q attributes", *out.Subnet.SubnetId)
 	}
 
+	if sn.PrivateDNSNameOptionsOnLaunch != nil {
+		if err := s.modifySubnetPrivateDNSNameOptions(*out.Subnet.SubnetId, sn.PrivateDNSNameOptionsOnLaunch); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedModifySubnetAttributes", "Failed modifying managed Subnet %q attributes: %v", *out.Subnet.SubnetId, err)
+			return nil, errors.Wrapf(err, "failed to set subnet %q private DNS name options", *out.Subnet.SubnetId)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifySubnetAttributes", "Modified managed Subnet %q attributes", *out.Subnet.SubnetId)
+	}
+
 	s.scope.V(2).Info("Created new subnet in VPC with cidr and availability zone ",
 		"subnet-id", *out.Subnet.SubnetId,
 		"vpc-id", *out.Subnet.VpcId,
@@ -392,13 +403,51 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		"availability-zone", *out.Subnet.AvailabilityZone)
 
 	return &infrav1.SubnetSpec{
-		ID:               *out.Subnet.SubnetId,
-		AvailabilityZone: *out.Subnet.AvailabilityZone,
-		CidrBlock:        *out.Subnet.CidrBlock,
-		IsPublic:         sn.IsPublic,
+		ID:                            *out.Subnet.SubnetId,
+		AvailabilityZone:              *out.Subnet.AvailabilityZone,
+		CidrBlock:                     *out.Subnet.CidrBlock,
+		IsPublic:                      sn.IsPublic,
+		PrivateDNSNameOptionsOnLaunch: sn.PrivateDNSNameOptionsOnLaunch,
 	}, nil
 }
 
+// modifySubnetPrivateDNSNameOptions sets the hostname type and DNS records of the instances launched in the subnet.
+// Only one attribute of a subnet can be modified per request.
+func (s *Service) modifySubnetPrivateDNSNameOptions(subnetID string, options *infrav1.PrivateDNSName) error {
+	inputs := []*ec2.ModifySubnetAttributeInput{}
+	if options.HostnameType != "" {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{
+			SubnetId:                       aws.String(subnetID),
+			PrivateDnsHostnameTypeOnLaunch: aws.String(string(options.HostnameType)),
+		})
+	}
+	if options.EnableResourceNameDNSARecord != nil {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{
+			SubnetId:                             aws.String(subnetID),
+			EnableResourceNameDnsARecordOnLaunch: &ec2.AttributeBooleanValue{Value: options.EnableResourceNameDNSARecord},
+		})
+	}
+	if options.EnableResourceNameDNSAAAARecord != nil {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{
+			SubnetId:                                aws.String(subnetID),
+			EnableResourceNameDnsAAAARecordOnLaunch: &ec2.AttributeBooleanValue{Value: options.EnableResourceNameDNSAAAARecord},
+		})
+	}
+
+	for _, attReq := range inputs {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if _, err := s.EC2Client.ModifySubnetAttribute(attReq); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.SubnetNotFound); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) deleteSubnet(id string) error {
 	_, err := s.EC2Client.DeleteSubnet(&ec2.DeleteSubnetInput{
 		SubnetId: aws.String(id),
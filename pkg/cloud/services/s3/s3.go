@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -31,10 +32,21 @@ import (
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/pkg/errors"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	iam "sigs.k8s.io/cluster-api-provider-aws/iam/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 )
 
+// The error codes of the S3 API for bucket configurations which are not set.
+const (
+	errCodeNoSuchPublicAccessBlockConfiguration      = "NoSuchPublicAccessBlockConfiguration"
+	errCodeServerSideEncryptionConfigurationNotFound = "ServerSideEncryptionConfigurationNotFoundError"
+	errCodeNoSuchLifecycleConfiguration              = "NoSuchLifecycleConfiguration"
+)
+
+// bootstrapDataPrefixes are the key prefixes of the bootstrap data objects in the bucket.
+var bootstrapDataPrefixes = []string{"control-plane", "node", securePrefix}
+
 // Service holds a collection of interfaces.
 // The interfaces are broken down like this to group functions together.
 // One alternative is to have a large list of functions from the ec2 client.
@@ -67,10 +79,30 @@ func (s *Service) ReconcileBucket() error {
 		return errors.Wrap(err, "ensuring bucket exists")
 	}
 
+	if err := s.ensureBucketOwnershipControls(bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket ownership controls")
+	}
+
+	if err := s.ensureBucketPublicAccessBlock(bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket public access block")
+	}
+
+	if err := s.ensureBucketEncryption(bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket encryption")
+	}
+
 	if err := s.ensureBucketPolicy(bucketName); err != nil {
 		return errors.Wrap(err, "ensuring bucket policy")
 	}
 
+	if err := s.ensureBucketLifecycle(bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket lifecycle")
+	}
+
+	if err := s.ensureBucketLogging(bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket logging")
+	}
+
 	return nil
 }
 
@@ -132,6 +164,8 @@ func (s *Service) Create(m *scope.MachineScope, data []byte) (string, error) {
 		Bucket:               aws.String(bucket),
 		Key:                  aws.String(key),
 		ServerSideEncryption: aws.String("aws:kms"),
+		SSEKMSKeyId:          s.bucketKMSKeyID(),
+		Tagging:              aws.String(objectTagging(s.scope.Name())),
 	}); err != nil {
 		return "", errors.Wrap(err, "putting object")
 	}
@@ -145,6 +179,41 @@ func (s *Service) Create(m *scope.MachineScope, data []byte) (string, error) {
 	return objectURL.String(), nil
 }
 
+// PresignedURL returns a presigned HTTPS URL of the bootstrap data object of the machine, which is valid for
+// the given duration and the lifetime of the credentials of the controller.
+func (s *Service) PresignedURL(m *scope.MachineScope, expiration time.Duration) (string, error) {
+	if !s.bucketManagementEnabled() {
+		return "", errors.New("requested presigned object URL but bucket management is not enabled")
+	}
+
+	if m == nil {
+		return "", errors.New("machine scope can't be nil")
+	}
+
+	req, _ := s.S3Client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName()),
+		Key:    aws.String(s.bootstrapDataKey(m)),
+	})
+
+	// A presigned URL is only valid as long as the credentials which signed it, so limit it to temporary credentials.
+	if _, err := req.Config.Credentials.Get(); err != nil {
+		return "", errors.Wrap(err, "getting credentials to presign object URL")
+	}
+	if expiresAt, err := req.Config.Credentials.ExpiresAt(); err == nil && !expiresAt.IsZero() {
+		if remaining := time.Until(expiresAt); remaining > 0 && remaining < expiration {
+			s.scope.Info("Limiting presigned object URL to the expiry of the controller credentials", "expiration", expiration, "credentials_expire_in", remaining)
+			expiration = remaining
+		}
+	}
+
+	presignedURL, err := req.Presign(expiration)
+	if err != nil {
+		return "", errors.Wrap(err, "presigning object URL")
+	}
+
+	return presignedURL, nil
+}
+
 func (s *Service) Delete(m *scope.MachineScope) error {
 	if !s.bucketManagementEnabled() {
 		return errors.New("requested object creation but bucket management is not enabled")
@@ -229,6 +298,267 @@ func (s *Service) ensureBucketPolicy(bucketName string) error {
 	return nil
 }
 
+// ensureBucketOwnershipControls sets the object ownership of the bucket, if configured.
+func (s *Service) ensureBucketOwnershipControls(bucketName string) error {
+	objectOwnership := s.scope.Bucket().ObjectOwnership
+	if objectOwnership == "" {
+		return nil
+	}
+
+	input := &s3.PutBucketOwnershipControlsInput{
+		Bucket: aws.String(bucketName),
+		OwnershipControls: &s3.OwnershipControls{
+			Rules: []*s3.OwnershipControlsRule{
+				{ObjectOwnership: aws.String(string(objectOwnership))},
+			},
+		},
+	}
+
+	if _, err := s.S3Client.PutBucketOwnershipControls(input); err != nil {
+		return errors.Wrap(err, "putting S3 bucket ownership controls")
+	}
+
+	s.scope.V(4).Info("Updated bucket ownership controls", "bucket_name", bucketName, "object_ownership", objectOwnership)
+
+	return nil
+}
+
+// ensureBucketPublicAccessBlock blocks all public access to the bucket, if configured. Removing the setting
+// does not unblock public access.
+func (s *Service) ensureBucketPublicAccessBlock(bucketName string) error {
+	if !s.scope.Bucket().BlockPublicAccess {
+		return nil
+	}
+
+	out, err := s.S3Client.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil && !isAWSErrorCode(err, errCodeNoSuchPublicAccessBlockConfiguration) {
+		return errors.Wrap(err, "getting S3 bucket public access block")
+	}
+	if out != nil && out.PublicAccessBlockConfiguration != nil {
+		current := out.PublicAccessBlockConfiguration
+		if aws.BoolValue(current.BlockPublicAcls) && aws.BoolValue(current.BlockPublicPolicy) &&
+			aws.BoolValue(current.IgnorePublicAcls) && aws.BoolValue(current.RestrictPublicBuckets) {
+			return nil
+		}
+	}
+
+	input := &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	}
+
+	if _, err := s.S3Client.PutPublicAccessBlock(input); err != nil {
+		return errors.Wrap(err, "putting S3 bucket public access block")
+	}
+
+	s.scope.V(4).Info("Updated bucket public access block", "bucket_name", bucketName)
+
+	return nil
+}
+
+// ensureBucketEncryption sets the default SSE-KMS encryption of the bucket, if configured. Removing the setting
+// keeps the last default encryption of the bucket.
+func (s *Service) ensureBucketEncryption(bucketName string) error {
+	if s.scope.Bucket().Encryption == nil {
+		return nil
+	}
+
+	rule := &s3.ServerSideEncryptionRule{
+		ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+			SSEAlgorithm:   aws.String(s3.ServerSideEncryptionAwsKms),
+			KMSMasterKeyID: s.bucketKMSKeyID(),
+		},
+		// Reduce the KMS requests for the objects of the bucket.
+		BucketKeyEnabled: aws.Bool(true),
+	}
+
+	out, err := s.S3Client.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil && !isAWSErrorCode(err, errCodeServerSideEncryptionConfigurationNotFound) {
+		return errors.Wrap(err, "getting S3 bucket encryption")
+	}
+	if out != nil && out.ServerSideEncryptionConfiguration != nil && len(out.ServerSideEncryptionConfiguration.Rules) == 1 {
+		current := out.ServerSideEncryptionConfiguration.Rules[0]
+		if current.ApplyServerSideEncryptionByDefault != nil &&
+			aws.StringValue(current.ApplyServerSideEncryptionByDefault.SSEAlgorithm) == s3.ServerSideEncryptionAwsKms &&
+			aws.StringValue(current.ApplyServerSideEncryptionByDefault.KMSMasterKeyID) == aws.StringValue(s.bucketKMSKeyID()) &&
+			aws.BoolValue(current.BucketKeyEnabled) {
+			return nil
+		}
+	}
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucketName),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{rule},
+		},
+	}
+
+	if _, err := s.S3Client.PutBucketEncryption(input); err != nil {
+		return errors.Wrap(err, "putting S3 bucket encryption")
+	}
+
+	s.scope.V(4).Info("Updated bucket encryption", "bucket_name", bucketName)
+
+	return nil
+}
+
+// ensureBucketLifecycle expires the bootstrap data objects of the bucket after the configured number of days.
+// The lifecycle rules of other objects are kept, and the rules of the bootstrap data are removed with the setting.
+func (s *Service) ensureBucketLifecycle(bucketName string) error {
+	expirationDays := s.scope.Bucket().BootstrapDataExpirationDays
+
+	out, err := s.S3Client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil && !isAWSErrorCode(err, errCodeNoSuchLifecycleConfiguration) {
+		return errors.Wrap(err, "getting S3 bucket lifecycle configuration")
+	}
+
+	desired := map[string]*s3.LifecycleRule{}
+	if expirationDays != nil {
+		for _, prefix := range bootstrapDataPrefixes {
+			desired[bootstrapDataLifecycleRuleID(prefix)] = &s3.LifecycleRule{
+				ID:     aws.String(bootstrapDataLifecycleRuleID(prefix)),
+				Status: aws.String(s3.ExpirationStatusEnabled),
+				Filter: &s3.LifecycleRuleFilter{
+					Prefix: aws.String(prefix + "/"),
+				},
+				Expiration: &s3.LifecycleExpiration{
+					Days: aws.Int64(int64(*expirationDays)),
+				},
+			}
+		}
+	}
+
+	rules := []*s3.LifecycleRule{}
+	upToDate := 0
+	changed := false
+	if out != nil {
+		for _, rule := range out.Rules {
+			if !isBootstrapDataLifecycleRule(rule) {
+				rules = append(rules, rule)
+				continue
+			}
+			if want, ok := desired[aws.StringValue(rule.ID)]; ok && bootstrapDataLifecycleRuleEqual(want, rule) {
+				upToDate++
+				continue
+			}
+			changed = true
+		}
+	}
+	if !changed && upToDate == len(desired) {
+		return nil
+	}
+
+	for _, prefix := range bootstrapDataPrefixes {
+		if rule, ok := desired[bootstrapDataLifecycleRuleID(prefix)]; ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	if len(rules) == 0 {
+		if _, err := s.S3Client.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+			Bucket: aws.String(bucketName),
+		}); err != nil {
+			return errors.Wrap(err, "deleting S3 bucket lifecycle configuration")
+		}
+
+		s.scope.V(4).Info("Deleted bucket lifecycle configuration", "bucket_name", bucketName)
+
+		return nil
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	if _, err := s.S3Client.PutBucketLifecycleConfiguration(input); err != nil {
+		return errors.Wrap(err, "putting S3 bucket lifecycle configuration")
+	}
+
+	s.scope.V(4).Info("Updated bucket lifecycle configuration", "bucket_name", bucketName, "expiration_days", aws.Int32Value(expirationDays))
+
+	return nil
+}
+
+// ensureBucketLogging delivers the server access logs of the bucket to the configured target bucket.
+// Removing the setting does not disable the access logging of the bucket.
+func (s *Service) ensureBucketLogging(bucketName string) error {
+	accessLogging := s.scope.Bucket().AccessLogging
+	if accessLogging == nil {
+		return nil
+	}
+
+	out, err := s.S3Client.GetBucketLogging(&s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return errors.Wrap(err, "getting S3 bucket logging")
+	}
+	if current := out.LoggingEnabled; current != nil &&
+		aws.StringValue(current.TargetBucket) == accessLogging.TargetBucket &&
+		aws.StringValue(current.TargetPrefix) == accessLogging.TargetPrefix {
+		return nil
+	}
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucketName),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: &s3.LoggingEnabled{
+				TargetBucket: aws.String(accessLogging.TargetBucket),
+				TargetPrefix: aws.String(accessLogging.TargetPrefix),
+			},
+		},
+	}
+
+	if _, err := s.S3Client.PutBucketLogging(input); err != nil {
+		return errors.Wrap(err, "putting S3 bucket logging")
+	}
+
+	s.scope.V(4).Info("Updated bucket logging", "bucket_name", bucketName, "target_bucket", accessLogging.TargetBucket)
+
+	return nil
+}
+
+// bootstrapDataLifecycleRuleID returns the ID of the lifecycle rule expiring the bootstrap data below the prefix.
+func bootstrapDataLifecycleRuleID(prefix string) string {
+	return fmt.Sprintf("expire-%s-bootstrap-data", prefix)
+}
+
+func isBootstrapDataLifecycleRule(rule *s3.LifecycleRule) bool {
+	for _, prefix := range bootstrapDataPrefixes {
+		if aws.StringValue(rule.ID) == bootstrapDataLifecycleRuleID(prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func bootstrapDataLifecycleRuleEqual(want, got *s3.LifecycleRule) bool {
+	return aws.StringValue(got.Status) == aws.StringValue(want.Status) &&
+		got.Filter != nil && aws.StringValue(got.Filter.Prefix) == aws.StringValue(want.Filter.Prefix) &&
+		got.Expiration != nil && aws.Int64Value(got.Expiration.Days) == aws.Int64Value(want.Expiration.Days)
+}
+
+// isAWSErrorCode returns whether the error is an AWS error with the code.
+func isAWSErrorCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
+
 func (s *Service) bucketPolicy(bucketName string) (string, error) {
 	accountID, err := s.STSClient.GetCallerIdentity(&sts.GetCallerIdentityInput{})
 	if err != nil {
@@ -237,8 +567,10 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 
 	bucket := s.scope.Bucket()
 
-	statements := []iam.StatementEntry{
-		{
+	statements := []iam.StatementEntry{}
+
+	if !bucket.PresignedIgnitionURLs {
+		statements = append(statements, iam.StatementEntry{
 			Sid:    "control-plane",
 			Effect: iam.EffectAllow,
 			Principal: map[iam.PrincipalType]iam.PrincipalID{
@@ -246,18 +578,68 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 			},
 			Action:   []string{"s3:GetObject"},
 			Resource: []string{fmt.Sprintf("arn:aws:s3:::%s/control-plane/*", bucketName)},
-		},
+		})
+
+		for _, iamInstanceProfile := range bucket.NodesIAMInstanceProfiles {
+			statements = append(statements, iam.StatementEntry{
+				Sid:    iamInstanceProfile,
+				Effect: iam.EffectAllow,
+				Principal: map[iam.PrincipalType]iam.PrincipalID{
+					iam.PrincipalAWS: []string{fmt.Sprintf("arn:aws:iam::%s:role/%s", *accountID.Account, iamInstanceProfile)},
+				},
+				Action:   []string{"s3:GetObject"},
+				Resource: []string{fmt.Sprintf("arn:aws:s3:::%s/node/*", bucketName)},
+			})
+		}
 	}
 
-	for _, iamInstanceProfile := range bucket.NodesIAMInstanceProfiles {
+	// Scope each IAM instance profile to read and delete the secure userdata below its own prefix.
+	seen := map[string]bool{}
+	roles := []string{}
+	for _, iamInstanceProfile := range append([]string{bucket.ControlPlaneIAMInstanceProfile}, bucket.NodesIAMInstanceProfiles...) {
+		if seen[iamInstanceProfile] {
+			continue
+		}
+		seen[iamInstanceProfile] = true
+		roles = append(roles, fmt.Sprintf("arn:aws:iam::%s:role/%s", *accountID.Account, iamInstanceProfile))
+
 		statements = append(statements, iam.StatementEntry{
-			Sid:    iamInstanceProfile,
+			Sid:    fmt.Sprintf("%s-%s", securePrefix, iamInstanceProfile),
 			Effect: iam.EffectAllow,
 			Principal: map[iam.PrincipalType]iam.PrincipalID{
 				iam.PrincipalAWS: []string{fmt.Sprintf("arn:aws:iam::%s:role/%s", *accountID.Account, iamInstanceProfile)},
 			},
+			Action:   []string{"s3:GetObject", "s3:DeleteObject"},
+			Resource: []string{fmt.Sprintf("arn:aws:s3:::%s/%s/%s/*", bucketName, securePrefix, iamInstanceProfile)},
+		})
+	}
+
+	// Ignition machines fetch their bootstrap data with presigned URLs of the controller, so deny the IAM
+	// instance profiles even if their own IAM policies allow reading from the bucket.
+	if bucket.PresignedIgnitionURLs {
+		statements = append(statements, iam.StatementEntry{
+			Sid:    "deny-ignition-bootstrap-data",
+			Effect: iam.EffectDeny,
+			Principal: map[iam.PrincipalType]iam.PrincipalID{
+				iam.PrincipalAWS: roles,
+			},
 			Action:   []string{"s3:GetObject"},
-			Resource: []string{fmt.Sprintf("arn:aws:s3:::%s/node/*", bucketName)},
+			Resource: []string{fmt.Sprintf("arn:aws:s3:::%s/control-plane/*", bucketName), fmt.Sprintf("arn:aws:s3:::%s/node/*", bucketName)},
+		})
+	}
+
+	if bucket.DenyInsecureTransport {
+		statements = append(statements, iam.StatementEntry{
+			Sid:    "deny-insecure-transport",
+			Effect: iam.EffectDeny,
+			Principal: map[iam.PrincipalType]iam.PrincipalID{
+				iam.PrincipalAWS: []string{iam.Any},
+			},
+			Action:   []string{"s3:*"},
+			Resource: []string{fmt.Sprintf("arn:aws:s3:::%s", bucketName), fmt.Sprintf("arn:aws:s3:::%s/*", bucketName)},
+			Condition: iam.Conditions{
+				iam.Bool: map[string]string{"aws:SecureTransport": "false"},
+			},
 		})
 	}
 
@@ -274,6 +656,15 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 	return string(policyRaw), nil
 }
 
+// bucketKMSKeyID returns the customer managed KMS key of the bucket encryption, or nil to use the AWS managed key.
+func (s *Service) bucketKMSKeyID() *string {
+	if encryption := s.scope.Bucket().Encryption; encryption != nil && encryption.KMSKeyARN != "" {
+		return aws.String(encryption.KMSKeyARN)
+	}
+
+	return nil
+}
+
 func (s *Service) bucketManagementEnabled() bool {
 	return s.scope.Bucket() != nil
 }
@@ -282,6 +673,12 @@ func (s *Service) bucketName() string {
 	return s.scope.Bucket().Name
 }
 
+// objectTagging returns the URL encoded tags of the bootstrap data objects of a cluster, which tell them
+// apart from the objects of other clusters sharing the bucket.
+func objectTagging(clusterName string) string {
+	return url.Values{infrav1.ClusterTagKey(clusterName): []string{string(infrav1.ResourceLifecycleOwned)}}.Encode()
+}
+
 func (s *Service) bootstrapDataKey(m *scope.MachineScope) string {
 	// Use machine name as object key.
 	return path.Join(m.Role(), m.Name())
@@ -17,16 +17,21 @@ limitations under the License.
 package s3_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	s3svc "github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/golang/mock/gomock"
@@ -76,6 +81,7 @@ func Test_Reconcile_bucket(t *testing.T) {
 
 		s3Mock.EXPECT().CreateBucket(gomock.Eq(input)).Return(nil, nil).Times(1)
 		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New("NoSuchLifecycleConfiguration", "", nil)).Times(1)
 
 		if err := svc.ReconcileBucket(); err != nil {
 			t.Fatalf("Unexpected error: %v", err)
@@ -130,6 +136,7 @@ func Test_Reconcile_bucket(t *testing.T) {
 		}).Return(nil, nil).Times(1)
 
 		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New("NoSuchLifecycleConfiguration", "", nil)).Times(1)
 
 		if err := svc.ReconcileBucket(); err != nil {
 			t.Fatalf("Unexpected error: %v", err)
@@ -172,7 +179,252 @@ func Test_Reconcile_bucket(t *testing.T) {
 			if !strings.Contains(policy, fmt.Sprintf("%s/node/*", bucketName)) {
 				t.Errorf("At least one policy should apply for all objects with %q prefix, got: %v", "node", policy)
 			}
+
+			for _, profile := range []string{"control-plane", "nodes"} {
+				securePrefix := fmt.Sprintf("%s/secure/%s%s/*", bucketName, profile, iamv1.DefaultNameSuffix)
+				if !strings.Contains(policy, securePrefix) {
+					t.Errorf("At least one policy should apply for all objects with %q prefix, got: %v", securePrefix, policy)
+				}
+			}
 		}).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New("NoSuchLifecycleConfiguration", "", nil)).Times(1)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("hardens_bucket_with_configured_settings", func(t *testing.T) {
+		t.Parallel()
+
+		bucketName := "bar"
+		keyARN := "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name:                        bucketName,
+			Encryption:                  &infrav1.S3BucketEncryption{KMSKeyARN: keyARN},
+			BlockPublicAccess:           true,
+			ObjectOwnership:             infrav1.S3ObjectOwnershipBucketOwnerEnforced,
+			DenyInsecureTransport:       true,
+			BootstrapDataExpirationDays: aws.Int32(7),
+			AccessLogging: &infrav1.S3BucketAccessLogging{
+				TargetBucket: "logs",
+				TargetPrefix: "bar/",
+			},
+		})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketOwnershipControls(gomock.Eq(&s3svc.PutBucketOwnershipControlsInput{
+			Bucket: aws.String(bucketName),
+			OwnershipControls: &s3svc.OwnershipControls{
+				Rules: []*s3svc.OwnershipControlsRule{{ObjectOwnership: aws.String("BucketOwnerEnforced")}},
+			},
+		})).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetPublicAccessBlock(gomock.Any()).Return(nil, awserr.New("NoSuchPublicAccessBlockConfiguration", "", nil)).Times(1)
+		s3Mock.EXPECT().PutPublicAccessBlock(gomock.Eq(&s3svc.PutPublicAccessBlockInput{
+			Bucket: aws.String(bucketName),
+			PublicAccessBlockConfiguration: &s3svc.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		})).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketEncryption(gomock.Any()).Return(&s3svc.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &s3svc.ServerSideEncryptionConfiguration{
+				Rules: []*s3svc.ServerSideEncryptionRule{{
+					ApplyServerSideEncryptionByDefault: &s3svc.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String("AES256")},
+				}},
+			},
+		}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketEncryption(gomock.Eq(&s3svc.PutBucketEncryptionInput{
+			Bucket: aws.String(bucketName),
+			ServerSideEncryptionConfiguration: &s3svc.ServerSideEncryptionConfiguration{
+				Rules: []*s3svc.ServerSideEncryptionRule{{
+					ApplyServerSideEncryptionByDefault: &s3svc.ServerSideEncryptionByDefault{
+						SSEAlgorithm:   aws.String("aws:kms"),
+						KMSMasterKeyID: aws.String(keyARN),
+					},
+					BucketKeyEnabled: aws.Bool(true),
+				}},
+			},
+		})).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Do(func(input *s3svc.PutBucketPolicyInput) {
+			policy := *input.Policy
+
+			if !strings.Contains(policy, `"Condition":{"Bool":{"aws:SecureTransport":"false"}}`) {
+				t.Errorf("Policy should deny requests not sent over TLS, got: %v", policy)
+			}
+		}).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3svc.GetBucketLifecycleConfigurationOutput{
+			Rules: []*s3svc.LifecycleRule{{ID: aws.String("expire-logs"), Status: aws.String("Enabled")}},
+		}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLifecycleConfiguration(gomock.Any()).Do(func(input *s3svc.PutBucketLifecycleConfigurationInput) {
+			rules := input.LifecycleConfiguration.Rules
+			if len(rules) != 4 {
+				t.Fatalf("Expected the existing lifecycle rule and a lifecycle rule for each bootstrap data prefix, got: %v", rules)
+			}
+
+			if *rules[0].ID != "expire-logs" {
+				t.Errorf("Expected the existing lifecycle rule to be kept, got: %v", rules[0])
+			}
+
+			for i, prefix := range []string{"control-plane/", "node/", "secure/"} {
+				if *rules[i+1].Filter.Prefix != prefix || *rules[i+1].Expiration.Days != 7 {
+					t.Errorf("Expected objects with %q prefix to expire after 7 days, got: %v", prefix, rules[i+1])
+				}
+			}
+		}).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLogging(gomock.Any()).Return(&s3svc.GetBucketLoggingOutput{}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLogging(gomock.Eq(&s3svc.PutBucketLoggingInput{
+			Bucket: aws.String(bucketName),
+			BucketLoggingStatus: &s3svc.BucketLoggingStatus{
+				LoggingEnabled: &s3svc.LoggingEnabled{
+					TargetBucket: aws.String("logs"),
+					TargetPrefix: aws.String("bar/"),
+				},
+			},
+		})).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("skips_bucket_settings_which_are_up_to_date", func(t *testing.T) {
+		t.Parallel()
+
+		bucketName := "bar"
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name:                        bucketName,
+			Encryption:                  &infrav1.S3BucketEncryption{},
+			BlockPublicAccess:           true,
+			BootstrapDataExpirationDays: aws.Int32(7),
+			AccessLogging: &infrav1.S3BucketAccessLogging{
+				TargetBucket: "logs",
+			},
+		})
+
+		lifecycleRules := []*s3svc.LifecycleRule{}
+		for _, prefix := range []string{"control-plane", "node", "secure"} {
+			lifecycleRules = append(lifecycleRules, &s3svc.LifecycleRule{
+				ID:         aws.String(fmt.Sprintf("expire-%s-bootstrap-data", prefix)),
+				Status:     aws.String("Enabled"),
+				Filter:     &s3svc.LifecycleRuleFilter{Prefix: aws.String(prefix + "/")},
+				Expiration: &s3svc.LifecycleExpiration{Days: aws.Int64(7)},
+			})
+		}
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetPublicAccessBlock(gomock.Any()).Return(&s3svc.GetPublicAccessBlockOutput{
+			PublicAccessBlockConfiguration: &s3svc.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		}, nil).Times(1)
+		s3Mock.EXPECT().GetBucketEncryption(gomock.Any()).Return(&s3svc.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &s3svc.ServerSideEncryptionConfiguration{
+				Rules: []*s3svc.ServerSideEncryptionRule{{
+					ApplyServerSideEncryptionByDefault: &s3svc.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String("aws:kms")},
+					BucketKeyEnabled:                   aws.Bool(true),
+				}},
+			},
+		}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3svc.GetBucketLifecycleConfigurationOutput{
+			Rules: lifecycleRules,
+		}, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLogging(gomock.Any()).Return(&s3svc.GetBucketLoggingOutput{
+			LoggingEnabled: &s3svc.LoggingEnabled{
+				TargetBucket: aws.String("logs"),
+				TargetPrefix: aws.String(""),
+			},
+		}, nil).Times(1)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("removes_bootstrap_data_lifecycle_rules_when_expiration_is_unset", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{Name: "bar"})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3svc.GetBucketLifecycleConfigurationOutput{
+			Rules: []*s3svc.LifecycleRule{
+				{ID: aws.String("expire-logs"), Status: aws.String("Enabled")},
+				{ID: aws.String("expire-node-bootstrap-data"), Status: aws.String("Enabled")},
+			},
+		}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLifecycleConfiguration(gomock.Eq(&s3svc.PutBucketLifecycleConfigurationInput{
+			Bucket: aws.String("bar"),
+			LifecycleConfiguration: &s3svc.BucketLifecycleConfiguration{
+				Rules: []*s3svc.LifecycleRule{{ID: aws.String("expire-logs"), Status: aws.String("Enabled")}},
+			},
+		})).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("deletes_bucket_lifecycle_configuration_without_other_rules_when_expiration_is_unset", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{Name: "bar"})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3svc.GetBucketLifecycleConfigurationOutput{
+			Rules: []*s3svc.LifecycleRule{{ID: aws.String("expire-node-bootstrap-data"), Status: aws.String("Enabled")}},
+		}, nil).Times(1)
+		s3Mock.EXPECT().DeleteBucketLifecycle(gomock.Eq(&s3svc.DeleteBucketLifecycleInput{
+			Bucket: aws.String("bar"),
+		})).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("creates_bucket_with_policy_denying_ignition_bootstrap_data_to_instance_profiles", func(t *testing.T) {
+		t.Parallel()
+
+		bucketName := "bar"
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name:                           bucketName,
+			ControlPlaneIAMInstanceProfile: "control-plane",
+			NodesIAMInstanceProfiles:       []string{"nodes"},
+			PresignedIgnitionURLs:          true,
+		})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Do(func(input *s3svc.PutBucketPolicyInput) {
+			policy := iamv1.PolicyDocument{}
+			if err := json.Unmarshal([]byte(*input.Policy), &policy); err != nil {
+				t.Fatalf("Unexpected policy: %v", err)
+			}
+
+			for _, statement := range policy.Statement {
+				for _, resource := range statement.Resource {
+					if statement.Effect == iamv1.EffectAllow && !strings.Contains(resource, "/secure/") {
+						t.Errorf("Instance profiles should only be allowed to read secure userdata, got: %v", statement)
+					}
+				}
+			}
+
+			if !strings.Contains(*input.Policy, `"Sid":"deny-ignition-bootstrap-data","Principal":{"AWS":["arn:aws:iam::foo:role/control-plane","arn:aws:iam::foo:role/nodes"]},"Effect":"Deny"`) {
+				t.Errorf("Policy should deny instance profiles reading Ignition bootstrap data, got: %v", *input.Policy)
+			}
+		}).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New("NoSuchLifecycleConfiguration", "", nil)).Times(1)
 
 		if err := svc.ReconcileBucket(); err != nil {
 			t.Fatalf("Unexpected error: %v", err)
@@ -186,6 +438,7 @@ func Test_Reconcile_bucket(t *testing.T) {
 
 		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil).Times(2)
 		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(2)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New("NoSuchLifecycleConfiguration", "", nil)).Times(2)
 
 		if err := svc.ReconcileBucket(); err != nil {
 			t.Fatalf("Unexpected error: %v", err)
@@ -205,6 +458,7 @@ func Test_Reconcile_bucket(t *testing.T) {
 
 		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, err).Times(1)
 		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New("NoSuchLifecycleConfiguration", "", nil)).Times(1)
 
 		if err := svc.ReconcileBucket(); err != nil {
 			t.Fatalf("Unexpected error, got: %v", err)
@@ -402,6 +656,15 @@ func Test_Create_object(t *testing.T) {
 				}
 			})
 
+			t.Run("tags_object_with_cluster", func(t *testing.T) {
+				t.Parallel()
+
+				expectedTagging := "sigs.k8s.io%2Fcluster-api-provider-aws%2Fcluster%2F" + testClusterName + "=owned"
+				if aws.StringValue(putObjectInput.Tagging) != expectedTagging {
+					t.Errorf("Expected tagging %q, got: %q", expectedTagging, aws.StringValue(putObjectInput.Tagging))
+				}
+			})
+
 			t.Run("puts_given_bootstrap_data_untouched", func(t *testing.T) {
 				t.Parallel()
 
@@ -565,6 +828,107 @@ func Test_Create_object(t *testing.T) {
 	})
 }
 
+func Test_Presigned_object_URL(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bucketName = "foo"
+		nodeName   = "aws-test1"
+	)
+
+	t.Run("presigns_get_request_of_machine_bootstrap_data", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name: bucketName,
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+		}
+
+		signer := s3svc.New(session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-east-1"),
+			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		})))
+		s3Mock.EXPECT().GetObjectRequest(gomock.Eq(&s3svc.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String("node/" + nodeName),
+		})).DoAndReturn(signer.GetObjectRequest).Times(1)
+
+		presignedURL, err := svc.PresignedURL(machineScope, time.Hour)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		parsedURL, err := url.Parse(presignedURL)
+		if err != nil {
+			t.Fatalf("Presigned URL is invalid: %v", err)
+		}
+
+		if parsedURL.Scheme != "https" || !strings.HasSuffix(parsedURL.Path, "node/"+nodeName) {
+			t.Errorf("Expected HTTPS URL of the bootstrap data object, got: %q", presignedURL)
+		}
+
+		if parsedURL.Query().Get("X-Amz-Expires") != "3600" {
+			t.Errorf("Expected presigned URL to expire after an hour, got: %q", presignedURL)
+		}
+	})
+
+	t.Run("limits_presigned_url_to_expiry_of_temporary_credentials", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name: bucketName,
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+		}
+
+		signer := s3svc.New(session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-east-1"),
+			Credentials: credentials.NewCredentials(&temporaryCredentials{expiresAt: time.Now().Add(30 * time.Minute)}),
+		})))
+		s3Mock.EXPECT().GetObjectRequest(gomock.Any()).DoAndReturn(signer.GetObjectRequest).Times(1)
+
+		presignedURL, err := svc.PresignedURL(machineScope, time.Hour)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		parsedURL, err := url.Parse(presignedURL)
+		if err != nil {
+			t.Fatalf("Presigned URL is invalid: %v", err)
+		}
+
+		expires, err := strconv.Atoi(parsedURL.Query().Get("X-Amz-Expires"))
+		if err != nil || expires > 1800 || expires < 1790 {
+			t.Errorf("Expected presigned URL to expire with the credentials after 30 minutes, got: %q", presignedURL)
+		}
+	})
+
+	t.Run("returns_error_when_bucket_management_is_disabled", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := testService(t, nil)
+
+		if _, err := svc.PresignedURL(&scope.MachineScope{}, time.Hour); err == nil {
+			t.Fatalf("Expected error")
+		}
+	})
+}
+
 func Test_Delete_object(t *testing.T) {
 	t.Parallel()
 
@@ -760,3 +1124,20 @@ func testService(t *testing.T, bucket *infrav1.S3Bucket) (*s3.Service, *mock_s3i
 
 	return svc, s3Mock
 }
+
+// temporaryCredentials is a credentials provider of credentials which expire at a fixed time.
+type temporaryCredentials struct {
+	expiresAt time.Time
+}
+
+func (c *temporaryCredentials) Retrieve() (credentials.Value, error) {
+	return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN"}, nil
+}
+
+func (c *temporaryCredentials) IsExpired() bool {
+	return time.Now().After(c.expiresAt)
+}
+
+func (c *temporaryCredentials) ExpiresAt() time.Time {
+	return c.expiresAt
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/mime"
+)
+
+const (
+	serviceID = "s3"
+
+	// securePrefix is the prefix of the bucket keys holding secure cloud-init userdata. Each
+	// IAM instance profile may only read and delete the objects below its own prefix.
+	securePrefix = "secure"
+)
+
+// SecretService stores cloud-init userdata as an SSE-KMS encrypted object in the
+// bucket of the cluster, so it can be used as a secure secrets backend.
+type SecretService struct {
+	scope    scope.S3Scope
+	S3Client s3iface.S3API
+}
+
+// NewSecretService returns a new secret service given the api clients.
+func NewSecretService(s3Scope scope.S3Scope) *SecretService {
+	return &SecretService{
+		scope:    s3Scope,
+		S3Client: scope.NewS3Client(s3Scope, s3Scope, s3Scope, s3Scope.InfraCluster()),
+	}
+}
+
+// Create stores data as a single object below the prefix of the IAM instance profile of the machine.
+// The object key ends with the SHA-256 checksum of the data, which the fetch script verifies.
+// The S3 URL of the object is returned as prefix together with a single chunk.
+func (s *SecretService) Create(m *scope.MachineScope, data []byte) (string, int32, error) {
+	bucket := s.scope.Bucket()
+	if bucket == nil {
+		return "", 0, errors.New("the s3 secure secrets backend requires an S3 bucket to be configured on the cluster")
+	}
+
+	if m == nil {
+		return "", 0, errors.New("machine scope can't be nil")
+	}
+
+	if len(data) == 0 {
+		return "", 0, errors.New("got empty data")
+	}
+
+	iamInstanceProfile := m.AWSMachine.Spec.IAMInstanceProfile
+	if !bucketHasIAMInstanceProfile(bucket.ControlPlaneIAMInstanceProfile, bucket.NodesIAMInstanceProfiles, iamInstanceProfile) {
+		return "", 0, errors.Errorf("IAM instance profile %q is not allowed to read from S3 bucket %q", iamInstanceProfile, bucket.Name)
+	}
+
+	sum := sha256.Sum256(data)
+	key := path.Join(securePrefix, iamInstanceProfile, m.Name(), hex.EncodeToString(sum[:]))
+
+	encryptionContext, err := s.encryptionContext()
+	if err != nil {
+		return "", 0, err
+	}
+
+	input := &s3.PutObjectInput{
+		Body:                    aws.ReadSeekCloser(bytes.NewReader(data)),
+		Bucket:                  aws.String(bucket.Name),
+		Key:                     aws.String(key),
+		ServerSideEncryption:    aws.String(s3.ServerSideEncryptionAwsKms),
+		SSEKMSEncryptionContext: aws.String(encryptionContext),
+		Tagging:                 aws.String(objectTagging(s.scope.Name())),
+		// S3 Bucket Keys are encrypted with the bucket ARN as encryption context instead of the one of the object.
+		BucketKeyEnabled: aws.Bool(false),
+	}
+	// Encrypt with the customer managed key of the machine or the bucket if set, otherwise with the AWS managed key.
+	if keyARN := m.SecretsKMSKeyARN(); keyARN != "" {
+		input.SSEKMSKeyId = aws.String(keyARN)
+	} else if bucket.Encryption != nil && bucket.Encryption.KMSKeyARN != "" {
+		input.SSEKMSKeyId = aws.String(bucket.Encryption.KMSKeyARN)
+	}
+
+	s.scope.Info("Creating secure userdata object", "bucket_name", bucket.Name, "key", key)
+
+	if _, err := s.S3Client.PutObject(input); err != nil {
+		return "", 0, errors.Wrap(err, "putting object")
+	}
+
+	objectURL := &url.URL{
+		Scheme: "s3",
+		Host:   bucket.Name,
+		Path:   key,
+	}
+
+	return objectURL.String(), 1, nil
+}
+
+// encryptionContext returns the base64 encoded KMS encryption context of the secure userdata objects. It holds
+// the name of the cluster, which the KMS decrypt permission of the nodes of the cluster is scoped to.
+func (s *SecretService) encryptionContext() (string, error) {
+	encryptionContext, err := json.Marshal(map[string]string{infrav1.NameAWSClusterName: s.scope.Name()})
+	if err != nil {
+		return "", errors.Wrap(err, "marshalling encryption context")
+	}
+	return base64.StdEncoding.EncodeToString(encryptionContext), nil
+}
+
+// Delete removes the object holding the userdata of a machine, ignoring if it is absent.
+func (s *SecretService) Delete(m *scope.MachineScope) error {
+	if m.GetSecretPrefix() == "" {
+		return nil
+	}
+
+	bucket, key, err := parseObjectURL(m.GetSecretPrefix())
+	if err != nil {
+		return err
+	}
+
+	s.scope.Info("Deleting secure userdata object", "bucket_name", bucket, "key", key)
+
+	_, err = s.S3Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err == nil {
+		return nil
+	}
+
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return errors.Wrap(err, "deleting S3 object")
+	}
+
+	switch aerr.Code() {
+	case s3.ErrCodeNoSuchBucket, s3.ErrCodeNoSuchKey:
+	default:
+		return errors.Wrap(aerr, "deleting S3 object")
+	}
+
+	return nil
+}
+
+// UserData creates a multi-part MIME document including a script boothook to
+// download userdata from Amazon S3 and then restart cloud-init, and an include part
+// specifying the on disk location of the new userdata.
+func (s *SecretService) UserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint, proxy *userdata.Proxy) ([]byte, error) {
+	serviceEndpoint := ""
+	for _, v := range endpoints {
+		if v.ServiceID == serviceID {
+			serviceEndpoint = v.URL
+		}
+	}
+	proxyScript, err := proxy.Script()
+	if err != nil {
+		return []byte{}, err
+	}
+	userData, err := mime.GenerateInitDocument(secretPrefix, chunks, region, serviceEndpoint, proxyScript, secretFetchScript)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return userData, nil
+}
+
+// WindowsUserData is not supported by the s3 secure secrets backend.
+func (s *SecretService) WindowsUserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint, proxy *userdata.Proxy) ([]byte, error) {
+	return nil, errors.New("the s3 secure secrets backend does not support Windows machines")
+}
+
+// parseObjectURL returns the bucket and key of an s3:// object URL.
+func parseObjectURL(objectURL string) (string, string, error) {
+	u, err := url.Parse(objectURL)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "parsing object URL %q", objectURL)
+	}
+
+	key := strings.TrimPrefix(u.Path, "/")
+	if u.Scheme != "s3" || u.Host == "" || key == "" {
+		return "", "", errors.Errorf("invalid object URL %q", objectURL)
+	}
+
+	return u.Host, key, nil
+}
+
+func bucketHasIAMInstanceProfile(controlPlaneIAMInstanceProfile string, nodesIAMInstanceProfiles []string, iamInstanceProfile string) bool {
+	if iamInstanceProfile == "" {
+		return false
+	}
+
+	if iamInstanceProfile == controlPlaneIAMInstanceProfile {
+		return true
+	}
+
+	for _, profile := range nodesIAMInstanceProfiles {
+		if profile == iamInstanceProfile {
+			return true
+		}
+	}
+
+	return false
+}
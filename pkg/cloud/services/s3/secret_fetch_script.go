@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+// nolint: gosec
+const secretFetchScript = `#cloud-boothook 
+#!/bin/bash
+
+# Copyright 2022 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+{{if .Proxy}}{{.Proxy}}
+{{end}}umask 006
+
+REGION="{{.Region}}"
+if [ "{{.Endpoint}}" != "" ]; then
+  ENDPOINT="--endpoint-url {{.Endpoint}}"
+fi
+SECRET_PREFIX="{{.SecretPrefix}}"
+OBJECT="${SECRET_PREFIX#s3://}"
+BUCKET="${OBJECT%%/*}"
+KEY="${OBJECT#*/}"
+CHECKSUM="${KEY##*/}"
+FILE="/etc/secret-userdata.txt"
+
+# Log an error and exit.
+# Args:
+#   $1 Message to log with the error
+#   $2 The error code to return
+log::error_exit() {
+  local message="${1}"
+  local code="${2}"
+
+  log::error "${message}"
+  log::error "aws.cluster.x-k8s.io encrypted cloud-init script $0 exiting with status ${code}"
+  exit "${code}"
+}
+
+log::success_exit() {
+  log::info "aws.cluster.x-k8s.io encrypted cloud-init script $0 finished"
+  exit 0
+}
+
+# Log an error but keep going.
+log::error() {
+  local message="${1}"
+  timestamp=$(date --iso-8601=seconds)
+  echo "!!! [${timestamp}] ${1}" >&2
+  shift
+  for message; do
+    echo "    ${message}" >&2
+  done
+}
+
+# Print a status line.  Formatted to show up in a stream of output.
+log::info() {
+  timestamp=$(date --iso-8601=seconds)
+  echo "+++ [${timestamp}] ${1}"
+  shift
+  for message; do
+    echo "    ${message}"
+  done
+}
+
+check_aws_command() {
+  local command="${1}"
+  local code="${2}"
+  local out="${3}"
+  local sanitised="${out//[$'\t\r\n']/}"
+  case ${code} in
+  "0")
+    log::info "AWS CLI reported successful execution for ${command}"
+    ;;
+  "2")
+    log::error "AWS CLI reported that it could not parse ${command}"
+    log::error "${sanitised}"
+    ;;
+  "130")
+    log::error "AWS CLI reported SIGINT signal during ${command}"
+    log::error "${sanitised}"
+    ;;
+  "255")
+    log::error "AWS CLI reported service error for ${command}"
+    log::error "${sanitised}"
+    ;;
+  *)
+    log::error "AWS CLI reported unknown error ${code} for ${command}"
+    log::error "${sanitised}"
+    ;;
+  esac
+}
+delete_object() {
+  local out
+  log::info "deleting userdata object from Amazon S3"
+  set +o errexit
+  set +o nounset
+  set +o pipefail
+  out=$(
+    aws s3api ${ENDPOINT} --region ${REGION} delete-object --bucket "${BUCKET}" --key "${KEY}" 2>&1
+  )
+  local delete_return=$?
+  set -o errexit
+  set -o nounset
+  set -o pipefail
+  check_aws_command "S3::DeleteObject" "${delete_return}" "${out}"
+  if [ ${delete_return} -ne 0 ]; then
+    log::error_exit "Could not delete userdata object" 2
+  fi
+}
+
+get_object() {
+  log::info "getting userdata object from Amazon S3"
+
+  local out
+  set +o errexit
+  set +o nounset
+  set +o pipefail
+  out=$(
+    aws s3api ${ENDPOINT} --region ${REGION} get-object --bucket "${BUCKET}" --key "${KEY}" "${FILE}.gz" 2>&1
+  )
+  local get_return=$?
+  check_aws_command "S3::GetObject" "${get_return}" "${out}"
+  set -o errexit
+  set -o nounset
+  set -o pipefail
+  if [ ${get_return} -ne 0 ]; then
+    log::error "could not get userdata object, deleting object"
+    rm -f "${FILE}.gz"
+    delete_object
+    log::error_exit "could not get userdata object, but object was deleted" 1
+  fi
+}
+
+verify_object() {
+  local sum
+  sum=$(sha256sum "${FILE}.gz" | cut -d ' ' -f 1)
+  if [ "${sum}" != "${CHECKSUM}" ]; then
+    log::error "userdata checksum ${sum} does not match ${CHECKSUM}, deleting object"
+    rm -f "${FILE}.gz"
+    delete_object
+    log::error_exit "userdata object failed verification, but object was deleted" 3
+  fi
+  log::info "userdata checksum verified"
+}
+
+log::info "aws.cluster.x-k8s.io encrypted cloud-init script $0 started"
+log::info "secret bucket: ${BUCKET}"
+log::info "secret key: ${KEY}"
+
+if test -f "${FILE}"; then
+  log::info "encrypted userdata already written to disk"
+  log::success_exit
+fi
+
+get_object
+verify_object
+delete_object
+
+log::info "decompressing userdata to ${FILE}"
+gunzip "${FILE}.gz"
+GUNZIP_RETURN=$?
+if [ ${GUNZIP_RETURN} -ne 0 ]; then
+  log::error_exit "could not unzip data" 4
+fi
+
+log::info "restarting cloud-init"
+systemctl restart cloud-init
+log::success_exit
+`
@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	s3svc "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3/mock_s3iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testKMSKeyARN       = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	testSecretBucket    = "secure-bucket"
+	testSecretNodeName  = "aws-test1"
+	testNodesProfile    = "nodes.cluster-api-provider-aws.sigs.k8s.io"
+	testControlProfile  = "control-plane.cluster-api-provider-aws.sigs.k8s.io"
+	testUnknownProfile  = "unknown.cluster-api-provider-aws.sigs.k8s.io"
+	testSecretObjectURL = "s3://secure-bucket/secure/nodes.cluster-api-provider-aws.sigs.k8s.io/aws-test1/abc"
+)
+
+func TestSecretServiceCreate(t *testing.T) {
+	data := []byte("userdata")
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name               string
+		bucket             *infrav1.S3Bucket
+		iamInstanceProfile string
+		kmsKeyARN          string
+		expect             func(m *mock_s3iface.MockS3APIMockRecorder)
+		wantPrefix         string
+		wantErr            bool
+	}{
+		{
+			name:               "stores the data below the prefix of the IAM instance profile",
+			bucket:             testSecretServiceBucket(),
+			iamInstanceProfile: testNodesProfile,
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.PutObject(gomock.Any()).DoAndReturn(func(input *s3svc.PutObjectInput) (*s3svc.PutObjectOutput, error) {
+					if aws.StringValue(input.ServerSideEncryption) != s3svc.ServerSideEncryptionAwsKms {
+						t.Errorf("Expected SSE-KMS encryption, got %q", aws.StringValue(input.ServerSideEncryption))
+					}
+					if input.SSEKMSKeyId != nil {
+						t.Errorf("Expected AWS managed key, got %q", aws.StringValue(input.SSEKMSKeyId))
+					}
+					encryptionContext := base64.StdEncoding.EncodeToString([]byte(`{"sigs.k8s.io/cluster-api-provider-aws/cluster-name":"` + testClusterName + `"}`))
+					if aws.StringValue(input.SSEKMSEncryptionContext) != encryptionContext {
+						t.Errorf("Expected encryption context %q, got %q", encryptionContext, aws.StringValue(input.SSEKMSEncryptionContext))
+					}
+					if aws.BoolValue(input.BucketKeyEnabled) {
+						t.Errorf("Expected S3 Bucket Key to be disabled")
+					}
+					if aws.StringValue(input.Tagging) != "sigs.k8s.io%2Fcluster-api-provider-aws%2Fcluster%2F"+testClusterName+"=owned" {
+						t.Errorf("Expected object to be tagged with the cluster, got %q", aws.StringValue(input.Tagging))
+					}
+					return &s3svc.PutObjectOutput{}, nil
+				})
+			},
+			wantPrefix: "s3://" + testSecretBucket + "/secure/" + testNodesProfile + "/" + testSecretNodeName + "/" + checksum,
+		},
+		{
+			name:               "encrypts the data with the customer managed key",
+			bucket:             testSecretServiceBucket(),
+			iamInstanceProfile: testControlProfile,
+			kmsKeyARN:          testKMSKeyARN,
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.PutObject(gomock.Any()).DoAndReturn(func(input *s3svc.PutObjectInput) (*s3svc.PutObjectOutput, error) {
+					if aws.StringValue(input.SSEKMSKeyId) != testKMSKeyARN {
+						t.Errorf("Expected key %q, got %q", testKMSKeyARN, aws.StringValue(input.SSEKMSKeyId))
+					}
+					return &s3svc.PutObjectOutput{}, nil
+				})
+			},
+			wantPrefix: "s3://" + testSecretBucket + "/secure/" + testControlProfile + "/" + testSecretNodeName + "/" + checksum,
+		},
+		{
+			name:               "fails when the cluster has no bucket",
+			iamInstanceProfile: testNodesProfile,
+			expect:             func(m *mock_s3iface.MockS3APIMockRecorder) {},
+			wantErr:            true,
+		},
+		{
+			name:               "fails when the IAM instance profile may not read from the bucket",
+			bucket:             testSecretServiceBucket(),
+			iamInstanceProfile: testUnknownProfile,
+			expect:             func(m *mock_s3iface.MockS3APIMockRecorder) {},
+			wantErr:            true,
+		},
+		{
+			name:               "fails when the object can't be put",
+			bucket:             testSecretServiceBucket(),
+			iamInstanceProfile: testNodesProfile,
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.PutObject(gomock.Any()).Return(nil, awserr.New("AccessDenied", "", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+
+			svc, machineScope := testSecretService(t, tc.bucket)
+			svc.S3Client = s3Mock
+			machineScope.AWSMachine.Spec.IAMInstanceProfile = tc.iamInstanceProfile
+			machineScope.AWSMachine.Spec.CloudInit.KMSKeyARN = tc.kmsKeyARN
+			tc.expect(s3Mock.EXPECT())
+
+			prefix, chunks, err := svc.Create(machineScope, data)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(prefix).To(Equal(tc.wantPrefix))
+			g.Expect(chunks).To(Equal(int32(1)))
+		})
+	}
+}
+
+func TestSecretServiceDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		expect  func(m *mock_s3iface.MockS3APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name:   "deletes the object of the secret prefix",
+			prefix: testSecretObjectURL,
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.DeleteObject(gomock.Eq(&s3svc.DeleteObjectInput{
+					Bucket: aws.String(testSecretBucket),
+					Key:    aws.String(strings.TrimPrefix(testSecretObjectURL, "s3://"+testSecretBucket+"/")),
+				})).Return(&s3svc.DeleteObjectOutput{}, nil)
+			},
+		},
+		{
+			name:   "does nothing without a secret prefix",
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {},
+		},
+		{
+			name:   "ignores a missing bucket",
+			prefix: testSecretObjectURL,
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.DeleteObject(gomock.Any()).Return(nil, awserr.New(s3svc.ErrCodeNoSuchBucket, "", nil))
+			},
+		},
+		{
+			name:    "fails on an invalid secret prefix",
+			prefix:  "aws.cluster.x-k8s.io/abc",
+			expect:  func(m *mock_s3iface.MockS3APIMockRecorder) {},
+			wantErr: true,
+		},
+		{
+			name:   "fails when the object can't be deleted",
+			prefix: testSecretObjectURL,
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.DeleteObject(gomock.Any()).Return(nil, awserr.New("AccessDenied", "", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+
+			svc, machineScope := testSecretService(t, testSecretServiceBucket())
+			svc.S3Client = s3Mock
+			machineScope.SetSecretPrefix(tc.prefix)
+			tc.expect(s3Mock.EXPECT())
+
+			err := svc.Delete(machineScope)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestSecretServiceUserData(t *testing.T) {
+	g := NewWithT(t)
+
+	svc, _ := testSecretService(t, testSecretServiceBucket())
+
+	userData, err := svc.UserData(testSecretObjectURL, 1, "us-east-1", nil, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(userData)).To(ContainSubstring(`SECRET_PREFIX="` + testSecretObjectURL + `"`))
+	g.Expect(string(userData)).To(ContainSubstring("aws s3api"))
+
+	_, err = svc.WindowsUserData(testSecretObjectURL, 1, "us-east-1", nil, nil)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func testSecretServiceBucket() *infrav1.S3Bucket {
+	return &infrav1.S3Bucket{
+		Name:                           testSecretBucket,
+		ControlPlaneIAMInstanceProfile: testControlProfile,
+		NodesIAMInstanceProfiles:       []string{testNodesProfile},
+	}
+}
+
+func testSecretService(t *testing.T, bucket *infrav1.S3Bucket) (*s3.SecretService, *scope.MachineScope) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testClusterName,
+				Namespace: testClusterNamespace,
+			},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				S3Bucket: bucket,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	machineScope := &scope.MachineScope{
+		Machine: &clusterv1.Machine{},
+		AWSMachine: &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testSecretNodeName,
+			},
+		},
+		InfraCluster: clusterScope,
+	}
+
+	return s3.NewSecretService(clusterScope), machineScope
+}
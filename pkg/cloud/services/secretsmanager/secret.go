@@ -65,14 +65,22 @@ func (s *Service) Create(m *scope.MachineScope, data []byte) (string, int32, err
 	// Build the prefix.
 	prefix := m.GetSecretPrefix()
 	if prefix == "" {
-		prefix = path.Join(entryPrefix, string(uuid.NewUUID()))
+		// The cluster name in the prefix is part of the KMS encryption context of the secret,
+		// which the KMS decrypt permission of the nodes of the cluster is scoped to.
+		prefix = path.Join(entryPrefix, s.scope.Name(), string(uuid.NewUUID()))
 	}
+	// Encrypt with the customer managed key if set, otherwise with the AWS managed key.
+	var kmsKeyID *string
+	if keyARN := m.SecretsKMSKeyARN(); keyARN != "" {
+		kmsKeyID = aws.String(keyARN)
+	}
+
 	// Split the data into chunks and create the secrets on demand.
 	chunks := int32(0)
 	var err error
 	bytes.Split(data, false, maxSecretSizeBytes, func(chunk []byte) {
 		name := fmt.Sprintf("%s-%d", prefix, chunks)
-		retryFunc := func() (bool, error) { return s.retryableCreateSecret(name, chunk, tags) }
+		retryFunc := func() (bool, error) { return s.retryableCreateSecret(name, chunk, tags, kmsKeyID) }
 		// Default timeout is 5 mins, but if Secrets Manager has got to the state where the timeout is reached,
 		// makes sense to slow down machine creation until AWS weather improves.
 		if err = wait.WaitForWithRetryable(wait.NewBackoff(), retryFunc, retryableErrors...); err != nil {
@@ -85,10 +93,11 @@ func (s *Service) Create(m *scope.MachineScope, data []byte) (string, int32, err
 }
 
 // retryableCreateSecret is a function to be passed into a waiter. In a separate function for ease of reading.
-func (s *Service) retryableCreateSecret(name string, chunk []byte, tags infrav1.Tags) (bool, error) {
+func (s *Service) retryableCreateSecret(name string, chunk []byte, tags infrav1.Tags, kmsKeyID *string) (bool, error) {
 	_, err := s.SecretsManagerClient.CreateSecret(&secretsmanager.CreateSecretInput{
 		Name:         aws.String(name),
 		SecretBinary: chunk,
+		KmsKeyId:     kmsKeyID,
 		Tags:         converters.MapToSecretsManagerTags(tags),
 	})
 	// If the secret already exists, delete it, return request to retry, as deletes are eventually consistent
@@ -86,6 +86,7 @@ func TestService_Create(t *testing.T) {
 		name           string
 		bytesCount     int64
 		secretPrefix   string
+		kmsKeyARN      string
 		expectedPrefix string
 		wantErr        bool
 		expect         func(g *WithT, m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder)
@@ -110,12 +111,28 @@ func TestService_Create(t *testing.T) {
 				m.CreateSecret(gomock.AssignableToTypeOf(&secretsmanager.CreateSecretInput{})).MinTimes(1).Return(&secretsmanager.CreateSecretOutput{}, nil).Do(
 					func(createSecretInput *secretsmanager.CreateSecretInput) {
 						g.Expect(*(createSecretInput.Name)).To(HavePrefix("prefix-"))
+						g.Expect(createSecretInput.KmsKeyId).To(BeNil())
 						sortTagsByKey(createSecretInput.Tags)
 						g.Expect(createSecretInput.Tags).To(Equal(expectedTags))
 					},
 				)
 			},
 		},
+		{
+			name:           "Should encrypt data with the customer managed KMS key if set",
+			bytesCount:     10,
+			secretPrefix:   "prefix",
+			kmsKeyARN:      "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			expectedPrefix: "prefix",
+			wantErr:        false,
+			expect: func(g *WithT, m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder) {
+				m.CreateSecret(gomock.AssignableToTypeOf(&secretsmanager.CreateSecretInput{})).Return(&secretsmanager.CreateSecretOutput{}, nil).Do(
+					func(createSecretInput *secretsmanager.CreateSecretInput) {
+						g.Expect(createSecretInput.KmsKeyId).To(Equal(aws.String("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab")))
+					},
+				)
+			},
+		},
 		{
 			name:           "Should not retry if non-retryable error occurred while storing data in secret manager",
 			bytesCount:     10,
@@ -136,7 +153,7 @@ func TestService_Create(t *testing.T) {
 			name:           "Should retry if retryable error occurred while storing data in secret manager",
 			bytesCount:     10,
 			secretPrefix:   "",
-			expectedPrefix: "aws.cluster.x-k8s.io",
+			expectedPrefix: "aws.cluster.x-k8s.io/test/",
 			wantErr:        false,
 			expect: func(g *WithT, m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder) {
 				m.CreateSecret(gomock.AssignableToTypeOf(&secretsmanager.CreateSecretInput{})).Return(nil, &secretsmanager.InvalidRequestException{})
@@ -148,7 +165,7 @@ func TestService_Create(t *testing.T) {
 			name:           "Should delete and retry creation if resource already exists while storing data in secret manager",
 			bytesCount:     10,
 			secretPrefix:   "",
-			expectedPrefix: "aws.cluster.x-k8s.io",
+			expectedPrefix: "aws.cluster.x-k8s.io/test/",
 			wantErr:        false,
 			expect: func(g *WithT, m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder) {
 				m.CreateSecret(gomock.AssignableToTypeOf(&secretsmanager.CreateSecretInput{})).Return(nil, &secretsmanager.ResourceExistsException{})
@@ -173,6 +190,7 @@ func TestService_Create(t *testing.T) {
 			ms, err := getMachineScope(client, clusterScope)
 			g.Expect(err).NotTo(HaveOccurred())
 			ms.SetSecretPrefix(tt.secretPrefix)
+			ms.AWSMachine.Spec.CloudInit.KMSKeyARN = tt.kmsKeyARN
 			data := generateBytes(g, tt.bytesCount)
 
 			prefix, _, err := s.Create(ms, data)
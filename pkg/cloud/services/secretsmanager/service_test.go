@@ -19,15 +19,18 @@ package secretsmanager
 import (
 	"bytes"
 	"net/mail"
+	"strings"
 	"testing"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 )
 
 func TestUserData(t *testing.T) {
 	service := Service{}
 	endpoints := []scope.ServiceEndpoint{}
-	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints)
+	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints, nil)
 
 	if _, err := mail.ReadMessage(bytes.NewBuffer(doc)); err != nil {
 		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
@@ -43,9 +46,56 @@ func TestUserDataEndpoints(t *testing.T) {
 			ServiceID:     "secretsmanager",
 		},
 	}
-	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints)
+	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints, nil)
 
 	if _, err := mail.ReadMessage(bytes.NewBuffer(doc)); err != nil {
 		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
 	}
 }
+
+func TestWindowsUserData(t *testing.T) {
+	service := Service{}
+	endpoints := []scope.ServiceEndpoint{
+		{
+			URL:           "localhost",
+			SigningRegion: "localhost",
+			ServiceID:     "secretsmanager",
+		},
+	}
+	doc, err := service.WindowsUserData("secretARN", 1, "eu-west-1", endpoints, nil)
+	if err != nil {
+		t.Fatalf("Cannot generate EC2Launch document: %+v", err)
+	}
+
+	for _, want := range []string{"executeScript", "$SecretPrefix = \"secretARN\"", "$Endpoint = \"localhost\""} {
+		if !strings.Contains(string(doc), want) {
+			t.Fatalf("EC2Launch document does not contain %q:\n%s", want, string(doc))
+		}
+	}
+}
+
+func TestUserDataProxy(t *testing.T) {
+	service := Service{}
+	proxy := userdata.NewProxy(&infrav1.ProxyConfig{HTTPSProxy: "http://proxy.example.com:3128"}, "", "10.0.0.0/16")
+
+	doc, err := service.UserData("secretARN", 1, "eu-west-1", nil, proxy)
+	if err != nil {
+		t.Fatalf("Cannot generate MIME doc: %+v", err)
+	}
+	script := string(doc)
+	want := "export HTTPS_PROXY=http://proxy.example.com:3128 https_proxy=http://proxy.example.com:3128"
+	if !strings.Contains(script, want) || strings.Index(script, want) > strings.Index(script, "aws secretsmanager") {
+		t.Fatalf("MIME doc does not export %q before fetching the secrets:\n%s", want, script)
+	}
+	if !strings.Contains(script, "10.0.0.0/16") {
+		t.Fatalf("MIME doc does not exclude the VPC from the proxy:\n%s", script)
+	}
+
+	doc, err = service.WindowsUserData("secretARN", 1, "eu-west-1", nil, proxy)
+	if err != nil {
+		t.Fatalf("Cannot generate EC2Launch document: %+v", err)
+	}
+	if want := "Set-AWSProxy -Hostname 'proxy.example.com' -Port 3128"; !strings.Contains(string(doc), want) {
+		t.Fatalf("EC2Launch document does not contain %q:\n%s", want, string(doc))
+	}
+}
@@ -18,6 +18,8 @@ package ssm
 
 import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/ec2launch"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/mime"
 )
 
@@ -28,14 +30,38 @@ const (
 // UserData creates a multi-part MIME document including a script boothook to
 // download userdata from AWS Systems Manager and then restart cloud-init, and an include part
 // specifying the on disk location of the new userdata.
-func (s *Service) UserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint) ([]byte, error) {
+func (s *Service) UserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint, proxy *userdata.Proxy) ([]byte, error) {
 	var serviceEndpoint = ""
 	for _, v := range endpoints {
 		if v.ServiceID == serviceID {
 			serviceEndpoint = v.URL
 		}
 	}
-	var userData, err = mime.GenerateInitDocument(secretPrefix, chunks, region, serviceEndpoint, secretFetchScript)
+	proxyScript, err := proxy.Script()
+	if err != nil {
+		return []byte{}, err
+	}
+	userData, err := mime.GenerateInitDocument(secretPrefix, chunks, region, serviceEndpoint, proxyScript, secretFetchScript)
+	if err != nil {
+		return []byte{}, err
+	}
+	return userData, nil
+}
+
+// WindowsUserData creates an EC2Launch v2 document running a PowerShell script to download
+// userdata from AWS Systems Manager, delete the parameters and then run the downloaded script.
+func (s *Service) WindowsUserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint, proxy *userdata.Proxy) ([]byte, error) {
+	var serviceEndpoint = ""
+	for _, v := range endpoints {
+		if v.ServiceID == serviceID {
+			serviceEndpoint = v.URL
+		}
+	}
+	proxyScript, err := proxy.PowerShellScript()
+	if err != nil {
+		return []byte{}, err
+	}
+	userData, err := ec2launch.GenerateSecretFetchDocument(secretPrefix, chunks, region, serviceEndpoint, proxyScript, windowsSecretFetchScript)
 	if err != nil {
 		return []byte{}, err
 	}
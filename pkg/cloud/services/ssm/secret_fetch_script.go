@@ -38,7 +38,8 @@ set -o errexit
 set -o nounset
 set -o pipefail
 
-umask 006
+{{if .Proxy}}{{.Proxy}}
+{{end}}umask 006
 
 REGION="{{.Region}}"
 if [ "{{.Endpoint}}" != "" ]; then
@@ -90,6 +90,7 @@ func TestService_Create(t *testing.T) {
 		name           string
 		bytesCount     int64
 		secretPrefix   string
+		kmsKeyARN      string
 		expectedPrefix string
 		wantErr        bool
 		expect         func(m *mock_ssmiface.MockSSMAPIMockRecorder)
@@ -111,6 +112,9 @@ func TestService_Create(t *testing.T) {
 						if !strings.HasPrefix(*(putParameterInput.Name), "/prefix/") {
 							t.Fatalf("Prefix is not as expected: %v", putParameterInput.Name)
 						}
+						if putParameterInput.KeyId != nil {
+							t.Fatalf("KeyId is not as expected, actual: %v, expected: nil", *putParameterInput.KeyId)
+						}
 						sortTagsByKey(putParameterInput.Tags)
 						if !cmp.Equal(putParameterInput.Tags, expectedTags) {
 							t.Fatalf("Tags are not as expected, actual: %v, expected: %v", putParameterInput.Tags, expectedTags)
@@ -119,6 +123,22 @@ func TestService_Create(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:           "Should encrypt data with the customer managed KMS key if set",
+			bytesCount:     10,
+			secretPrefix:   "prefix",
+			kmsKeyARN:      "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			expectedPrefix: "/prefix",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.PutParameter(gomock.AssignableToTypeOf(&ssm.PutParameterInput{})).Return(&ssm.PutParameterOutput{}, nil).Do(
+					func(putParameterInput *ssm.PutParameterInput) {
+						if aws.StringValue(putParameterInput.KeyId) != "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab" {
+							t.Fatalf("KeyId is not as expected: %v", putParameterInput.KeyId)
+						}
+					},
+				)
+			},
+		},
 		{
 			name:           "Should not retry if non-retryable error occurred while storing data in SSM",
 			bytesCount:     10,
@@ -143,7 +163,7 @@ func TestService_Create(t *testing.T) {
 			name:           "Should retry if retryable error occurred while storing data in SSM",
 			bytesCount:     10,
 			secretPrefix:   "",
-			expectedPrefix: "/cluster.x-k8s.io",
+			expectedPrefix: "/cluster.x-k8s.io/test/",
 			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
 				m.PutParameter(gomock.AssignableToTypeOf(&ssm.PutParameterInput{})).Return(nil, &ssm.ParameterLimitExceeded{})
 				m.PutParameter(gomock.AssignableToTypeOf(&ssm.PutParameterInput{})).Return(&ssm.PutParameterOutput{}, nil)
@@ -169,6 +189,7 @@ func TestService_Create(t *testing.T) {
 			ms, err := getMachineScope(client, clusterScope)
 			g.Expect(err).NotTo(HaveOccurred())
 			ms.SetSecretPrefix(tt.secretPrefix)
+			ms.AWSMachine.Spec.CloudInit.KMSKeyARN = tt.kmsKeyARN
 			data := generateBytes(tt.bytesCount)
 
 			prefix, _, err := s.Create(ms, data)
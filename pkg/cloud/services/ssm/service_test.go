@@ -19,6 +19,7 @@ package ssm
 import (
 	"bytes"
 	"net/mail"
+	"strings"
 	"testing"
 
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
@@ -27,7 +28,7 @@ import (
 func TestUserData(t *testing.T) {
 	service := Service{}
 	endpoints := []scope.ServiceEndpoint{}
-	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints)
+	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints, nil)
 
 	if _, err := mail.ReadMessage(bytes.NewBuffer(doc)); err != nil {
 		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
@@ -43,9 +44,30 @@ func TestUserDataEndpoints(t *testing.T) {
 			ServiceID:     "ssm",
 		},
 	}
-	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints)
+	doc, _ := service.UserData("secretARN", 1, "eu-west-1", endpoints, nil)
 
 	if _, err := mail.ReadMessage(bytes.NewBuffer(doc)); err != nil {
 		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
 	}
 }
+
+func TestWindowsUserData(t *testing.T) {
+	service := Service{}
+	endpoints := []scope.ServiceEndpoint{
+		{
+			URL:           "localhost",
+			SigningRegion: "localhost",
+			ServiceID:     "ssm",
+		},
+	}
+	doc, err := service.WindowsUserData("secretARN", 1, "eu-west-1", endpoints, nil)
+	if err != nil {
+		t.Fatalf("Cannot generate EC2Launch document: %+v", err)
+	}
+
+	for _, want := range []string{"executeScript", "$SecretPrefix = \"secretARN\"", "$Endpoint = \"localhost\""} {
+		if !strings.Contains(string(doc), want) {
+			t.Fatalf("EC2Launch document does not contain %q:\n%s", want, string(doc))
+		}
+	}
+}
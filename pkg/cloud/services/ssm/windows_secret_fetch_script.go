@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+// nolint: gosec
+const windowsSecretFetchScript = `# Copyright 2022 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+$ErrorActionPreference = "Stop"
+
+{{if .Proxy}}{{.Proxy}}
+{{end}}$Region = "{{.Region}}"
+$Endpoint = "{{.Endpoint}}"
+$SecretPrefix = "{{.SecretPrefix}}"
+$Chunks = {{.Chunks}}
+$File = "$env:ProgramData\Amazon\EC2Launch\secret-userdata.ps1"
+
+$AWSParams = @{ Region = $Region }
+if ($Endpoint -ne "") {
+  $AWSParams["EndpointUrl"] = $Endpoint
+}
+
+# Print a status line.  Formatted to show up in a stream of output.
+function Write-LogInfo([string]$Message) {
+  Write-Output "+++ [$(Get-Date -Format o)] $Message"
+}
+
+# Log an error but keep going.
+function Write-LogError([string]$Message) {
+  [Console]::Error.WriteLine("!!! [$(Get-Date -Format o)] $Message")
+}
+
+# Log an error and exit.
+function Exit-WithError([string]$Message, [int]$Code) {
+  Write-LogError $Message
+  Write-LogError "aws.cluster.x-k8s.io encrypted bootstrap script exiting with status $Code"
+  exit $Code
+}
+
+function Remove-Secrets {
+  for ($i = 0; $i -lt $Chunks; $i++) {
+    Write-LogInfo "deleting secret from AWS SSM Parameter Store"
+    try {
+      Remove-SSMParameter @AWSParams -Name "$SecretPrefix/$i" -Force | Out-Null
+    } catch {
+      Exit-WithError "Could not delete secret value: $_" 2
+    }
+  }
+}
+
+Write-LogInfo "aws.cluster.x-k8s.io encrypted bootstrap script started"
+Write-LogInfo "secret prefix: $SecretPrefix"
+Write-LogInfo "secret count: $Chunks"
+
+if (Test-Path $File) {
+  Write-LogInfo "encrypted userdata already written to disk"
+  exit 0
+}
+
+$Encoded = ""
+for ($i = 0; $i -lt $Chunks; $i++) {
+  Write-LogInfo "getting secret value from AWS SSM Parameter Store"
+  try {
+    $Parameter = Get-SSMParameter @AWSParams -Name "$SecretPrefix/$i" -WithDecryption $true
+  } catch {
+    Write-LogError "could not get secret value, deleting secret: $_"
+    Remove-Secrets
+    Exit-WithError "could not get secret value, but secret was deleted" 1
+  }
+  $Encoded += $Parameter.Value
+}
+
+Remove-Secrets
+
+Write-LogInfo "decompressing userdata to $File"
+try {
+  $Compressed = New-Object System.IO.MemoryStream(, [Convert]::FromBase64String($Encoded))
+  $Gzip = New-Object System.IO.Compression.GZipStream($Compressed, [System.IO.Compression.CompressionMode]::Decompress)
+  $Out = [System.IO.File]::Create($File)
+  $Gzip.CopyTo($Out)
+  $Out.Close()
+  $Gzip.Close()
+} catch {
+  Exit-WithError "could not unzip data: $_" 4
+}
+
+Write-LogInfo "running userdata"
+& $File
+Write-LogInfo "aws.cluster.x-k8s.io encrypted bootstrap script finished"
+`
@@ -18,6 +18,9 @@ package userdata
 
 const (
 	bastionBashScript = `{{.Header}}
+{{- with .Proxy }}
+{{ .Script }}
+{{- end }}
 
 BASTION_BOOTSTRAP_FILE=bastion_bootstrap.sh
 BASTION_BOOTSTRAP=https://s3.amazonaws.com/aws-quickstart/quickstart-linux-bastion/scripts/bastion_bootstrap.sh
@@ -36,6 +39,8 @@ pip install --upgrade pip &> /dev/null
 // BastionInput defines the context to generate a bastion instance user data.
 type BastionInput struct {
 	baseUserData
+
+	Proxy *Proxy
 }
 
 // NewBastion returns the user data string to be used on a bastion instance.
@@ -0,0 +1,27 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/mime"
+)
+
+// AppendCloudInitParts returns the multipart cloud-init user data with the additional
+// parts appended in order after its existing parts.
+func AppendCloudInitParts(userData []byte, parts []mime.Part) ([]byte, error) {
+	return mime.AppendParts(userData, parts)
+}
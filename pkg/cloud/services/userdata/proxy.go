@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"encoding/pem"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/alessio/shellescape"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+)
+
+const (
+	proxyBashScript = `# HTTP proxy and additional trusted CA certificates of the cluster.
+{{- range .Environment }}
+export {{ .Name }}={{ .Value | ShellQuote }} {{ .Name | ToLower }}={{ .Value | ShellQuote }}
+{{- end }}
+{{- if .AdditionalTrustBundle }}
+if command -v update-ca-trust >/dev/null 2>&1; then
+  TRUST_BUNDLE_FILE=/etc/pki/ca-trust/source/anchors/cluster-api-provider-aws.pem
+  AWS_CA_BUNDLE=/etc/pki/tls/certs/ca-bundle.crt
+else
+  TRUST_BUNDLE_FILE=/usr/local/share/ca-certificates/cluster-api-provider-aws.crt
+  AWS_CA_BUNDLE=/etc/ssl/certs/ca-certificates.crt
+fi
+mkdir -p "$(dirname "${TRUST_BUNDLE_FILE}")"
+cat >"${TRUST_BUNDLE_FILE}" <<'CLUSTER_API_PROVIDER_AWS_TRUST_BUNDLE'
+{{ .AdditionalTrustBundle }}
+CLUSTER_API_PROVIDER_AWS_TRUST_BUNDLE
+chmod 0644 "${TRUST_BUNDLE_FILE}"
+if command -v update-ca-trust >/dev/null 2>&1; then
+  update-ca-trust extract
+else
+  update-ca-certificates
+fi
+export AWS_CA_BUNDLE
+{{- end }}
+`
+
+	proxySystemdScript = `# HTTP proxy of the cluster for {{ .Units | JoinUnits }}.
+for unit in {{ .Units | JoinUnits }}; do
+  mkdir -p "/etc/systemd/system/${unit}.service.d"
+  cat >"/etc/systemd/system/${unit}.service.d/http-proxy.conf" <<'CLUSTER_API_PROVIDER_AWS_HTTP_PROXY'
+[Service]
+{{- range .Environment }}
+Environment="{{ .Name }}={{ .Value | SystemdEscape }}"
+{{- end }}
+CLUSTER_API_PROVIDER_AWS_HTTP_PROXY
+done
+systemctl daemon-reload
+`
+
+	proxyPowerShellScript = `# HTTP proxy and additional trusted CA certificates of the cluster.
+{{- range .Environment }}
+$env:{{ .Name }} = {{ .Value | PowerShellQuote }}
+{{- end }}
+{{- with .AWSProxy }}
+Set-AWSProxy -Hostname {{ .Hostname | PowerShellQuote }} -Port {{ .Port }}
+{{- if .Username }} -Username {{ .Username | PowerShellQuote }} -Password {{ .Password | PowerShellQuote }}{{ end }} -BypassOnLocal
+{{- if .BypassList }} -BypassList @({{ .BypassList | PowerShellList }}){{ end }}
+{{- end }}
+{{- range $i, $certificate := .Certificates }}
+$TrustBundleFile = Join-Path $env:TEMP "cluster-api-provider-aws-{{ $i }}.crt"
+Set-Content -Path $TrustBundleFile -Value {{ $certificate | PowerShellQuote }}
+Import-Certificate -FilePath $TrustBundleFile -CertStoreLocation Cert:\LocalMachine\Root | Out-Null
+Remove-Item -Path $TrustBundleFile
+{{- end }}
+`
+)
+
+// defaultNoProxy are the hosts instances always reach without the HTTP proxy: the loopback
+// interface, the instance metadata service and the private DNS names of EC2 instances.
+var defaultNoProxy = []string{"localhost", "127.0.0.1", "169.254.169.254", ".internal"}
+
+var proxyTemplateFuncMap = template.FuncMap{
+	"ShellQuote":      shellescape.Quote,
+	"ToLower":         strings.ToLower,
+	"JoinUnits":       func(units []string) string { return strings.Join(units, " ") },
+	"SystemdEscape":   systemdEscape,
+	"PowerShellQuote": powerShellQuote,
+	"PowerShellList":  powerShellList,
+}
+
+// Proxy is the HTTP proxy and the additional trusted CA certificates of the instances of a cluster.
+type Proxy struct {
+	HTTPProxy             string
+	HTTPSProxy            string
+	NoProxy               []string
+	AdditionalTrustBundle string
+}
+
+type proxyVariable struct {
+	Name  string
+	Value string
+}
+
+type awsProxy struct {
+	Hostname   string
+	Port       string
+	Username   string
+	Password   string
+	BypassList []string
+}
+
+// NewProxy returns the proxy settings of the instances of a cluster, where the given hosts and the
+// hosts instances always reach directly are added to the no proxy hosts of the config. Hosts given
+// as URLs are reduced to their host name. It returns nil if neither a proxy nor an additional trust
+// bundle is configured.
+func NewProxy(config *infrav1.ProxyConfig, additionalTrustBundle string, noProxy ...string) *Proxy {
+	if config == nil && additionalTrustBundle == "" {
+		return nil
+	}
+
+	proxy := &Proxy{
+		AdditionalTrustBundle: strings.TrimSpace(additionalTrustBundle),
+	}
+	if config == nil {
+		return proxy
+	}
+
+	proxy.HTTPProxy = config.HTTPProxy
+	proxy.HTTPSProxy = config.HTTPSProxy
+
+	seen := map[string]bool{}
+	for _, hosts := range [][]string{defaultNoProxy, config.NoProxy, noProxy} {
+		for _, host := range hosts {
+			if strings.Contains(host, "://") {
+				if u, err := url.Parse(host); err == nil {
+					host = u.Hostname()
+				}
+			}
+			if host == "" || seen[host] {
+				continue
+			}
+			seen[host] = true
+			proxy.NoProxy = append(proxy.NoProxy, host)
+		}
+	}
+
+	return proxy
+}
+
+// Script returns a bash script exporting the proxy environment variables for the rest of the
+// script, and adding the additional trust bundle to the system trust store.
+func (p *Proxy) Script() (string, error) {
+	if p == nil {
+		return "", nil
+	}
+
+	return p.render("proxy", proxyBashScript, nil)
+}
+
+// SystemdScript returns a bash script configuring the proxy environment variables for the
+// given systemd units, or an empty string if no proxy is configured.
+func (p *Proxy) SystemdScript(units ...string) (string, error) {
+	if p == nil || len(p.environment()) == 0 {
+		return "", nil
+	}
+
+	return p.render("proxy-systemd", proxySystemdScript, units)
+}
+
+// PowerShellScript returns a PowerShell script setting the proxy environment variables and the
+// proxy of the AWS Tools for PowerShell for the rest of the script, and adding the additional
+// trust bundle to the trusted root certificates of the local machine. CIDR blocks of the no proxy
+// hosts do not apply to the AWS Tools for PowerShell.
+func (p *Proxy) PowerShellScript() (string, error) {
+	if p == nil {
+		return "", nil
+	}
+
+	return p.render("proxy-powershell", proxyPowerShellScript, nil)
+}
+
+func (p *Proxy) render(kind, tpl string, units []string) (string, error) {
+	data := struct {
+		Environment           []proxyVariable
+		AdditionalTrustBundle string
+		Certificates          []string
+		AWSProxy              *awsProxy
+		Units                 []string
+	}{
+		Environment:           p.environment(),
+		AdditionalTrustBundle: p.AdditionalTrustBundle,
+		Certificates:          p.certificates(),
+		AWSProxy:              p.awsProxy(),
+		Units:                 units,
+	}
+
+	t, err := template.New(kind).Funcs(proxyTemplateFuncMap).Parse(tpl)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse %s template", kind)
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, data); err != nil {
+		return "", errors.Wrapf(err, "failed to generate %s template", kind)
+	}
+
+	return out.String(), nil
+}
+
+func (p *Proxy) environment() []proxyVariable {
+	var env []proxyVariable
+	if p.HTTPProxy != "" {
+		env = append(env, proxyVariable{Name: "HTTP_PROXY", Value: p.HTTPProxy})
+	}
+	if p.HTTPSProxy != "" {
+		env = append(env, proxyVariable{Name: "HTTPS_PROXY", Value: p.HTTPSProxy})
+	}
+	if len(env) > 0 && len(p.NoProxy) > 0 {
+		env = append(env, proxyVariable{Name: "NO_PROXY", Value: strings.Join(p.NoProxy, ",")})
+	}
+	return env
+}
+
+// certificates returns the PEM encoded certificates of the additional trust bundle one by one, as
+// Import-Certificate only imports the first certificate of a file.
+func (p *Proxy) certificates() []string {
+	var certificates []string
+	rest := []byte(p.AdditionalTrustBundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return certificates
+		}
+		certificates = append(certificates, strings.TrimSpace(string(pem.EncodeToMemory(block))))
+	}
+}
+
+// awsProxy returns the proxy of the AWS Tools for PowerShell, which reach the AWS APIs over HTTPS.
+func (p *Proxy) awsProxy() *awsProxy {
+	proxyURL := p.HTTPSProxy
+	if proxyURL == "" {
+		proxyURL = p.HTTPProxy
+	}
+	u, err := url.Parse(proxyURL)
+	if proxyURL == "" || err != nil {
+		return nil
+	}
+
+	proxy := &awsProxy{
+		Hostname: u.Hostname(),
+		Port:     u.Port(),
+	}
+	if proxy.Port == "" {
+		proxy.Port = "80"
+		if u.Scheme == "https" {
+			proxy.Port = "443"
+		}
+	}
+	if u.User != nil {
+		proxy.Username = u.User.Username()
+		proxy.Password, _ = u.User.Password()
+	}
+
+	for _, host := range p.NoProxy {
+		if _, _, err := net.ParseCIDR(host); err == nil {
+			continue
+		}
+		proxy.BypassList = append(proxy.BypassList, regexp.QuoteMeta(host))
+	}
+
+	return proxy
+}
+
+func systemdEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "%", "%%").Replace(value)
+}
+
+func powerShellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func powerShellList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = powerShellQuote(value)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -28,6 +28,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// MaxUserDataSize is the maximum size of EC2 instance userdata before base64 encoding.
+const MaxUserDataSize = 16 * 1024
+
 var defaultTemplateFuncMap = template.FuncMap{
 	"Base64Encode": templateBase64Encode,
 	"Indent":       templateYAMLIndent,
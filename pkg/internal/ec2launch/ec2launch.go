@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ec2launch generates EC2Launch v2 user data documents for Windows instances.
+package ec2launch
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	documentVersion   = "1.0"
+	executeScriptTask = "executeScript"
+)
+
+// document is the EC2Launch v2 YAML user data format, see
+// https://docs.aws.amazon.com/AWSEC2/latest/WindowsGuide/ec2launch-v2-settings.html#ec2launch-v2-task-configuration
+type document struct {
+	Version string `json:"version"`
+	Tasks   []task `json:"tasks"`
+}
+
+type task struct {
+	Task   string        `json:"task"`
+	Inputs []scriptInput `json:"inputs"`
+}
+
+type scriptInput struct {
+	Frequency string `json:"frequency"`
+	Type      string `json:"type"`
+	RunAs     string `json:"runAs"`
+	Content   string `json:"content"`
+}
+
+type scriptVariables struct {
+	SecretPrefix string
+	Chunks       int32
+	Region       string
+	Endpoint     string
+	Proxy        string
+}
+
+// GenerateScriptDocument returns an EC2Launch v2 document running the PowerShell script
+// once as the local system account.
+func GenerateScriptDocument(script []byte) ([]byte, error) {
+	doc := document{
+		Version: documentVersion,
+		Tasks: []task{
+			{
+				Task: executeScriptTask,
+				Inputs: []scriptInput{
+					{
+						Frequency: "once",
+						Type:      "powershell",
+						RunAs:     "localSystem",
+						Content:   string(script),
+					},
+				},
+			},
+		},
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return []byte{}, errors.Wrap(err, "failed to serialize EC2Launch document")
+	}
+
+	return out, nil
+}
+
+// GenerateSecretFetchDocument renders the given PowerShell secret fetch script template, with the
+// proxy script rendered into it as is, and returns an EC2Launch v2 document running it.
+func GenerateSecretFetchDocument(secretPrefix string, chunks int32, region string, endpoint string, proxyScript string, secretFetchScript string) ([]byte, error) {
+	secretFetchTemplate, err := template.New("secret-fetch-script").Parse(secretFetchScript)
+	if err != nil {
+		return []byte{}, errors.Wrap(err, "failed to parse secret fetch script")
+	}
+
+	var scriptBuf bytes.Buffer
+	if err := secretFetchTemplate.Execute(&scriptBuf, scriptVariables{
+		SecretPrefix: secretPrefix,
+		Chunks:       chunks,
+		Region:       region,
+		Endpoint:     endpoint,
+		Proxy:        proxyScript,
+	}); err != nil {
+		return []byte{}, err
+	}
+
+	return GenerateScriptDocument(scriptBuf.Bytes())
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2launch
+
+import (
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+func TestGenerateSecretFetchDocument(t *testing.T) {
+	doc, err := GenerateSecretFetchDocument("prefix", 2, "eu-west-1", "localhost", "$env:HTTPS_PROXY = 'http://proxy:3128'\n", "{{.Proxy}}$Prefix = \"{{.SecretPrefix}}\"\n$Chunks = {{.Chunks}}\n")
+	if err != nil {
+		t.Fatalf("Cannot generate EC2Launch document: %+v", err)
+	}
+
+	parsed := document{}
+	if err := yaml.UnmarshalStrict(doc, &parsed); err != nil {
+		t.Fatalf("Cannot parse EC2Launch document: %+v\n%s", err, string(doc))
+	}
+
+	if len(parsed.Tasks) != 1 || len(parsed.Tasks[0].Inputs) != 1 {
+		t.Fatalf("Expected a single executeScript task, got:\n%s", string(doc))
+	}
+
+	if got, want := parsed.Tasks[0].Inputs[0].Content, "$env:HTTPS_PROXY = 'http://proxy:3128'\n$Prefix = \"prefix\"\n$Chunks = 2\n"; got != want {
+		t.Fatalf("Script content = %q, want %q", got, want)
+	}
+}
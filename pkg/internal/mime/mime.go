@@ -19,10 +19,15 @@ package mime
 import (
 	"bytes"
 	"fmt"
-	"html/template"
+	"io"
+	stdmime "mime"
 	"mime/multipart"
+	"net/mail"
 	"net/textproto"
 	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -45,17 +50,25 @@ var (
 	}, "\n")
 )
 
+// Part is an additional part of a multipart userdata document.
+type Part struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
 type scriptVariables struct {
 	SecretPrefix string
 	Chunks       int32
 	Region       string
 	Endpoint     string
+	Proxy        string
 }
 
 // GenerateInitDocument renders a given template, applies MIME properties
 // and returns a series of byte chunks which put together represent a UserData
-// script.
-func GenerateInitDocument(secretPrefix string, chunks int32, region string, endpoint string, secretFetchScript string) ([]byte, error) {
+// script. The proxy script is rendered into the template as is.
+func GenerateInitDocument(secretPrefix string, chunks int32, region string, endpoint string, proxyScript string, secretFetchScript string) ([]byte, error) {
 	var secretFetchTemplate = template.Must(template.New("secret-fetch-script").Parse(secretFetchScript))
 
 	var buf bytes.Buffer
@@ -71,6 +84,7 @@ func GenerateInitDocument(secretPrefix string, chunks int32, region string, endp
 		Chunks:       chunks,
 		Region:       region,
 		Endpoint:     endpoint,
+		Proxy:        proxyScript,
 	}
 
 	var scriptBuf bytes.Buffer
@@ -98,3 +112,62 @@ func GenerateInitDocument(secretPrefix string, chunks int32, region string, endp
 
 	return buf.Bytes(), nil
 }
+
+// AppendParts returns the multipart userdata document with the given parts appended in order
+// after its existing parts.
+func AppendParts(document []byte, parts []Part) ([]byte, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(document))
+	if err != nil {
+		return []byte{}, errors.Wrap(err, "failed to parse userdata document")
+	}
+
+	mediaType, params, err := stdmime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return []byte{}, errors.Wrap(err, "failed to parse userdata document content type")
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return []byte{}, errors.Errorf("userdata document is not a multipart document but %s", mediaType)
+	}
+
+	var buf bytes.Buffer
+	mpWriter := multipart.NewWriter(&buf)
+	buf.WriteString(fmt.Sprintf(multipartHeader, mpWriter.Boundary()))
+
+	mpReader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		existing, err := mpReader.NextRawPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return []byte{}, errors.Wrap(err, "failed to read userdata document part")
+		}
+
+		partWriter, err := mpWriter.CreatePart(existing.Header)
+		if err != nil {
+			return []byte{}, err
+		}
+		if _, err := io.Copy(partWriter, existing); err != nil {
+			return []byte{}, err
+		}
+	}
+
+	for _, part := range parts {
+		partWriter, err := mpWriter.CreatePart(textproto.MIMEHeader{
+			"content-type":        {part.ContentType},
+			"content-disposition": {stdmime.FormatMediaType("attachment", map[string]string{"filename": part.Filename})},
+		})
+		if err != nil {
+			return []byte{}, err
+		}
+		if _, err := partWriter.Write(part.Content); err != nil {
+			return []byte{}, err
+		}
+	}
+
+	if err := mpWriter.Close(); err != nil {
+		return []byte{}, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -18,15 +18,69 @@ package mime
 
 import (
 	"bytes"
+	"io"
+	stdmime "mime"
+	"mime/multipart"
 	"net/mail"
 	"testing"
 )
 
 func TestGenerateInitDocument(t *testing.T) {
 	secretARN := "secretARN"
-	doc, _ := GenerateInitDocument(secretARN, 1, "eu-west-1", "localhost", "abc123")
+	doc, _ := GenerateInitDocument(secretARN, 1, "eu-west-1", "localhost", "", "abc123")
 
 	if _, err := mail.ReadMessage(bytes.NewBuffer(doc)); err != nil {
 		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
 	}
 }
+
+func TestAppendParts(t *testing.T) {
+	doc, err := GenerateInitDocument("secretARN", 1, "eu-west-1", "localhost", "", "abc123")
+	if err != nil {
+		t.Fatalf("Cannot generate MIME doc: %+v", err)
+	}
+
+	doc, err = AppendParts(doc, []Part{
+		{Filename: "first.sh", ContentType: "text/x-shellscript", Content: []byte("#!/bin/sh\necho first\n")},
+		{Filename: "second.cfg", ContentType: "text/cloud-config", Content: []byte("#cloud-config\n")},
+	})
+	if err != nil {
+		t.Fatalf("Cannot append parts: %+v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewBuffer(doc))
+	if err != nil {
+		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
+	}
+	_, params, err := stdmime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("Cannot parse MIME doc content type: %+v", err)
+	}
+
+	var contentTypes, filenames []string
+	mpReader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mpReader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Cannot read MIME doc part: %+v", err)
+		}
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+		filenames = append(filenames, part.FileName())
+	}
+
+	wantContentTypes := []string{"text/cloud-boothook", "text/x-include-url", "text/x-shellscript", "text/cloud-config"}
+	if len(contentTypes) != len(wantContentTypes) {
+		t.Fatalf("Expected parts %v, got %v", wantContentTypes, contentTypes)
+	}
+	for i := range wantContentTypes {
+		if contentTypes[i] != wantContentTypes[i] {
+			t.Fatalf("Expected parts %v, got %v", wantContentTypes, contentTypes)
+		}
+	}
+	if filenames[2] != "first.sh" || filenames[3] != "second.cfg" {
+		t.Fatalf("Expected file names of the appended parts, got %v", filenames)
+	}
+}